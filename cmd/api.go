@@ -0,0 +1,462 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/yjiky/linctl/pkg/api"
+	"github.com/yjiky/linctl/pkg/auth"
+	"github.com/yjiky/linctl/pkg/output"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// apiCmd represents the api command
+var apiCmd = &cobra.Command{
+	Use:   "api [QUERY]",
+	Short: "Run a raw GraphQL query or mutation against the Linear API",
+	Long: `Run an arbitrary GraphQL query or mutation against the Linear API using your stored credentials.
+
+The query is read from the argument, from --query-file, or from stdin when the
+argument is omitted or "-". The response data is printed as JSON.
+
+Variables:
+  -F key=value   typed variable: true, false, null and numbers are decoded as JSON,
+                 @path reads the value from a file
+  -f key=value   raw string variable
+  --variables-file vars.json   JSON object of variables (flags override it)
+
+A document that defines several operations runs the one named with
+--operation-name.
+
+Pagination:
+  --paginate follows the first pageInfo in the response, passing its endCursor
+  as $after, and concatenates the nodes of every page. Declare an $after
+  variable in your query for this to work.
+
+Extraction:
+  --jq takes a path such as .issues.nodes[].identifier and prints the matching
+  values. Strings are printed raw, one per line.
+
+Examples:
+  linctl api '{ viewer { id name email } }'
+  linctl api 'query($id: String!) { issue(id: $id) { title } }' -F id=LIN-123
+  linctl api --query-file query.graphql --variables-file vars.json
+  echo '{ teams { nodes { key } } }' | linctl api --jq '.teams.nodes[].key'
+  linctl api --paginate --jq '.issues.nodes[].identifier' \
+    'query($after: String) { issues(first: 250, after: $after) { nodes { identifier } pageInfo { hasNextPage endCursor } } }'
+  linctl api rate-limit    # Show your remaining request and complexity quota`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		query, err := readAPIQuery(cmd, args)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(1)
+		}
+
+		variables, err := buildAPIVariables(cmd)
+		if err != nil {
+			output.Error(fmt.Sprintf("Invalid variables: %v", err), plaintext, jsonOut)
+			os.Exit(1)
+		}
+
+		client, err := auth.NewClient()
+		if err != nil {
+			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		operationName, _ := cmd.Flags().GetString("operation-name")
+		paginate, _ := cmd.Flags().GetBool("paginate")
+		var data interface{}
+		if paginate {
+			data, err = executePaginated(context.Background(), client, query, operationName, variables)
+		} else {
+			err = client.ExecuteOperation(context.Background(), query, operationName, variables, &data)
+		}
+		if err != nil {
+			output.Error(fmt.Sprintf("Request failed: %v", err), plaintext, jsonOut)
+			os.Exit(exitCodeFor(err))
+		}
+
+		jqPath, _ := cmd.Flags().GetString("jq")
+		if jqPath == "" {
+			output.JSON(data)
+			return
+		}
+
+		values, err := extractPath(data, jqPath)
+		if err != nil {
+			output.Error(fmt.Sprintf("Invalid --jq expression: %v", err), plaintext, jsonOut)
+			os.Exit(1)
+		}
+		for _, v := range values {
+			if str, ok := v.(string); ok {
+				fmt.Println(str)
+				continue
+			}
+			encoded, err := json.Marshal(v)
+			if err != nil {
+				output.Error(fmt.Sprintf("Failed to encode value: %v", err), plaintext, jsonOut)
+				os.Exit(1)
+			}
+			fmt.Println(string(encoded))
+		}
+	},
+}
+
+// readAPIQuery reads the GraphQL document from the argument, --query-file or stdin
+func readAPIQuery(cmd *cobra.Command, args []string) (string, error) {
+	queryFile, _ := cmd.Flags().GetString("query-file")
+
+	var query string
+	switch {
+	case queryFile != "" && len(args) > 0:
+		return "", fmt.Errorf("pass the query as an argument or with --query-file, not both")
+	case queryFile != "":
+		data, err := os.ReadFile(queryFile)
+		if err != nil {
+			return "", fmt.Errorf("failed to read query file: %v", err)
+		}
+		query = string(data)
+	case len(args) > 0 && args[0] != "-":
+		query = args[0]
+	default:
+		if len(args) == 0 && isTerminal(os.Stdin) {
+			return "", fmt.Errorf("a query is required (argument, --query-file, or stdin)")
+		}
+		data, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return "", fmt.Errorf("failed to read query from stdin: %v", err)
+		}
+		query = string(data)
+	}
+
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return "", fmt.Errorf("query is empty")
+	}
+	return query, nil
+}
+
+// buildAPIVariables merges --variables-file with -F and -f flags
+func buildAPIVariables(cmd *cobra.Command) (map[string]interface{}, error) {
+	variables := map[string]interface{}{}
+
+	if path, _ := cmd.Flags().GetString("variables-file"); path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read variables file: %v", err)
+		}
+		if err := json.Unmarshal(data, &variables); err != nil {
+			return nil, fmt.Errorf("variables file must contain a JSON object: %v", err)
+		}
+	}
+
+	typedFields, _ := cmd.Flags().GetStringArray("field")
+	for _, field := range typedFields {
+		key, value, err := splitField(field)
+		if err != nil {
+			return nil, err
+		}
+		parsed, err := parseTypedValue(value)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", key, err)
+		}
+		variables[key] = parsed
+	}
+
+	rawFields, _ := cmd.Flags().GetStringArray("raw-field")
+	for _, field := range rawFields {
+		key, value, err := splitField(field)
+		if err != nil {
+			return nil, err
+		}
+		variables[key] = value
+	}
+
+	if len(variables) == 0 {
+		return nil, nil
+	}
+	return variables, nil
+}
+
+// splitField splits a key=value pair
+func splitField(field string) (string, string, error) {
+	key, value, ok := strings.Cut(field, "=")
+	if !ok || strings.TrimSpace(key) == "" {
+		return "", "", fmt.Errorf("expected key=value, got %q", field)
+	}
+	return strings.TrimSpace(key), value, nil
+}
+
+// parseTypedValue decodes a -F value: literals and numbers become JSON, @path reads a file
+func parseTypedValue(value string) (interface{}, error) {
+	if strings.HasPrefix(value, "@") {
+		data, err := os.ReadFile(value[1:])
+		if err != nil {
+			return nil, err
+		}
+		return string(data), nil
+	}
+
+	switch value {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	case "null":
+		return nil, nil
+	}
+
+	if i, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return i, nil
+	}
+	if f, err := strconv.ParseFloat(value, 64); err == nil {
+		return f, nil
+	}
+
+	return value, nil
+}
+
+// executePaginated runs the query repeatedly, following the first pageInfo found
+// in the response and concatenating the nodes next to it
+func executePaginated(ctx context.Context, client *api.Client, query, operationName string, variables map[string]interface{}) (interface{}, error) {
+	if variables == nil {
+		variables = map[string]interface{}{}
+	}
+
+	var merged map[string]interface{}
+	var mergedConn map[string]interface{}
+
+	for {
+		var page map[string]interface{}
+		if err := client.ExecuteOperation(ctx, query, operationName, variables, &page); err != nil {
+			return nil, err
+		}
+
+		conn := findConnection(page)
+		if conn == nil {
+			if merged == nil {
+				return page, nil
+			}
+			return nil, fmt.Errorf("response page has no pageInfo to follow")
+		}
+
+		if merged == nil {
+			merged = page
+			mergedConn = conn
+		} else {
+			nodes, _ := mergedConn["nodes"].([]interface{})
+			more, _ := conn["nodes"].([]interface{})
+			mergedConn["nodes"] = append(nodes, more...)
+			mergedConn["pageInfo"] = conn["pageInfo"]
+		}
+
+		pageInfo, _ := conn["pageInfo"].(map[string]interface{})
+		hasNext, _ := pageInfo["hasNextPage"].(bool)
+		cursor, _ := pageInfo["endCursor"].(string)
+		if !hasNext || cursor == "" || cursor == variables["after"] {
+			return merged, nil
+		}
+		variables["after"] = cursor
+	}
+}
+
+// findConnection returns the first object with a pageInfo field, walking keys in sorted order
+func findConnection(value interface{}) map[string]interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if _, ok := v["pageInfo"].(map[string]interface{}); ok {
+			return v
+		}
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			if conn := findConnection(v[k]); conn != nil {
+				return conn
+			}
+		}
+	case []interface{}:
+		for _, item := range v {
+			if conn := findConnection(item); conn != nil {
+				return conn
+			}
+		}
+	}
+	return nil
+}
+
+// extractPath evaluates a jq-style path made of .field, [N] and [] steps
+func extractPath(data interface{}, path string) ([]interface{}, error) {
+	path = strings.TrimSpace(path)
+	if path == "" || path == "." {
+		return []interface{}{data}, nil
+	}
+	if !strings.HasPrefix(path, ".") && !strings.HasPrefix(path, "[") {
+		return nil, fmt.Errorf("path must start with '.'")
+	}
+
+	current := []interface{}{data}
+	rest := path
+	for rest != "" {
+		var next []interface{}
+		switch {
+		case strings.HasPrefix(rest, "[]"):
+			rest = rest[2:]
+			for _, v := range current {
+				if arr, ok := v.([]interface{}); ok {
+					next = append(next, arr...)
+				}
+			}
+		case strings.HasPrefix(rest, "["):
+			end := strings.Index(rest, "]")
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated '[' in %q", path)
+			}
+			index, err := strconv.Atoi(rest[1:end])
+			if err != nil {
+				return nil, fmt.Errorf("invalid index %q", rest[1:end])
+			}
+			rest = rest[end+1:]
+			for _, v := range current {
+				arr, ok := v.([]interface{})
+				if !ok {
+					continue
+				}
+				i := index
+				if i < 0 {
+					i += len(arr)
+				}
+				if i >= 0 && i < len(arr) {
+					next = append(next, arr[i])
+				}
+			}
+		case strings.HasPrefix(rest, "."):
+			rest = rest[1:]
+			end := strings.IndexAny(rest, ".[")
+			if end < 0 {
+				end = len(rest)
+			}
+			key := rest[:end]
+			rest = rest[end:]
+			if key == "" {
+				continue
+			}
+			for _, v := range current {
+				if obj, ok := v.(map[string]interface{}); ok {
+					next = append(next, obj[key])
+				}
+			}
+		default:
+			return nil, fmt.Errorf("unexpected %q in %q", rest, path)
+		}
+		current = next
+	}
+
+	return current, nil
+}
+
+var apiRateLimitCmd = &cobra.Command{
+	Use:   "rate-limit",
+	Short: "Show current API rate limit status",
+	Long:  `Show the request and complexity quotas Linear reports for your API key.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		client, err := auth.NewClient()
+		if err != nil {
+			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		rl, err := client.GetRateLimit(context.Background())
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to get rate limit: %v", err), plaintext, jsonOut)
+			os.Exit(exitCodeFor(err))
+		}
+
+		if jsonOut {
+			output.JSON(rl)
+			return
+		}
+
+		if plaintext {
+			fmt.Printf("Requests: %d/%d remaining (resets %s)\n", rl.Remaining, rl.Limit, rl.Reset.Format(time.RFC3339))
+			if rl.ComplexityLimit > 0 {
+				fmt.Printf("Complexity: %d/%d remaining (resets %s)\n", rl.ComplexityRemaining, rl.ComplexityLimit, rl.ComplexityReset.Format(time.RFC3339))
+			}
+			return
+		}
+
+		fmt.Println()
+		fmt.Printf("%s\n", color.New(color.FgCyan, color.Bold).Sprint("⏱️  Linear API Rate Limit"))
+		fmt.Printf("%s %s of %d remaining, resets in %s\n",
+			color.New(color.Bold).Sprint("Requests:"),
+			quotaColor(rl.Remaining, rl.Limit).Sprintf("%d", rl.Remaining),
+			rl.Limit,
+			formatResetIn(rl.Reset))
+		if rl.ComplexityLimit > 0 {
+			fmt.Printf("%s %s of %d remaining, resets in %s\n",
+				color.New(color.Bold).Sprint("Complexity:"),
+				quotaColor(rl.ComplexityRemaining, rl.ComplexityLimit).Sprintf("%d", rl.ComplexityRemaining),
+				rl.ComplexityLimit,
+				formatResetIn(rl.ComplexityReset))
+		}
+		fmt.Println()
+	},
+}
+
+// quotaColor colors a remaining quota by how much of it is left
+func quotaColor(remaining, limit int) *color.Color {
+	if limit <= 0 {
+		return color.New(color.FgWhite)
+	}
+	ratio := float64(remaining) / float64(limit)
+	if ratio < 0.1 {
+		return color.New(color.FgRed)
+	} else if ratio < 0.5 {
+		return color.New(color.FgYellow)
+	}
+	return color.New(color.FgGreen)
+}
+
+// formatResetIn formats the time until a quota reset
+func formatResetIn(reset time.Time) string {
+	if reset.IsZero() {
+		return "unknown"
+	}
+	d := time.Until(reset).Round(time.Second)
+	if d < 0 {
+		d = 0
+	}
+	return d.String()
+}
+
+func init() {
+	rootCmd.AddCommand(apiCmd)
+	apiCmd.AddCommand(apiRateLimitCmd)
+
+	apiCmd.Flags().StringArrayP("field", "F", nil, "Add a typed variable in key=value format (repeatable; @path reads a file)")
+	apiCmd.Flags().StringArrayP("raw-field", "f", nil, "Add a string variable in key=value format (repeatable)")
+	apiCmd.Flags().String("variables-file", "", "Read variables from a JSON file")
+	apiCmd.Flags().String("query-file", "", "Read the query from a file")
+	apiCmd.Flags().String("operation-name", "", "Operation to run when the document defines several")
+	apiCmd.Flags().Bool("paginate", false, "Follow pageInfo.endCursor through every page (query must declare $after)")
+	apiCmd.Flags().String("jq", "", "Print values at a path such as .issues.nodes[].identifier")
+}
@@ -0,0 +1,208 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// echoVariables makes the Echo operation answer with the variables it got
+func echoVariables(env *testEnv) {
+	env.fake.Handle("Echo", func(variables map[string]interface{}) (interface{}, error) {
+		return map[string]interface{}{"variables": variables}, nil
+	})
+}
+
+func TestAPIQuery(t *testing.T) {
+	env := newWorkspace(t)
+
+	var data struct {
+		Viewer struct {
+			Email string `json:"email"`
+		} `json:"viewer"`
+	}
+	decodeJSON(t, env.run("api", "query Me { viewer { id email } }"), &data)
+	if data.Viewer.Email != "alice@example.com" {
+		t.Errorf("viewer = %+v", data.Viewer)
+	}
+
+	out := env.run("api", "query Issue($id: String!) { issue(id: $id) { title } }", "-F", "id=ENG-2", "--jq", ".issue.title")
+	if out != "Add dark mode\n" {
+		t.Errorf("--jq printed %q, want the raw title", out)
+	}
+
+	out = env.run("api", "query Me { viewer { id } }", "--jq", ".viewer.admin")
+	if out != "true\n" {
+		t.Errorf("--jq printed %q, want a JSON boolean", out)
+	}
+
+	file := filepath.Join(t.TempDir(), "teams.graphql")
+	if err := os.WriteFile(file, []byte("query Teams { teams { nodes { key } } }\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	out = env.run("api", "--query-file", file, "--jq", ".teams.nodes[].key")
+	if out != "ENG\nOPS\n" {
+		t.Errorf("--query-file printed %q", out)
+	}
+
+	out = env.runWithInput("query Teams { teams { nodes { key } } }", "api", "-", "--jq", ".teams.nodes[-1].key")
+	if out != "OPS\n" {
+		t.Errorf("query from stdin printed %q", out)
+	}
+}
+
+func TestAPIVariables(t *testing.T) {
+	env := newWorkspace(t)
+	echoVariables(env)
+
+	dir := t.TempDir()
+	vars := filepath.Join(dir, "vars.json")
+	if err := os.WriteFile(vars, []byte(`{"first": 10, "team": "OPS", "keep": [1, 2]}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	body := filepath.Join(dir, "body.md")
+	if err := os.WriteFile(body, []byte("From a file\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	var data struct {
+		Variables map[string]interface{} `json:"variables"`
+	}
+	decodeJSON(t, env.run("api", "query Echo { echo }",
+		"--variables-file", vars,
+		"-F", "team=ENG",
+		"-F", "count=3",
+		"-F", "ratio=0.5",
+		"-F", "archived=false",
+		"-F", "cycle=null",
+		"-F", "body=@"+body,
+		"-f", "number=42",
+		"-f", "empty=",
+	), &data)
+	want := map[string]interface{}{
+		"first":    float64(10),
+		"keep":     []interface{}{float64(1), float64(2)},
+		"team":     "ENG",
+		"count":    float64(3),
+		"ratio":    0.5,
+		"archived": false,
+		"cycle":    nil,
+		"body":     "From a file\n",
+		"number":   "42",
+		"empty":    "",
+	}
+	if !reflect.DeepEqual(data.Variables, want) {
+		t.Errorf("variables = %v, want %v", data.Variables, want)
+	}
+}
+
+func TestAPIPaginate(t *testing.T) {
+	env := newWorkspace(t)
+	pages := map[string]map[string]interface{}{
+		"":       {"nodes": []interface{}{map[string]interface{}{"id": "a"}, map[string]interface{}{"id": "b"}}, "pageInfo": map[string]interface{}{"hasNextPage": true, "endCursor": "page-2"}},
+		"page-2": {"nodes": []interface{}{map[string]interface{}{"id": "c"}}, "pageInfo": map[string]interface{}{"hasNextPage": false, "endCursor": "page-2"}},
+	}
+	env.fake.Handle("Items", func(variables map[string]interface{}) (interface{}, error) {
+		after, _ := variables["after"].(string)
+		return map[string]interface{}{"items": pages[after]}, nil
+	})
+
+	query := "query Items($after: String) { items(after: $after) { nodes { id } pageInfo { hasNextPage endCursor } } }"
+	out := env.run("api", query, "--paginate", "--jq", ".items.nodes[].id")
+	if out != "a\nb\nc\n" {
+		t.Errorf("--paginate printed %q, want every page's nodes", out)
+	}
+
+	out = env.run("api", query, "--jq", ".items.nodes[].id")
+	if out != "a\nb\n" {
+		t.Errorf("without --paginate printed %q, want the first page", out)
+	}
+}
+
+func TestAPIErrors(t *testing.T) {
+	env := newWorkspace(t)
+	file := filepath.Join(t.TempDir(), "query.graphql")
+	if err := os.WriteFile(file, []byte("query Me { viewer { id } }"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		code int
+		args []string
+		want string
+	}{
+		{"argument and file", exitError, []string{"query Me { viewer { id } }", "--query-file", file}, "not both"},
+		{"empty query", exitError, []string{"  "}, "query is empty"},
+		{"missing query file", exitError, []string{"--query-file", file + ".missing"}, "failed to read query file"},
+		{"field without value", exitError, []string{"query Me { viewer { id } }", "-F", "id"}, `Invalid variables: expected key=value, got "id"`},
+		{"invalid jq", exitError, []string{"query Me { viewer { id } }", "--jq", "viewer"}, "Invalid --jq expression: path must start with '.'"},
+		{"not found", exitNotFound, []string{"query Issue($id: String!) { issue(id: $id) { id } }", "-F", "id=ENG-404"}, "Request failed"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := env.expectExit(tt.code, append([]string{"api"}, tt.args...)...)
+			assertContains(t, out, tt.want)
+		})
+	}
+}
+
+func TestExtractPath(t *testing.T) {
+	data := map[string]interface{}{
+		"issues": map[string]interface{}{
+			"nodes": []interface{}{
+				map[string]interface{}{"identifier": "ENG-1"},
+				map[string]interface{}{"identifier": "ENG-2"},
+			},
+		},
+	}
+
+	tests := []struct {
+		path string
+		want []interface{}
+	}{
+		{".", []interface{}{data}},
+		{".issues.nodes[].identifier", []interface{}{"ENG-1", "ENG-2"}},
+		{".issues.nodes[1].identifier", []interface{}{"ENG-2"}},
+		{".issues.nodes[-2].identifier", []interface{}{"ENG-1"}},
+		{".issues.nodes[5].identifier", nil},
+		{".issues.missing", []interface{}{nil}},
+	}
+	for _, tt := range tests {
+		got, err := extractPath(data, tt.path)
+		if err != nil {
+			t.Errorf("extractPath(%q) failed: %v", tt.path, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("extractPath(%q) = %v, want %v", tt.path, got, tt.want)
+		}
+	}
+
+	for _, path := range []string{"issues", ".issues[", ".issues[x]"} {
+		if _, err := extractPath(data, path); err == nil {
+			t.Errorf("extractPath(%q) succeeded, want an error", path)
+		}
+	}
+}
+
+func TestAPIRateLimit(t *testing.T) {
+	env := newWorkspace(t)
+
+	out := env.run("api", "rate-limit", "--plaintext")
+	assertContains(t, out, "Requests: 1499/1500 remaining (resets ", "Complexity: 249000/250000 remaining (resets ")
+
+	var rl struct {
+		Limit     int `json:"limit"`
+		Remaining int `json:"remaining"`
+	}
+	env.runJSON(&rl, "api", "rate-limit")
+	if rl.Limit != 1500 || rl.Remaining != 1499 {
+		t.Errorf("rate limit = %+v", rl)
+	}
+
+	env.fake.SetAPIKey("lin_api_other")
+	out = env.expectExit(exitAuth, "api", "rate-limit")
+	assertContains(t, out, "Failed to get rate limit")
+}
@@ -35,19 +35,17 @@ var issueAttachmentsListCmd = &cobra.Command{
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
 
-		authHeader, err := auth.GetAuthHeader()
+		client, err := auth.NewClient()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitAuth)
 		}
 
-		client := api.NewClient(authHeader)
-
 		first, _ := cmd.Flags().GetInt("limit")
 		issue, err := client.GetIssueAttachments(context.Background(), args[0], first)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to get attachments: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCodeFor(err))
 		}
 
 		attachments := []api.Attachment{}
@@ -97,14 +95,12 @@ By default, downloads all attachments. Use --id to download only specific attach
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
 
-		authHeader, err := auth.GetAuthHeader()
+		client, err := auth.NewClient()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitAuth)
 		}
 
-		client := api.NewClient(authHeader)
-
 		targetDir, _ := cmd.Flags().GetString("dir")
 		ids, _ := cmd.Flags().GetStringSlice("id")
 		limit, _ := cmd.Flags().GetInt("limit")
@@ -112,7 +108,7 @@ By default, downloads all attachments. Use --id to download only specific attach
 		issue, err := client.GetIssueAttachments(context.Background(), args[0], limit)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to get attachments: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCodeFor(err))
 		}
 
 		attachments := []api.Attachment{}
@@ -145,7 +141,13 @@ By default, downloads all attachments. Use --id to download only specific attach
 			os.Exit(1)
 		}
 
-		httpClient := &http.Client{Timeout: 60 * time.Second}
+		authHeader, err := client.AuthHeader(context.Background())
+		if err != nil {
+			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		httpClient := fileHTTPClient(client, 60*time.Second)
 		downloaded := []map[string]interface{}{}
 
 		for _, a := range selected {
@@ -186,21 +188,19 @@ var issueAttachmentsUploadCmd = &cobra.Command{
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
 
-		authHeader, err := auth.GetAuthHeader()
+		client, err := auth.NewClient()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitAuth)
 		}
 
-		client := api.NewClient(authHeader)
-
 		issueRef := args[0]
 		filePath := args[1]
 
 		info, err := os.Stat(filePath)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to read file: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCodeFor(err))
 		}
 		if info.IsDir() {
 			output.Error("File path is a directory", plaintext, jsonOut)
@@ -221,24 +221,24 @@ var issueAttachmentsUploadCmd = &cobra.Command{
 		issue, err := client.GetIssueAttachments(context.Background(), issueRef, 1)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to resolve issue: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCodeFor(err))
 		}
 
 		uploadFile, err := client.FileUpload(context.Background(), contentType, filename, info.Size())
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to request upload URL: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCodeFor(err))
 		}
 
-		if err := putFile(uploadFile.UploadURL, contentType, uploadFile.Headers, filePath); err != nil {
+		if err := putFile(fileHTTPClient(client, 120*time.Second), uploadFile.UploadURL, contentType, uploadFile.Headers, filePath); err != nil {
 			output.Error(fmt.Sprintf("Failed to upload file to Linear storage: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCodeFor(err))
 		}
 
 		attachment, err := client.AttachmentCreate(context.Background(), issue.ID, title, uploadFile.AssetURL)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to create attachment: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCodeFor(err))
 		}
 
 		if jsonOut {
@@ -336,7 +336,15 @@ func downloadAttachment(httpClient *http.Client, authHeader, targetDir, issueIde
 	return outPath, nil
 }
 
-func putFile(uploadURL, contentType string, headers []api.UploadHeader, filePath string) error {
+// fileHTTPClient returns a client for transferring attachment files that
+// goes through the API client's proxy and TLS settings
+func fileHTTPClient(client *api.Client, timeout time.Duration) *http.Client {
+	httpClient := *client.HTTPClient()
+	httpClient.Timeout = timeout
+	return &httpClient
+}
+
+func putFile(httpClient *http.Client, uploadURL, contentType string, headers []api.UploadHeader, filePath string) error {
 	f, err := os.Open(filePath)
 	if err != nil {
 		return err
@@ -357,7 +365,6 @@ func putFile(uploadURL, contentType string, headers []api.UploadHeader, filePath
 		req.Header.Set(h.Key, h.Value)
 	}
 
-	httpClient := &http.Client{Timeout: 120 * time.Second}
 	resp, err := httpClient.Do(req)
 	if err != nil {
 		return err
@@ -0,0 +1,123 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/yjiky/linctl/pkg/api"
+)
+
+// addAttachments attaches a stored screenshot and a log file to ENG-1
+func addAttachments(env *testEnv) (api.Attachment, api.Attachment) {
+	screenshot := env.fake.AddAttachment("ENG-1", api.Attachment{
+		Title: "Login screen",
+		URL:   env.fake.AddFile("login.png", "image/png", []byte("png data")),
+	})
+	log := env.fake.AddAttachment("ENG-1", api.Attachment{
+		Title: "server.log",
+		URL:   env.fake.AddFile("server.log", "text/plain", []byte("500 on /login\n")),
+	})
+	return screenshot, log
+}
+
+func TestIssueAttachmentsList(t *testing.T) {
+	env := newWorkspace(t)
+	screenshot, log := addAttachments(env)
+
+	var result struct {
+		Identifier       string           `json:"identifier"`
+		Attachments      []api.Attachment `json:"attachments"`
+		AttachmentsCount int              `json:"attachmentsCount"`
+	}
+	env.runJSON(&result, "issue", "attachments", "list", "ENG-1")
+	if result.Identifier != "ENG-1" || result.AttachmentsCount != 2 || len(result.Attachments) != 2 {
+		t.Fatalf("attachments list = %+v", result)
+	}
+
+	out := env.run("issue", "attachments", "list", "ENG-1", "--plaintext")
+	assertContains(t, out,
+		"# Attachments for ENG-1",
+		screenshot.ID+"\tLogin screen\t"+screenshot.URL,
+		log.ID+"\tserver.log\t"+log.URL,
+	)
+
+	env.runJSON(&result, "issue", "attachments", "list", "ENG-1", "--limit", "1")
+	if result.AttachmentsCount != 1 {
+		t.Errorf("--limit 1 returned %d attachments", result.AttachmentsCount)
+	}
+
+	env.expectExit(exitNotFound, "issue", "attachments", "list", "ENG-404")
+}
+
+func TestIssueAttachmentsDownload(t *testing.T) {
+	env := newWorkspace(t)
+	screenshot, log := addAttachments(env)
+	dir := filepath.Join(t.TempDir(), "attachments")
+
+	var result struct {
+		Downloaded []map[string]string `json:"downloaded"`
+		Count      int                 `json:"count"`
+		Directory  string              `json:"directory"`
+	}
+	env.runJSON(&result, "issue", "attachments", "download", "ENG-1", "--dir", dir)
+	if result.Count != 2 || result.Directory != dir {
+		t.Fatalf("download result = %+v", result)
+	}
+	for name, want := range map[string]string{
+		"ENG-1-" + screenshot.ID + "-Login-screen.png": "png data",
+		"ENG-1-" + log.ID + "-server.log":              "500 on /login\n",
+	} {
+		data, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil || string(data) != want {
+			t.Errorf("%s = %q, %v, want %q", name, data, err, want)
+		}
+	}
+
+	only := t.TempDir()
+	out := env.run("issue", "attachments", "download", "ENG-1", "--dir", only, "--id", log.ID, "--plaintext")
+	assertContains(t, out, "Downloaded 1 attachment(s) to "+only)
+	if entries, _ := os.ReadDir(only); len(entries) != 1 {
+		t.Errorf("--id downloaded %d files, want 1", len(entries))
+	}
+
+	out = env.expectExit(exitError, "issue", "attachments", "download", "ENG-1", "--dir", only, "--id", "no-such-attachment")
+	assertContains(t, out, "No matching attachments found for provided --id values.")
+}
+
+func TestIssueAttachmentsUpload(t *testing.T) {
+	env := newWorkspace(t)
+	dir := t.TempDir()
+	file := filepath.Join(dir, "trace.txt")
+	if err := os.WriteFile(file, []byte("stack trace"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	var result struct {
+		Identifier string         `json:"identifier"`
+		Attachment api.Attachment `json:"attachment"`
+	}
+	env.runJSON(&result, "issue", "attachments", "upload", "ENG-2", file)
+	if result.Identifier != "ENG-2" || result.Attachment.Title != "trace.txt" {
+		t.Fatalf("upload result = %+v", result)
+	}
+	upload, ok := env.fake.Upload(result.Attachment.URL)
+	if !ok || !upload.Uploaded || string(upload.Data) != "stack trace" || upload.Filename != "trace.txt" {
+		t.Errorf("upload = %+v, want the file's content stored", upload)
+	}
+	if got := upload.Header.Get("Content-Type"); got != "text/plain; charset=utf-8" {
+		t.Errorf("upload content type = %q", got)
+	}
+
+	out := env.run("issue", "attachments", "upload", "ENG-2", file, "--title", "Stack trace", "--plaintext")
+	assertContains(t, out, "Attached file to ENG-2: Stack trace")
+	if uploads := env.fake.Uploads(); len(uploads) != 2 {
+		t.Errorf("%d uploads, want 2", len(uploads))
+	}
+
+	out = env.expectExit(exitError, "issue", "attachments", "upload", "ENG-2", dir)
+	assertContains(t, out, "File path is a directory")
+	out = env.expectExit(exitError, "issue", "attachments", "upload", "ENG-2", filepath.Join(dir, "missing.txt"))
+	assertContains(t, out, "Failed to read file")
+	env.expectExit(exitNotFound, "issue", "attachments", "upload", "ENG-404", file)
+}
@@ -17,11 +17,25 @@ var authCmd = &cobra.Command{
 	Short: "Authenticate with Linear",
 	Long: `Authenticate with Linear using Personal API Key.
 
+Credentials are stored per profile, so you can stay logged in to several
+workspaces. Commands run against the current profile unless --profile or
+LINCTL_PROFILE selects another. LINEAR_API_KEY, when set, is used instead
+of any stored credentials.
+
+Credentials are kept in ~/.linctl-auth.json by default. Set auth.store in
+~/.linctl.yaml or LINCTL_CREDENTIAL_STORE to "keyring" (OS keyring) or
+"encrypted-file" (passphrase-encrypted ~/.linctl-auth.enc) to change that.
+
 Examples:
-  linctl auth              # Interactive authentication
-  linctl auth login        # Same as above
-  linctl auth status       # Check authentication status
-  linctl auth logout       # Clear stored credentials`,
+  linctl auth                          # Interactive authentication
+  linctl auth login                    # Same as above
+  linctl auth login --profile client   # Log in to another workspace
+  linctl auth login --oauth            # Log in through the browser with OAuth
+  linctl auth list                     # Show stored profiles
+  linctl auth switch client            # Make client the current profile
+  linctl auth status                   # Check authentication status
+  linctl auth logout                   # Clear the profile's credentials
+  linctl auth migrate keyring          # Move credentials into the OS keyring`,
 	Run: func(cmd *cobra.Command, args []string) {
 		// Default behavior is to run login
 		loginCmd.Run(cmd, args)
@@ -31,7 +45,18 @@ Examples:
 var loginCmd = &cobra.Command{
 	Use:   "login",
 	Short: "Login to Linear",
-	Long:  `Authenticate with Linear using Personal API Key.`,
+	Long: `Authenticate with Linear using a Personal API Key, or with --oauth through
+the browser. Credentials are stored under the profile selected with --profile
+(default: the current profile).
+
+OAuth login needs a Linear OAuth application whose callback URL is
+http://127.0.0.1:PORT/callback. The access token is refreshed automatically
+when it expires.
+
+Examples:
+  linctl auth login
+  linctl auth login --oauth --client-id abc123 --port 8976
+  LINCTL_OAUTH_CLIENT_ID=abc123 linctl auth login --oauth --scopes read,write,admin`,
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
@@ -41,21 +66,41 @@ var loginCmd = &cobra.Command{
 			fmt.Println()
 		}
 
-		err := auth.Login(plaintext, jsonOut)
+		var err error
+		if useOAuth, _ := cmd.Flags().GetBool("oauth"); useOAuth {
+			opts := auth.OAuthLoginOptions{}
+			opts.ClientID, _ = cmd.Flags().GetString("client-id")
+			opts.ClientSecret, _ = cmd.Flags().GetString("client-secret")
+			opts.Scopes, _ = cmd.Flags().GetStringSlice("scopes")
+			opts.Port, _ = cmd.Flags().GetInt("port")
+			opts.NoBrowser, _ = cmd.Flags().GetBool("no-browser")
+			err = auth.LoginOAuth(opts, plaintext, jsonOut)
+		} else {
+			err = auth.Login(plaintext, jsonOut)
+		}
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitAuth)
 		}
 
+		profile := auth.ActiveProfile()
 		if !plaintext && !jsonOut {
 			fmt.Println(color.New(color.FgGreen).Sprint("✅ Successfully authenticated with Linear!"))
+			if profile != currentProfileName() {
+				fmt.Printf("\n%s Saved as profile %s. Use --profile %s or run 'linctl auth switch %s' to use it.\n",
+					color.New(color.FgYellow).Sprint("ℹ️"),
+					color.New(color.FgCyan).Sprint(profile),
+					profile,
+					profile)
+			}
 		} else if jsonOut {
 			output.JSON(map[string]interface{}{
 				"status":  "success",
 				"message": "Successfully authenticated with Linear",
+				"profile": profile,
 			})
 		} else {
-			fmt.Println("Successfully authenticated with Linear")
+			fmt.Printf("Successfully authenticated with Linear (profile %s)\n", profile)
 		}
 	},
 }
@@ -80,20 +125,36 @@ var statusCmd = &cobra.Command{
 			} else {
 				fmt.Println("Not authenticated")
 			}
-			os.Exit(1)
+			os.Exit(exitCodeFor(err))
 		}
 
+		profile := auth.ActiveProfile()
+		source := auth.StoreLocation()
+		if auth.EnvAPIKey() != "" {
+			profile = ""
+			source = auth.APIKeyEnv
+		}
 		if jsonOut {
 			output.JSON(map[string]interface{}{
 				"authenticated": true,
+				"profile":       profile,
+				"credentials":   source,
 				"user":          user,
 			})
 		} else if plaintext {
 			fmt.Printf("Authenticated as: %s (%s)\n", user.Name, user.Email)
+			if profile != "" {
+				fmt.Printf("Profile: %s\n", profile)
+			}
+			fmt.Printf("Credentials: %s\n", source)
 		} else {
 			fmt.Println(color.New(color.FgGreen).Sprint("✅ Authenticated"))
 			fmt.Printf("User: %s\n", color.New(color.FgCyan).Sprint(user.Name))
 			fmt.Printf("Email: %s\n", color.New(color.FgCyan).Sprint(user.Email))
+			if profile != "" {
+				fmt.Printf("Profile: %s\n", color.New(color.FgCyan).Sprint(profile))
+			}
+			fmt.Printf("Credentials: %s\n", color.New(color.FgCyan).Sprint(source))
 		}
 	},
 }
@@ -101,7 +162,7 @@ var statusCmd = &cobra.Command{
 var logoutCmd = &cobra.Command{
 	Use:   "logout",
 	Short: "Logout from Linear",
-	Long:  `Clear stored Linear credentials.`,
+	Long:  `Clear the stored Linear credentials of the selected profile.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
@@ -109,7 +170,7 @@ var logoutCmd = &cobra.Command{
 		err := auth.Logout()
 		if err != nil {
 			output.Error(fmt.Sprintf("Logout failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCodeFor(err))
 		}
 
 		if jsonOut {
@@ -125,6 +186,165 @@ var logoutCmd = &cobra.Command{
 	},
 }
 
+var authListCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls", "profiles"},
+	Short:   "List auth profiles",
+	Long: `List stored auth profiles with the user each one is logged in as. The
+current profile is marked with *.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		profiles, err := auth.ListProfiles()
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to list profiles: %v", err), plaintext, jsonOut)
+			os.Exit(exitCodeFor(err))
+		}
+
+		if jsonOut {
+			output.JSON(profiles)
+			return
+		}
+		if len(profiles) == 0 {
+			output.Info("No profiles found. Run 'linctl auth login' to create one.", plaintext, jsonOut)
+			return
+		}
+
+		headers := []string{"", "Profile", "Method", "User", "Email"}
+		rows := [][]string{}
+		for _, profile := range profiles {
+			marker := ""
+			name := profile.Name
+			if profile.Current {
+				marker = "*"
+				if !plaintext {
+					marker = color.New(color.FgGreen).Sprint("*")
+					name = color.New(color.FgCyan, color.Bold).Sprint(name)
+				}
+			}
+			userName, email := "", ""
+			if profile.User != nil {
+				userName, email = profile.User.Name, profile.User.Email
+			}
+			rows = append(rows, []string{marker, name, profile.Method, userName, email})
+		}
+
+		output.Table(output.TableData{
+			Headers: headers,
+			Rows:    rows,
+		}, plaintext, jsonOut)
+
+		if !plaintext {
+			fmt.Printf("\n%s %d profiles\n",
+				color.New(color.FgGreen).Sprint("✓"),
+				len(profiles))
+		}
+	},
+}
+
+var authSwitchCmd = &cobra.Command{
+	Use:   "switch PROFILE",
+	Short: "Switch the current auth profile",
+	Long: `Make PROFILE the current profile, used by every command that is not
+given --profile or LINCTL_PROFILE.
+
+Examples:
+  linctl auth switch client
+  linctl auth switch default`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		profile, err := auth.SwitchProfile(args[0])
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to switch profile: %v", err), plaintext, jsonOut)
+			os.Exit(exitCodeFor(err))
+		}
+
+		if jsonOut {
+			output.JSON(profile)
+			return
+		}
+
+		user := ""
+		if profile.User != nil {
+			user = fmt.Sprintf(" (%s)", profile.User.Email)
+		}
+		if plaintext {
+			fmt.Printf("Switched to profile %s%s\n", profile.Name, user)
+		} else {
+			fmt.Printf("%s Switched to profile %s%s\n",
+				color.New(color.FgGreen).Sprint("✓"),
+				color.New(color.FgCyan, color.Bold).Sprint(profile.Name),
+				user)
+		}
+	},
+}
+
+var authMigrateCmd = &cobra.Command{
+	Use:   "migrate STORE",
+	Short: "Move stored credentials to another credential store",
+	Long: `Move every stored profile into another credential store and remove it
+from the current one. Stores: file, keyring, encrypted-file.
+
+Afterwards, set auth.store in ~/.linctl.yaml (or LINCTL_CREDENTIAL_STORE) to
+the new store so linctl reads credentials from it.
+
+Examples:
+  linctl auth migrate keyring
+  LINCTL_AUTH_PASSPHRASE=... linctl auth migrate encrypted-file
+  LINCTL_CREDENTIAL_STORE=keyring linctl auth migrate file`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		source := auth.StoreLocation()
+		target, err := auth.MigrateStore(args[0])
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to migrate credentials: %v", err), plaintext, jsonOut)
+			os.Exit(exitCodeFor(err))
+		}
+
+		if jsonOut {
+			output.JSON(map[string]interface{}{
+				"store":    target.Name(),
+				"location": target.Location(),
+				"from":     source,
+			})
+		} else if plaintext {
+			fmt.Printf("Moved credentials from %s to %s\n", source, target.Location())
+			fmt.Printf("Set auth.store: %s in ~/.linctl.yaml or LINCTL_CREDENTIAL_STORE=%s to use it\n", target.Name(), target.Name())
+		} else {
+			fmt.Printf("%s Moved credentials from %s to %s\n",
+				color.New(color.FgGreen).Sprint("✓"),
+				source,
+				color.New(color.FgCyan).Sprint(target.Location()))
+			fmt.Printf("\n%s Set %s in ~/.linctl.yaml or LINCTL_CREDENTIAL_STORE=%s to use it\n",
+				color.New(color.FgYellow).Sprint("ℹ️"),
+				color.New(color.FgCyan).Sprintf("auth.store: %s", target.Name()),
+				target.Name())
+		}
+	},
+}
+
+// currentProfileName returns the profile stored as current, ignoring
+// --profile and LINCTL_PROFILE
+func currentProfileName() string {
+	profiles, err := auth.ListProfiles()
+	if err != nil {
+		return ""
+	}
+	for _, profile := range profiles {
+		if profile.Current {
+			return profile.Name
+		}
+	}
+	return ""
+}
+
 var whoamiCmd = &cobra.Command{
 	Use:   "whoami",
 	Short: "Show current user",
@@ -139,6 +359,16 @@ func init() {
 	authCmd.AddCommand(loginCmd)
 	authCmd.AddCommand(statusCmd)
 	authCmd.AddCommand(logoutCmd)
+	authCmd.AddCommand(authListCmd)
+	authCmd.AddCommand(authSwitchCmd)
+	authCmd.AddCommand(authMigrateCmd)
+
+	loginCmd.Flags().Bool("oauth", false, "Log in through the browser with OAuth instead of an API key")
+	loginCmd.Flags().String("client-id", "", "OAuth application client ID (env LINCTL_OAUTH_CLIENT_ID)")
+	loginCmd.Flags().String("client-secret", "", "OAuth application client secret, if required (env LINCTL_OAUTH_CLIENT_SECRET)")
+	loginCmd.Flags().StringSlice("scopes", nil, "OAuth scopes to request (default read,write)")
+	loginCmd.Flags().Int("port", 0, "Local callback port for OAuth (default: any free port)")
+	loginCmd.Flags().Bool("no-browser", false, "Print the OAuth authorize URL instead of opening a browser")
 
 	// Add whoami as a top-level command too
 	rootCmd.AddCommand(whoamiCmd)
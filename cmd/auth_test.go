@@ -0,0 +1,174 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/yjiky/linctl/pkg/auth"
+)
+
+// login stores an API key under profile through 'auth login', reading the
+// key from stdin as a user would type it
+func (e *testEnv) login(profile, apiKey string) string {
+	e.t.Helper()
+	e.t.Setenv("LINEAR_API_KEY", "")
+	return e.runWithInput(apiKey+"\n", "auth", "login", "--profile", profile, "--plaintext")
+}
+
+func TestAuthLogin(t *testing.T) {
+	env := newWorkspace(t)
+
+	out := env.login("default", "lin_api_alice")
+	assertContains(t, out, "Successfully authenticated with Linear (profile default)")
+	if _, err := os.Stat(filepath.Join(env.home, ".linctl-auth.json")); err != nil {
+		t.Fatalf("credentials were not stored: %v", err)
+	}
+
+	env.run("whoami")
+	req, _ := env.fake.LastRequest("Me")
+	if got := req.Header.Get("Authorization"); got != "lin_api_alice" {
+		t.Errorf("Authorization = %q, want the stored key", got)
+	}
+
+	env.fake.SetAPIKey("lin_api_alice")
+	_, stderr, code := env.runExitWithInput("lin_api_revoked\n", "auth", "login", "--profile", "other", "--plaintext")
+	if code != exitAuth {
+		t.Errorf("login with a rejected key exited with %d, want %d", code, exitAuth)
+	}
+	assertContains(t, stderr, "invalid API key")
+
+	_, stderr, code = env.runExitWithInput("\n", "auth", "login", "--plaintext")
+	if code != exitAuth {
+		t.Errorf("login without a key exited with %d, want %d", code, exitAuth)
+	}
+	assertContains(t, stderr, "API key cannot be empty")
+}
+
+func TestAuthStatus(t *testing.T) {
+	env := newWorkspace(t)
+
+	out := env.run("auth", "status", "--plaintext")
+	assertContains(t, out, "Authenticated as: Alice Smith (alice@example.com)", "Credentials: LINEAR_API_KEY")
+
+	env.login("default", "lin_api_alice")
+	out = env.run("whoami", "--plaintext")
+	assertContains(t, out, "Profile: default", "Credentials: "+filepath.Join(env.home, ".linctl-auth.json"))
+
+	var status struct {
+		Authenticated bool   `json:"authenticated"`
+		Profile       string `json:"profile"`
+	}
+	env.runJSON(&status, "auth", "status")
+	if !status.Authenticated || status.Profile != "default" {
+		t.Errorf("auth status = %+v", status)
+	}
+
+	env.run("auth", "logout")
+	stdout, _, code := env.runExit("auth", "status", "--json")
+	if code != exitAuth {
+		t.Errorf("status after logout exited with %d, want %d", code, exitAuth)
+	}
+	decodeJSON(t, stdout, &status)
+	if status.Authenticated {
+		t.Error("status reports authenticated after logout")
+	}
+}
+
+func TestAuthProfiles(t *testing.T) {
+	env := newWorkspace(t)
+	env.login("default", "lin_api_alice")
+	env.fake.SetViewer("bob@example.com")
+	out := env.login("work", "lin_api_bob")
+	assertContains(t, out, "(profile work)")
+
+	var profiles []auth.ProfileInfo
+	env.runJSON(&profiles, "auth", "list")
+	if len(profiles) != 2 || !profiles[0].Current || profiles[1].Current || profiles[1].User == nil || profiles[1].User.Email != "bob@example.com" {
+		t.Errorf("auth list = %+v, want default current and work as bob", profiles)
+	}
+
+	out = env.run("auth", "switch", "work", "--plaintext")
+	assertContains(t, out, "Switched to profile work (bob@example.com)")
+	out = env.run("auth", "profiles", "--plaintext")
+	assertContains(t, out, "*\twork\tapi_key\tBob Jones\tbob@example.com")
+
+	env.run("whoami", "--profile", "default")
+	req, _ := env.fake.LastRequest("Me")
+	if got := req.Header.Get("Authorization"); got != "lin_api_alice" {
+		t.Errorf("--profile default sent %q, want alice's key", got)
+	}
+	t.Setenv("LINCTL_PROFILE", "default")
+	env.run("whoami")
+	req, _ = env.fake.LastRequest("Me")
+	if got := req.Header.Get("Authorization"); got != "lin_api_alice" {
+		t.Errorf("LINCTL_PROFILE=default sent %q, want alice's key", got)
+	}
+	t.Setenv("LINCTL_PROFILE", "")
+
+	out = env.expectExit(exitError, "auth", "switch", "client")
+	assertContains(t, out, `profile "client" not found`)
+}
+
+func TestAuthLogout(t *testing.T) {
+	env := newWorkspace(t)
+	env.login("default", "lin_api_alice")
+	env.login("work", "lin_api_bob")
+
+	out := env.run("auth", "logout", "--profile", "default", "--plaintext")
+	assertContains(t, out, "Successfully logged out")
+
+	var profiles []auth.ProfileInfo
+	env.runJSON(&profiles, "auth", "list")
+	if len(profiles) != 1 || profiles[0].Name != "work" || !profiles[0].Current {
+		t.Errorf("profiles after logout = %+v, want work as the current profile", profiles)
+	}
+
+	env.run("auth", "logout")
+	if _, err := os.Stat(filepath.Join(env.home, ".linctl-auth.json")); !os.IsNotExist(err) {
+		t.Errorf("credentials file remains after the last logout: %v", err)
+	}
+	out = env.run("auth", "list", "--plaintext")
+	assertContains(t, out, "No profiles found")
+}
+
+func TestAuthMigrate(t *testing.T) {
+	env := newWorkspace(t)
+	env.login("default", "lin_api_alice")
+	t.Setenv(auth.PassphraseEnv, "correct horse battery staple")
+
+	out := env.run("auth", "migrate", "encrypted-file", "--plaintext")
+	assertContains(t, out,
+		"Moved credentials from "+filepath.Join(env.home, ".linctl-auth.json")+" to "+filepath.Join(env.home, ".linctl-auth.enc"),
+		"Set auth.store: encrypted-file in ~/.linctl.yaml or LINCTL_CREDENTIAL_STORE=encrypted-file to use it",
+	)
+	if _, err := os.Stat(filepath.Join(env.home, ".linctl-auth.json")); !os.IsNotExist(err) {
+		t.Errorf("plaintext credentials remain after migrating: %v", err)
+	}
+
+	env.run("config", "set", "auth.store", "encrypted-file")
+	var status struct {
+		Credentials string `json:"credentials"`
+	}
+	env.runJSON(&status, "auth", "status")
+	if status.Credentials != filepath.Join(env.home, ".linctl-auth.enc") {
+		t.Errorf("credentials = %q, want the encrypted file", status.Credentials)
+	}
+
+	var migrated map[string]string
+	env.runJSON(&migrated, "auth", "migrate", "file")
+	want := map[string]string{
+		"store":    "file",
+		"location": filepath.Join(env.home, ".linctl-auth.json"),
+		"from":     filepath.Join(env.home, ".linctl-auth.enc"),
+	}
+	if !reflect.DeepEqual(migrated, want) {
+		t.Errorf("migrate = %v, want %v", migrated, want)
+	}
+
+	env.run("config", "unset", "auth.store")
+	out = env.expectExit(exitError, "auth", "migrate", "file")
+	assertContains(t, out, "credentials are already in the file store")
+	env.expectExit(exitError, "auth", "migrate", "clipboard")
+}
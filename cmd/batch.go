@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/yjiky/linctl/pkg/output"
+	"github.com/fatih/color"
+)
+
+// batchResult records the outcome of an action on one of several references,
+// such as archiving an issue or deleting a comment
+type batchResult struct {
+	Identifier string `json:"identifier"`
+	Success    bool   `json:"success"`
+	Error      string `json:"error,omitempty"`
+}
+
+// runBatchAction applies action to each reference, prints a per-reference
+// report, and exits non-zero if any of them failed
+func runBatchAction(refs []string, verb string, action func(ctx context.Context, ref string) error, plaintext, jsonOut bool) {
+	results := make([]batchResult, 0, len(refs))
+	var lastErr error
+
+	for _, ref := range refs {
+		result := batchResult{Identifier: ref, Success: true}
+		if err := action(context.Background(), ref); err != nil {
+			result.Success = false
+			result.Error = err.Error()
+			lastErr = err
+		}
+		results = append(results, result)
+	}
+
+	if jsonOut {
+		output.JSON(results)
+	} else {
+		for _, result := range results {
+			if result.Success {
+				if plaintext {
+					fmt.Printf("%s %s\n", verb, result.Identifier)
+				} else {
+					fmt.Printf("%s %s %s\n",
+						color.New(color.FgGreen).Sprint("✓"),
+						verb,
+						color.New(color.FgCyan, color.Bold).Sprint(result.Identifier))
+				}
+			} else {
+				output.Error(fmt.Sprintf("%s: %s", result.Identifier, result.Error), plaintext, jsonOut)
+			}
+		}
+	}
+
+	if lastErr != nil {
+		os.Exit(exitCodeFor(lastErr))
+	}
+}
@@ -19,36 +19,36 @@ import (
 var commentCmd = &cobra.Command{
 	Use:   "comment",
 	Short: "Manage issue comments",
-	Long: `Manage comments on Linear issues including listing and creating comments.
+	Long: `Manage comments on Linear issues: list threads, comment, reply, edit,
+delete, resolve threads, and react.
 
 Examples:
-  linctl comment list LIN-123        # List comments for an issue
-  linctl comment create LIN-123 --body "This is fixed"  # Add a comment`,
+  linctl comment list LIN-123        # List comment threads for an issue
+  linctl comment create LIN-123 --body "This is fixed"  # Add a comment
+  linctl comment reply COMMENT-ID --body "Agreed"       # Reply in a thread
+  linctl comment react COMMENT-ID 👍                    # React to a comment`,
 }
 
 var commentListCmd = &cobra.Command{
 	Use:     "list ISSUE-ID",
 	Aliases: []string{"ls"},
 	Short:   "List comments for an issue",
-	Long:    `List all comments for a specific issue.`,
+	Long:    `List all comments for a specific issue, with replies nested under their thread.`,
 	Args:    cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
 		issueID := args[0]
 
-		// Get auth header
-		authHeader, err := auth.GetAuthHeader()
+		// Create API client
+		client, err := auth.NewClient()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitAuth)
 		}
 
-		// Create API client
-		client := api.NewClient(authHeader)
-
 		// Get limit
-		limit, _ := cmd.Flags().GetInt("limit")
+		limit := resolveListLimit(cmd)
 
 		// Get sort option
 		sortBy, _ := cmd.Flags().GetString("sort")
@@ -69,23 +69,24 @@ var commentListCmd = &cobra.Command{
 		}
 
 		// Get comments
-		comments, err := client.GetIssueComments(context.Background(), issueID, limit, "", orderBy)
+		paginator := api.NewPaginator(client.IssueCommentPages(issueID, orderBy), limit)
+		nodes, err := paginator.All(context.Background())
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to list comments: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCodeFor(err))
 		}
+		comments := &api.Comments{Nodes: nodes, PageInfo: paginator.PageInfo()}
 
 		// Handle output
 		if jsonOut {
 			output.JSON(comments.Nodes)
 		} else if plaintext {
-			for i, comment := range comments.Nodes {
+			roots, replies := commentThreads(comments.Nodes)
+			for i, comment := range roots {
 				if i > 0 {
 					fmt.Println("---")
 				}
-				fmt.Printf("Author: %s\n", comment.User.Name)
-				fmt.Printf("Date: %s\n", comment.CreatedAt.Format("2006-01-02 15:04:05"))
-				fmt.Printf("Comment:\n%s\n", comment.Body)
+				printCommentThread(comment, replies, 0, plaintext)
 			}
 		} else {
 			// Rich display
@@ -101,20 +102,12 @@ var commentListCmd = &cobra.Command{
 				color.New(color.FgCyan).Sprint(issueID),
 				len(comments.Nodes))
 
-			for i, comment := range comments.Nodes {
+			roots, replies := commentThreads(comments.Nodes)
+			for i, comment := range roots {
 				if i > 0 {
 					fmt.Println(strings.Repeat("─", 50))
 				}
-
-				// Header with author and time
-				timeAgo := formatTimeAgo(comment.CreatedAt)
-				fmt.Printf("%s %s %s\n",
-					color.New(color.FgCyan, color.Bold).Sprint(comment.User.Name),
-					color.New(color.FgWhite, color.Faint).Sprint("•"),
-					color.New(color.FgWhite, color.Faint).Sprint(timeAgo))
-
-				// Comment body
-				fmt.Printf("\n%s\n\n", comment.Body)
+				printCommentThread(comment, replies, 0, plaintext)
 			}
 		}
 	},
@@ -124,27 +117,38 @@ var commentCreateCmd = &cobra.Command{
 	Use:     "create ISSUE-ID",
 	Aliases: []string{"add", "new"},
 	Short:   "Create a comment on an issue",
-	Long:    `Add a new comment to a specific issue.`,
-	Args:    cobra.ExactArgs(1),
+	Long: `Add a new comment to a specific issue.
+
+Without --body on a terminal, or with --editor, the comment is written in
+$VISUAL or $EDITOR. An empty comment is not posted.
+
+Examples:
+  linctl comment create LIN-123 --body "Fixed in #456"
+  linctl comment create LIN-123 --editor`,
+	Args: cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
 		issueID := args[0]
 
-		// Get auth header
-		authHeader, err := auth.GetAuthHeader()
+		// Create API client
+		client, err := auth.NewClient()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitAuth)
 		}
 
-		// Create API client
-		client := api.NewClient(authHeader)
-
-		// Get comment body
+		// Get comment body, from $EDITOR if it was not given
 		body, _ := cmd.Flags().GetString("body")
-		if body == "" {
-			output.Error("Comment body is required (--body)", plaintext, jsonOut)
+		if useEditor(cmd, "body", plaintext, jsonOut) {
+			body, err = editText(body, "linctl-comment-*.md")
+			if err != nil {
+				output.Error(err.Error(), plaintext, jsonOut)
+				os.Exit(1)
+			}
+		}
+		if strings.TrimSpace(body) == "" {
+			output.Error("Comment body is required (--body or --editor)", plaintext, jsonOut)
 			os.Exit(1)
 		}
 
@@ -152,7 +156,7 @@ var commentCreateCmd = &cobra.Command{
 		comment, err := client.CreateComment(context.Background(), issueID, body)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to create comment: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCodeFor(err))
 		}
 
 		// Handle output
@@ -216,10 +220,11 @@ func init() {
 	commentCmd.AddCommand(commentCreateCmd)
 
 	// List command flags
-	commentListCmd.Flags().IntP("limit", "l", 50, "Maximum number of comments to return")
+	commentListCmd.Flags().IntP("limit", "l", 50, "Maximum number of comments to return (pages automatically)")
+	commentListCmd.Flags().Bool("all", false, "Fetch every comment, ignoring --limit")
 	commentListCmd.Flags().StringP("sort", "o", "linear", "Sort order: linear (default), created, updated")
 
 	// Create command flags
-	commentCreateCmd.Flags().StringP("body", "b", "", "Comment body (required)")
-	_ = commentCreateCmd.MarkFlagRequired("body")
+	commentCreateCmd.Flags().StringP("body", "b", "", "Comment body (opens $EDITOR when omitted on a terminal)")
+	commentCreateCmd.Flags().BoolP("editor", "e", false, "Write the comment in $VISUAL/$EDITOR")
 }
@@ -0,0 +1,190 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/yjiky/linctl/pkg/api"
+)
+
+// addThread adds a comment by alice on ENG-1 with a reply from bob, returning
+// both
+func addThread(env *testEnv) (api.Comment, api.Comment) {
+	start := time.Now().Add(-time.Hour).UTC()
+	root := env.fake.AddComment("ENG-1", api.Comment{Body: "Can you reproduce this?", CreatedAt: start})
+	reply := env.fake.AddComment("ENG-1", api.Comment{
+		Body:      "Yes, on staging",
+		User:      &api.User{Email: "bob@example.com"},
+		Parent:    &api.Comment{ID: root.ID},
+		CreatedAt: start.Add(time.Minute),
+	})
+	return root, reply
+}
+
+func TestCommentList(t *testing.T) {
+	env := newWorkspace(t)
+	root, reply := addThread(env)
+	env.fake.AddComment("ENG-1", api.Comment{Body: "Fixed in #42", Reactions: []api.Reaction{{Emoji: "🎉"}}})
+
+	var comments []api.Comment
+	env.runJSON(&comments, "comment", "list", "ENG-1")
+	if len(comments) != 3 {
+		t.Fatalf("comment list returned %d comments, want 3", len(comments))
+	}
+
+	out := env.run("comment", "list", "ENG-1", "--plaintext")
+	assertContains(t, out,
+		"ID: "+root.ID+"\nAuthor: Alice Smith",
+		"  ID: "+reply.ID+"\n  Author: Bob Jones",
+		"  Comment:\n  Yes, on staging",
+		"---",
+		"Reactions: 🎉 1",
+	)
+
+	out = env.run("comment", "ls", "ENG-2")
+	assertContains(t, out, "No comments on issue ENG-2")
+
+	env.expectExit(exitNotFound, "comment", "list", "ENG-404")
+	env.expectExit(exitError, "comment", "list", "ENG-1", "--sort", "oldest")
+}
+
+func TestCommentCreate(t *testing.T) {
+	env := newWorkspace(t)
+
+	var comment api.Comment
+	env.runJSON(&comment, "comment", "create", "ENG-1", "--body", "Looking into it")
+	if comment.Body != "Looking into it" || comment.User == nil || comment.User.Email != "alice@example.com" {
+		t.Errorf("created comment = %+v", comment)
+	}
+	if got := env.issue("ENG-1").Comments; got == nil || len(got.Nodes) != 1 {
+		t.Errorf("ENG-1 comments = %+v, want the new comment", got)
+	}
+
+	out := env.run("comment", "add", "ENG-2", "-b", "Plain", "--plaintext")
+	assertContains(t, out, "Created comment on ENG-2", "Author: Alice Smith")
+
+	t.Setenv("VISUAL", writeScript(t, "editor", `printf 'Written in the editor\n' > "$1"`))
+	env.runJSON(&comment, "comment", "create", "ENG-2", "--editor")
+	if comment.Body != "Written in the editor" {
+		t.Errorf("comment body = %q, want the editor's text", comment.Body)
+	}
+
+	out = env.expectExit(exitError, "comment", "create", "ENG-1", "--body", "  ")
+	assertContains(t, out, "Comment body is required")
+	env.expectExit(exitNotFound, "comment", "create", "ENG-404", "--body", "Hello")
+}
+
+func TestCommentReply(t *testing.T) {
+	env := newWorkspace(t)
+	root, reply := addThread(env)
+
+	var created api.Comment
+	env.runJSON(&created, "comment", "reply", reply.ID, "--body", "Thanks, fixing")
+	if created.Parent == nil || created.Parent.ID != root.ID {
+		t.Errorf("reply to a reply has parent %+v, want the thread's root %s", created.Parent, root.ID)
+	}
+	if got := env.lastVariables("CreateComment")["input"].(map[string]interface{})["parentId"]; got != root.ID {
+		t.Errorf("parentId = %v, want %s", got, root.ID)
+	}
+
+	out := env.run("comment", "reply", root.ID, "--body", "Done", "--plaintext")
+	assertContains(t, out, "Replied with comment ")
+
+	out = env.expectExit(exitError, "comment", "reply", root.ID)
+	assertContains(t, out, "Reply body is required")
+	env.expectExit(exitNotFound, "comment", "reply", "00000000-0000-4000-8000-999999999999", "--body", "Hi")
+}
+
+func TestCommentEdit(t *testing.T) {
+	env := newWorkspace(t)
+	root, reply := addThread(env)
+
+	out := env.run("comment", "edit", root.ID, "--body", "Can anyone reproduce this?", "--plaintext")
+	assertContains(t, out, "Edited comment "+root.ID)
+	if edited, _ := env.fake.Comment(root.ID); edited.Body != "Can anyone reproduce this?" || edited.EditedAt == nil {
+		t.Errorf("edited comment = %q, edited at %v", edited.Body, edited.EditedAt)
+	}
+
+	out = env.run("comment", "update", root.ID, "--body", "Can anyone reproduce this?", "--plaintext")
+	assertContains(t, out, "Comment unchanged, nothing to update")
+
+	t.Setenv("EDITOR", writeScript(t, "editor", `printf '%s (edited)\n' "$(cat "$1")" > "$1"`))
+	env.run("comment", "edit", root.ID, "--editor")
+	if edited, _ := env.fake.Comment(root.ID); edited.Body != "Can anyone reproduce this? (edited)" {
+		t.Errorf("comment body = %q, want the current body edited", edited.Body)
+	}
+
+	out = env.expectExit(exitAuth, "comment", "edit", reply.ID, "--body", "Not mine")
+	assertContains(t, out, "Failed to edit comment")
+}
+
+func TestCommentDelete(t *testing.T) {
+	env := newWorkspace(t)
+	root, reply := addThread(env)
+	other := env.fake.AddComment("ENG-2", api.Comment{Body: "Duplicate of ENG-1"})
+
+	out := env.expectExit(exitError, "comment", "delete", other.ID)
+	assertContains(t, out, "pass --yes")
+
+	out = env.run("comment", "rm", other.ID, "--yes", "--plaintext")
+	assertContains(t, out, "Deleted comment "+other.ID)
+	if _, ok := env.fake.Comment(other.ID); ok {
+		t.Error("comment still exists after delete")
+	}
+
+	var results []batchResult
+	stdout, _, code := env.runExit("comment", "delete", reply.ID, root.ID, "--yes", "--json")
+	if code != exitAuth {
+		t.Errorf("deleting bob's reply exited with %d, want %d", code, exitAuth)
+	}
+	decodeJSON(t, stdout, &results)
+	if len(results) != 2 || results[0].Success || !results[1].Success {
+		t.Errorf("comment delete results = %+v", results)
+	}
+}
+
+func TestCommentResolveAndUnresolve(t *testing.T) {
+	env := newWorkspace(t)
+	root, reply := addThread(env)
+
+	out := env.run("comment", "resolve", root.ID, "--plaintext")
+	assertContains(t, out, "Resolved thread "+root.ID)
+	if resolved, _ := env.fake.Comment(root.ID); resolved.ResolvedAt == nil {
+		t.Error("thread was not resolved")
+	}
+
+	out = env.run("comment", "list", "ENG-1", "--plaintext")
+	assertContains(t, out, "Resolved: ")
+
+	out = env.run("comment", "unresolve", root.ID, "--plaintext")
+	assertContains(t, out, "Reopened thread "+root.ID)
+	if reopened, _ := env.fake.Comment(root.ID); reopened.ResolvedAt != nil {
+		t.Error("thread is still resolved")
+	}
+
+	out = env.expectExit(exitError, "comment", "resolve", reply.ID)
+	assertContains(t, out, reply.ID)
+}
+
+func TestCommentReact(t *testing.T) {
+	env := newWorkspace(t)
+	root, _ := addThread(env)
+
+	var reaction api.Reaction
+	env.runJSON(&reaction, "comment", "react", root.ID, "👍")
+	if reaction.Emoji != "👍" {
+		t.Errorf("reaction = %+v", reaction)
+	}
+	if comment, _ := env.fake.Comment(root.ID); len(comment.Reactions) != 1 {
+		t.Errorf("comment reactions = %+v, want one", comment.Reactions)
+	}
+
+	out := env.run("comment", "react", root.ID, "👍", "--remove", "--plaintext")
+	assertContains(t, out, "Removed 👍 from comment "+root.ID)
+	if comment, _ := env.fake.Comment(root.ID); len(comment.Reactions) != 0 {
+		t.Errorf("comment reactions = %+v, want none", comment.Reactions)
+	}
+
+	out = env.expectExit(exitNotFound, "comment", "react", root.ID, "🎉", "--remove")
+	assertContains(t, out, "you have not reacted 🎉 to this comment")
+}
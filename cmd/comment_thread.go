@@ -0,0 +1,452 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/yjiky/linctl/pkg/api"
+	"github.com/yjiky/linctl/pkg/auth"
+	"github.com/yjiky/linctl/pkg/output"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// commentThreads splits comments into thread roots and replies keyed by
+// parent ID. Replies whose parent is not in the list are shown as roots.
+func commentThreads(comments []api.Comment) ([]api.Comment, map[string][]api.Comment) {
+	present := make(map[string]bool, len(comments))
+	for _, comment := range comments {
+		present[comment.ID] = true
+	}
+
+	roots := []api.Comment{}
+	replies := make(map[string][]api.Comment)
+	for _, comment := range comments {
+		if comment.Parent != nil && present[comment.Parent.ID] {
+			replies[comment.Parent.ID] = append(replies[comment.Parent.ID], comment)
+		} else {
+			roots = append(roots, comment)
+		}
+	}
+
+	for id := range replies {
+		thread := replies[id]
+		sort.SliceStable(thread, func(i, j int) bool {
+			return thread[i].CreatedAt.Before(thread[j].CreatedAt)
+		})
+	}
+
+	return roots, replies
+}
+
+// reactionSummary groups reactions by emoji, e.g. "👍 2  🎉 1"
+func reactionSummary(reactions []api.Reaction) string {
+	order := []string{}
+	counts := make(map[string]int)
+	for _, reaction := range reactions {
+		if counts[reaction.Emoji] == 0 {
+			order = append(order, reaction.Emoji)
+		}
+		counts[reaction.Emoji]++
+	}
+
+	parts := make([]string, 0, len(order))
+	for _, emoji := range order {
+		parts = append(parts, fmt.Sprintf("%s %d", emoji, counts[emoji]))
+	}
+	return strings.Join(parts, "  ")
+}
+
+// commentAuthor returns the comment author's name, or "Unknown" for
+// comments posted by integrations
+func commentAuthor(comment api.Comment) string {
+	if comment.User != nil {
+		return comment.User.Name
+	}
+	return "Unknown"
+}
+
+// printCommentThread prints a comment and, indented below it, its replies
+func printCommentThread(comment api.Comment, replies map[string][]api.Comment, depth int, plaintext bool) {
+	indent := strings.Repeat("  ", depth)
+	indentBody := func(body string) string {
+		return indent + strings.ReplaceAll(body, "\n", "\n"+indent)
+	}
+
+	if plaintext {
+		fmt.Printf("%sID: %s\n", indent, comment.ID)
+		fmt.Printf("%sAuthor: %s\n", indent, commentAuthor(comment))
+		fmt.Printf("%sDate: %s\n", indent, comment.CreatedAt.Format("2006-01-02 15:04:05"))
+		if comment.ResolvedAt != nil {
+			fmt.Printf("%sResolved: %s\n", indent, comment.ResolvedAt.Format("2006-01-02 15:04:05"))
+		}
+		if len(comment.Reactions) > 0 {
+			fmt.Printf("%sReactions: %s\n", indent, reactionSummary(comment.Reactions))
+		}
+		fmt.Printf("%sComment:\n%s\n", indent, indentBody(comment.Body))
+	} else {
+		marker := ""
+		if depth > 0 {
+			marker = color.New(color.FgWhite, color.Faint).Sprint("↳ ")
+		}
+		header := fmt.Sprintf("%s%s%s %s %s",
+			indent,
+			marker,
+			color.New(color.FgCyan, color.Bold).Sprint(commentAuthor(comment)),
+			color.New(color.FgWhite, color.Faint).Sprint("•"),
+			color.New(color.FgWhite, color.Faint).Sprint(formatTimeAgo(comment.CreatedAt)))
+		if comment.EditedAt != nil {
+			header += color.New(color.FgWhite, color.Faint).Sprint(" (edited)")
+		}
+		if comment.ResolvedAt != nil {
+			header += " " + color.New(color.FgGreen).Sprint("✓ resolved")
+		}
+		header += " " + color.New(color.FgWhite, color.Faint).Sprint(comment.ID)
+		fmt.Println(header)
+
+		fmt.Printf("\n%s\n", indentBody(comment.Body))
+		if len(comment.Reactions) > 0 {
+			fmt.Printf("%s%s\n", indent, reactionSummary(comment.Reactions))
+		}
+		fmt.Println()
+	}
+
+	for _, reply := range replies[comment.ID] {
+		printCommentThread(reply, replies, depth+1, plaintext)
+	}
+}
+
+// readCommentBody returns --body, or opens $EDITOR on initial when --body is
+// omitted on a terminal or --editor is given
+func readCommentBody(cmd *cobra.Command, initial string, plaintext, jsonOut bool) (string, error) {
+	body, _ := cmd.Flags().GetString("body")
+	if useEditor(cmd, "body", plaintext, jsonOut) {
+		if body == "" {
+			body = initial
+		}
+		return editText(body, "linctl-comment-*.md")
+	}
+	return strings.TrimSpace(body), nil
+}
+
+// printCommentResult reports a created or edited comment
+func printCommentResult(verb string, comment *api.Comment, plaintext, jsonOut bool) {
+	if jsonOut {
+		output.JSON(comment)
+	} else if plaintext {
+		fmt.Printf("%s comment %s\n", verb, comment.ID)
+		if comment.URL != "" {
+			fmt.Printf("URL: %s\n", comment.URL)
+		}
+	} else {
+		fmt.Printf("%s %s comment %s\n",
+			color.New(color.FgGreen).Sprint("✓"),
+			verb,
+			color.New(color.FgWhite, color.Faint).Sprint(comment.ID))
+		fmt.Printf("\n%s\n", comment.Body)
+	}
+}
+
+var commentReplyCmd = &cobra.Command{
+	Use:   "reply COMMENT-ID",
+	Short: "Reply to a comment",
+	Long: `Reply to a comment, adding to its thread. Comment IDs are shown by
+'linctl comment list'.
+
+Without --body on a terminal, or with --editor, the reply is written in
+$VISUAL or $EDITOR.
+
+Examples:
+  linctl comment reply COMMENT-ID --body "Agreed, let's ship it"
+  linctl comment reply COMMENT-ID --editor`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		body, err := readCommentBody(cmd, "", plaintext, jsonOut)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(1)
+		}
+		if body == "" {
+			output.Error("Reply body is required (--body or --editor)", plaintext, jsonOut)
+			os.Exit(1)
+		}
+
+		client, err := auth.NewClient()
+		if err != nil {
+			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		ctx := context.Background()
+
+		parent, err := client.GetComment(ctx, args[0])
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to find comment: %v", err), plaintext, jsonOut)
+			os.Exit(exitCodeFor(err))
+		}
+		if parent.Issue == nil {
+			output.Error("Only comments on issues can be replied to", plaintext, jsonOut)
+			os.Exit(1)
+		}
+
+		// Replies always attach to the thread's top-level comment
+		parentID := parent.ID
+		if parent.Parent != nil && parent.Parent.ID != "" {
+			parentID = parent.Parent.ID
+		}
+
+		reply, err := client.CreateReply(ctx, parent.Issue.ID, parentID, body)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to reply: %v", err), plaintext, jsonOut)
+			os.Exit(exitCodeFor(err))
+		}
+
+		printCommentResult("Replied with", reply, plaintext, jsonOut)
+	},
+}
+
+var commentEditCmd = &cobra.Command{
+	Use:     "edit COMMENT-ID",
+	Aliases: []string{"update"},
+	Short:   "Edit a comment",
+	Long: `Replace a comment's body. Without --body, the current body is opened in
+$VISUAL or $EDITOR; nothing is changed if it comes back unchanged.
+
+Examples:
+  linctl comment edit COMMENT-ID --body "Fixed in #456"
+  linctl comment edit COMMENT-ID`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		client, err := auth.NewClient()
+		if err != nil {
+			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		ctx := context.Background()
+
+		current, err := client.GetComment(ctx, args[0])
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to find comment: %v", err), plaintext, jsonOut)
+			os.Exit(exitCodeFor(err))
+		}
+
+		body, err := readCommentBody(cmd, current.Body, plaintext, jsonOut)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(1)
+		}
+		if body == "" {
+			output.Error("Comment body is required (--body or --editor)", plaintext, jsonOut)
+			os.Exit(1)
+		}
+		if body == strings.TrimSpace(current.Body) {
+			output.Info("Comment unchanged, nothing to update", plaintext, jsonOut)
+			return
+		}
+
+		comment, err := client.UpdateComment(ctx, current.ID, body)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to edit comment: %v", err), plaintext, jsonOut)
+			os.Exit(exitCodeFor(err))
+		}
+
+		printCommentResult("Edited", comment, plaintext, jsonOut)
+	},
+}
+
+var commentDeleteCmd = &cobra.Command{
+	Use:     "delete COMMENT-ID...",
+	Aliases: []string{"rm"},
+	Short:   "Delete one or more comments",
+	Long: `Delete comments. You are asked to confirm unless --yes is given.
+
+Examples:
+  linctl comment delete COMMENT-ID
+  linctl comment delete COMMENT-ID OTHER-ID --yes`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		if yes, _ := cmd.Flags().GetBool("yes"); !yes {
+			confirmed, err := confirmAction(fmt.Sprintf("Delete %d comment(s)? This cannot be undone.", len(args)))
+			if err != nil {
+				output.Error(err.Error(), plaintext, jsonOut)
+				os.Exit(1)
+			}
+			if !confirmed {
+				output.Info("Aborted", plaintext, jsonOut)
+				return
+			}
+		}
+
+		client, err := auth.NewClient()
+		if err != nil {
+			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		runBatchAction(args, "Deleted comment", client.DeleteComment, plaintext, jsonOut)
+	},
+}
+
+var commentResolveCmd = &cobra.Command{
+	Use:   "resolve COMMENT-ID...",
+	Short: "Resolve comment threads",
+	Long: `Mark comment threads as resolved. Pass the thread's top-level comment.
+
+Examples:
+  linctl comment resolve COMMENT-ID`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		client, err := auth.NewClient()
+		if err != nil {
+			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		runBatchAction(args, "Resolved thread", client.ResolveComment, plaintext, jsonOut)
+	},
+}
+
+var commentUnresolveCmd = &cobra.Command{
+	Use:   "unresolve COMMENT-ID...",
+	Short: "Reopen resolved comment threads",
+	Long: `Reopen resolved comment threads.
+
+Examples:
+  linctl comment unresolve COMMENT-ID`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		client, err := auth.NewClient()
+		if err != nil {
+			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		runBatchAction(args, "Reopened thread", client.UnresolveComment, plaintext, jsonOut)
+	},
+}
+
+var commentReactCmd = &cobra.Command{
+	Use:   "react COMMENT-ID EMOJI",
+	Short: "React to a comment with an emoji",
+	Long: `Add an emoji reaction to a comment, or remove your own with --remove.
+
+Examples:
+  linctl comment react COMMENT-ID 👍
+  linctl comment react COMMENT-ID 🎉 --remove`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+		commentID, emoji := args[0], strings.TrimSpace(args[1])
+		remove, _ := cmd.Flags().GetBool("remove")
+
+		client, err := auth.NewClient()
+		if err != nil {
+			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		ctx := context.Background()
+
+		if !remove {
+			reaction, err := client.CreateReaction(ctx, commentID, emoji)
+			if err != nil {
+				output.Error(fmt.Sprintf("Failed to react: %v", err), plaintext, jsonOut)
+				os.Exit(exitCodeFor(err))
+			}
+
+			if jsonOut {
+				output.JSON(reaction)
+			} else if plaintext {
+				fmt.Printf("Reacted %s to comment %s\n", reaction.Emoji, commentID)
+			} else {
+				fmt.Printf("%s Reacted %s to comment %s\n",
+					color.New(color.FgGreen).Sprint("✓"),
+					reaction.Emoji,
+					color.New(color.FgWhite, color.Faint).Sprint(commentID))
+			}
+			return
+		}
+
+		viewer, err := client.GetViewer(ctx)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to get current user: %v", err), plaintext, jsonOut)
+			os.Exit(exitCodeFor(err))
+		}
+		comment, err := client.GetComment(ctx, commentID)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to find comment: %v", err), plaintext, jsonOut)
+			os.Exit(exitCodeFor(err))
+		}
+
+		reactionID := ""
+		for _, reaction := range comment.Reactions {
+			if reaction.Emoji == emoji && reaction.User != nil && reaction.User.ID == viewer.ID {
+				reactionID = reaction.ID
+				break
+			}
+		}
+		if reactionID == "" {
+			err := &api.Error{Code: api.CodeEntityNotFound, Message: fmt.Sprintf("you have not reacted %s to this comment", emoji)}
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitCodeFor(err))
+		}
+
+		if err := client.DeleteReaction(ctx, reactionID); err != nil {
+			output.Error(fmt.Sprintf("Failed to remove reaction: %v", err), plaintext, jsonOut)
+			os.Exit(exitCodeFor(err))
+		}
+
+		if jsonOut {
+			output.JSON(map[string]interface{}{
+				"removed": true,
+				"id":      reactionID,
+				"emoji":   emoji,
+			})
+		} else if plaintext {
+			fmt.Printf("Removed %s from comment %s\n", emoji, commentID)
+		} else {
+			fmt.Printf("%s Removed %s from comment %s\n",
+				color.New(color.FgGreen).Sprint("✓"),
+				emoji,
+				color.New(color.FgWhite, color.Faint).Sprint(commentID))
+		}
+	},
+}
+
+func init() {
+	commentCmd.AddCommand(commentReplyCmd)
+	commentCmd.AddCommand(commentEditCmd)
+	commentCmd.AddCommand(commentDeleteCmd)
+	commentCmd.AddCommand(commentResolveCmd)
+	commentCmd.AddCommand(commentUnresolveCmd)
+	commentCmd.AddCommand(commentReactCmd)
+
+	for _, c := range []*cobra.Command{commentReplyCmd, commentEditCmd} {
+		c.Flags().StringP("body", "b", "", "Comment body (opens $EDITOR when omitted on a terminal)")
+		c.Flags().BoolP("editor", "e", false, "Write the comment in $VISUAL/$EDITOR")
+	}
+
+	commentDeleteCmd.Flags().BoolP("yes", "y", false, "Skip the confirmation prompt")
+	commentReactCmd.Flags().Bool("remove", false, "Remove your reaction instead of adding it")
+}
@@ -0,0 +1,337 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/yjiky/linctl/pkg/config"
+	"github.com/yjiky/linctl/pkg/output"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v3"
+)
+
+// configFilePath returns the config file that 'config set' writes to
+func configFilePath() (string, error) {
+	if cfgFile != "" {
+		return cfgFile, nil
+	}
+	if used := viper.ConfigFileUsed(); used != "" {
+		return used, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".linctl.yaml"), nil
+}
+
+// readConfigDocument parses the config file, keeping its comments. A missing
+// file yields an empty document.
+func readConfigDocument(path string) (*yaml.Node, error) {
+	doc := &yaml.Node{Kind: yaml.DocumentNode}
+	data, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	if len(strings.TrimSpace(string(data))) > 0 {
+		if err := yaml.Unmarshal(data, doc); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %v", path, err)
+		}
+	}
+	if len(doc.Content) == 0 {
+		doc.Content = []*yaml.Node{{Kind: yaml.MappingNode, Tag: "!!map"}}
+	}
+	if doc.Content[0].Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("%s does not contain a YAML mapping", path)
+	}
+	return doc, nil
+}
+
+// writeConfigDocument writes the config file back
+func writeConfigDocument(path string, doc *yaml.Node) error {
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(doc); err != nil {
+		return err
+	}
+	if err := encoder.Close(); err != nil {
+		return err
+	}
+	return os.WriteFile(path, buf.Bytes(), 0600)
+}
+
+// setConfigValue sets a dotted key in a YAML mapping, creating nested
+// mappings as needed
+func setConfigValue(mapping *yaml.Node, path []string, value interface{}) error {
+	for i := 0; i < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value != path[0] {
+			continue
+		}
+		if len(path) == 1 {
+			return mapping.Content[i+1].Encode(value)
+		}
+		child := mapping.Content[i+1]
+		if child.Kind != yaml.MappingNode {
+			// Replace a scalar such as "limits: 20" with a nested mapping
+			*child = yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+		}
+		return setConfigValue(child, path[1:], value)
+	}
+
+	key := &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: path[0]}
+	child := &yaml.Node{}
+	if len(path) == 1 {
+		if err := child.Encode(value); err != nil {
+			return err
+		}
+	} else {
+		child.Kind = yaml.MappingNode
+		child.Tag = "!!map"
+		if err := setConfigValue(child, path[1:], value); err != nil {
+			return err
+		}
+	}
+	mapping.Content = append(mapping.Content, key, child)
+	return nil
+}
+
+// unsetConfigValue removes a dotted key from a YAML mapping, dropping
+// mappings it leaves empty. It reports whether the key was present.
+func unsetConfigValue(mapping *yaml.Node, path []string) bool {
+	for i := 0; i < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value != path[0] {
+			continue
+		}
+		child := mapping.Content[i+1]
+		if len(path) > 1 {
+			if child.Kind != yaml.MappingNode || !unsetConfigValue(child, path[1:]) {
+				return false
+			}
+			if len(child.Content) > 0 {
+				return true
+			}
+		}
+		mapping.Content = append(mapping.Content[:i], mapping.Content[i+2:]...)
+		return true
+	}
+	return false
+}
+
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "View and edit linctl settings",
+	Long: `View and edit the settings in ~/.linctl.yaml (or the file given by --config).
+
+Settings:
+  output             Default output format: table, plaintext or json
+  limit              Default number of results for list commands
+  limits.<command>   Default limit for one command, e.g. limits.issue.list
+  team               Default team key for commands with --team (--team "" skips it)
+  api.timeout        HTTP request timeout, e.g. 30s
+  api.retries        How often rate-limited and failed requests are retried
+  api.url            GraphQL endpoint to use instead of Linear's (or --api-url)
+  api.proxy          Proxy for API requests; HTTPS_PROXY is used when unset
+  api.ca_file        PEM bundle of extra CA certificates to trust
+  api.headers.<name> Header sent with every API request
+  auth.store         Credential store: file, keyring or encrypted-file
+
+Examples:
+  linctl config list
+  linctl config get api.timeout
+  linctl config set output json
+  linctl config set limits.issue.list 100
+  linctl config set api.headers.X-Request-Source ci
+  linctl config unset team`,
+}
+
+var configListCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "List settings and their effective values",
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		if jsonOut {
+			values := map[string]string{}
+			for _, entry := range settings.Entries() {
+				values[entry[0]] = entry[1]
+			}
+			output.JSON(values)
+			return
+		}
+
+		path, _ := configFilePath()
+		headers := []string{"Setting", "Value", "Source"}
+		rows := [][]string{}
+		for _, entry := range settings.Entries() {
+			source := "default"
+			if viper.InConfig(entry[0]) {
+				source = "config"
+			}
+			value := entry[1]
+			if !plaintext && value == "" {
+				value = color.New(color.FgWhite, color.Faint).Sprint("-")
+			}
+			rows = append(rows, []string{entry[0], value, source})
+		}
+
+		output.Table(output.TableData{
+			Headers: headers,
+			Rows:    rows,
+		}, plaintext, jsonOut)
+
+		if !plaintext {
+			fmt.Printf("\n%s Config file: %s\n",
+				color.New(color.FgYellow).Sprint("ℹ️"),
+				color.New(color.FgCyan).Sprint(path))
+		}
+	},
+}
+
+var configGetCmd = &cobra.Command{
+	Use:   "get KEY",
+	Short: "Print the effective value of a setting",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		key, err := config.LookupKey(args[0])
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(1)
+		}
+
+		value := settings.Value(key.Name)
+		if jsonOut {
+			output.JSON(map[string]interface{}{
+				"key":   key.Name,
+				"value": value,
+			})
+			return
+		}
+		fmt.Println(value)
+	},
+}
+
+var configSetCmd = &cobra.Command{
+	Use:   "set KEY VALUE",
+	Short: "Write a setting to the config file",
+	Args:  cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		key, err := config.LookupKey(args[0])
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(1)
+		}
+		value, err := key.Parse(args[1])
+		if err != nil {
+			output.Error(fmt.Sprintf("Invalid %s: %v", key.Name, err), plaintext, jsonOut)
+			os.Exit(1)
+		}
+
+		path, err := configFilePath()
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(1)
+		}
+		doc, err := readConfigDocument(path)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(1)
+		}
+		if err := setConfigValue(doc.Content[0], strings.Split(key.Name, "."), value); err != nil {
+			output.Error(fmt.Sprintf("Failed to set %s: %v", key.Name, err), plaintext, jsonOut)
+			os.Exit(1)
+		}
+		if err := writeConfigDocument(path, doc); err != nil {
+			output.Error(fmt.Sprintf("Failed to write %s: %v", path, err), plaintext, jsonOut)
+			os.Exit(1)
+		}
+
+		if jsonOut {
+			output.JSON(map[string]interface{}{
+				"key":   key.Name,
+				"value": value,
+				"file":  path,
+			})
+		} else if plaintext {
+			fmt.Printf("Set %s to %v in %s\n", key.Name, value, path)
+		} else {
+			fmt.Printf("%s Set %s to %s in %s\n",
+				color.New(color.FgGreen).Sprint("✓"),
+				color.New(color.FgCyan, color.Bold).Sprint(key.Name),
+				color.New(color.FgCyan).Sprint(value),
+				path)
+		}
+	},
+}
+
+var configUnsetCmd = &cobra.Command{
+	Use:     "unset KEY",
+	Aliases: []string{"rm"},
+	Short:   "Remove a setting from the config file, restoring its default",
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		key, err := config.LookupKey(args[0])
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(1)
+		}
+
+		path, err := configFilePath()
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(1)
+		}
+		doc, err := readConfigDocument(path)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(1)
+		}
+		if !unsetConfigValue(doc.Content[0], strings.Split(key.Name, ".")) {
+			output.Info(fmt.Sprintf("%s is not set in %s", key.Name, path), plaintext, jsonOut)
+			return
+		}
+		if err := writeConfigDocument(path, doc); err != nil {
+			output.Error(fmt.Sprintf("Failed to write %s: %v", path, err), plaintext, jsonOut)
+			os.Exit(1)
+		}
+
+		if jsonOut {
+			output.JSON(map[string]interface{}{
+				"key":   key.Name,
+				"unset": true,
+				"file":  path,
+			})
+		} else if plaintext {
+			fmt.Printf("Unset %s in %s\n", key.Name, path)
+		} else {
+			fmt.Printf("%s Unset %s in %s\n",
+				color.New(color.FgGreen).Sprint("✓"),
+				color.New(color.FgCyan, color.Bold).Sprint(key.Name),
+				path)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(configCmd)
+	configCmd.AddCommand(configListCmd)
+	configCmd.AddCommand(configGetCmd)
+	configCmd.AddCommand(configSetCmd)
+	configCmd.AddCommand(configUnsetCmd)
+}
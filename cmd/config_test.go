@@ -0,0 +1,117 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestConfigSetAndGet(t *testing.T) {
+	env := newTestEnv(t)
+	path := filepath.Join(env.home, ".linctl.yaml")
+	if err := os.WriteFile(path, []byte("# my settings\noutput: plaintext\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	out := env.run("config", "set", "limits.issue.list", "100", "--plaintext")
+	assertContains(t, out, "Set limits.issue.list to 100 in "+path)
+	env.run("config", "set", "api.timeout", "90")
+	env.run("config", "set", "team", "ENG")
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "# my settings\noutput: plaintext\nlimits:\n  issue:\n    list: 100\napi:\n  timeout: 1m30s\nteam: ENG\n"
+	if string(data) != want {
+		t.Errorf("config file =\n%s\nwant\n%s", data, want)
+	}
+
+	tests := map[string]string{
+		"output":            "plaintext",
+		"limit":             "50",
+		"limits.issue.list": "100",
+		"api.timeout":       "1m30s",
+		"API.Retries":       "3",
+		"team":              "ENG",
+	}
+	for key, value := range tests {
+		if got := strings.TrimSpace(env.run("config", "get", key)); got != value {
+			t.Errorf("config get %s = %q, want %q", key, got, value)
+		}
+	}
+
+	var setting map[string]string
+	env.runJSON(&setting, "config", "get", "auth.store")
+	if setting["key"] != "auth.store" || setting["value"] != "" {
+		t.Errorf("config get auth.store = %v", setting)
+	}
+
+	tests2 := []struct {
+		args []string
+		want string
+	}{
+		{[]string{"get", "colour"}, `unknown setting "colour"`},
+		{[]string{"set", "output", "yaml"}, `Invalid output: "yaml" is not one of table, plaintext, json`},
+		{[]string{"set", "limit", "0"}, `Invalid limit: "0" is not a positive number`},
+		{[]string{"set", "auth.store", "clipboard"}, "Invalid auth.store"},
+	}
+	for _, tt := range tests2 {
+		out := env.expectExit(exitError, append([]string{"config"}, tt.args...)...)
+		assertContains(t, out, tt.want)
+	}
+}
+
+func TestConfigList(t *testing.T) {
+	env := newTestEnv(t)
+	env.run("config", "set", "limit", "20")
+	env.run("config", "set", "api.headers.X-Request-Source", "ci")
+
+	var settings map[string]string
+	env.runJSON(&settings, "config", "list")
+	if settings["limit"] != "20" || settings["output"] != "table" || settings["api.headers.x-request-source"] != "ci" {
+		t.Errorf("config list = %v", settings)
+	}
+
+	out := env.run("config", "ls", "--plaintext")
+	assertContains(t, out, "Setting\tValue\tSource", "limit\t20\tconfig", "output\ttable\tdefault", "api.headers.x-request-source\tci\tconfig")
+}
+
+func TestConfigUnset(t *testing.T) {
+	env := newTestEnv(t)
+	env.run("config", "set", "team", "ENG")
+	env.run("config", "set", "limits.issue.list", "100")
+	path := filepath.Join(env.home, ".linctl.yaml")
+
+	out := env.run("config", "unset", "limits.issue.list", "--plaintext")
+	assertContains(t, out, "Unset limits.issue.list in "+path)
+	if data, _ := os.ReadFile(path); string(data) != "team: ENG\n" {
+		t.Errorf("config file = %q, want the empty limits mapping removed", data)
+	}
+
+	env.run("config", "rm", "team")
+	if got := strings.TrimSpace(env.run("config", "get", "team")); got != "" {
+		t.Errorf("team = %q after unset", got)
+	}
+
+	out = env.run("config", "unset", "team", "--plaintext")
+	assertContains(t, out, "team is not set in "+path)
+}
+
+func TestConfigFlag(t *testing.T) {
+	env := newTestEnv(t)
+	path := filepath.Join(t.TempDir(), "linctl.yaml")
+
+	env.run("config", "set", "output", "json", "--config", path)
+	if _, err := os.Stat(filepath.Join(env.home, ".linctl.yaml")); !os.IsNotExist(err) {
+		t.Errorf("--config wrote to the home config: %v", err)
+	}
+
+	// The setting applies at once, so get answers in JSON
+	var setting map[string]string
+	decodeJSON(t, env.run("config", "get", "output", "--config", path), &setting)
+	if setting["value"] != "json" {
+		t.Errorf("output = %q, want json from --config", setting["value"])
+	}
+}
@@ -0,0 +1,367 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/yjiky/linctl/pkg/api"
+	"github.com/yjiky/linctl/pkg/auth"
+	"github.com/yjiky/linctl/pkg/output"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// cycleCmd represents the cycle command
+var cycleCmd = &cobra.Command{
+	Use:     "cycle",
+	Aliases: []string{"cycles", "sprint"},
+	Short:   "Manage Linear cycles",
+	Long: `Manage Linear cycles (sprints) including listing a team's cycles and viewing the issues in a cycle.
+
+Cycles can be referenced as current, next, previous, a cycle number, or a cycle ID.
+
+Examples:
+  linctl cycle list --team ENG       # List ENG's cycles
+  linctl cycle current --team ENG    # Show the active cycle and its issues
+  linctl cycle get next --team ENG   # Show the upcoming cycle
+  linctl cycle get 42 --team ENG     # Show cycle 42`,
+}
+
+// cycleStatus describes where a cycle sits relative to today
+func cycleStatus(cycle api.Cycle) string {
+	switch {
+	case cycle.IsActive:
+		return "Active"
+	case cycle.IsNext:
+		return "Next"
+	case cycle.IsPrevious:
+		return "Previous"
+	case cycle.IsFuture:
+		return "Upcoming"
+	case cycle.CompletedAt != nil || cycle.IsPast:
+		return "Completed"
+	default:
+		return ""
+	}
+}
+
+// cycleDate trims a cycle timestamp down to its date
+func cycleDate(value string) string {
+	if len(value) >= 10 {
+		return value[:10]
+	}
+	return value
+}
+
+// resolveCycleID resolves a --cycle value to a cycle ID within a team, where
+// "none" clears the cycle
+func resolveCycleID(ctx context.Context, client *api.Client, teamKey, ref string) (interface{}, error) {
+	trimmed := strings.TrimSpace(ref)
+	if trimmed == "" || strings.EqualFold(trimmed, "none") {
+		return nil, nil
+	}
+
+	cycle, err := client.FindTeamCycle(ctx, teamKey, trimmed)
+	if err != nil {
+		return nil, err
+	}
+	return cycle.ID, nil
+}
+
+// printCycle prints a cycle's details followed by its issues
+func printCycle(cycle *api.Cycle, plaintext, jsonOut bool) {
+	if jsonOut {
+		output.JSON(cycle)
+		return
+	}
+
+	name := cycleDisplayName(cycle)
+	teamKey := ""
+	if cycle.Team != nil {
+		teamKey = cycle.Team.Key
+	}
+
+	issues := cycle.Issues
+	if issues == nil {
+		issues = &api.Issues{}
+	}
+
+	if plaintext {
+		fmt.Printf("# %s\n", name)
+		fmt.Printf("- **Number**: %d\n", cycle.Number)
+		if teamKey != "" {
+			fmt.Printf("- **Team**: %s\n", teamKey)
+		}
+		if status := cycleStatus(*cycle); status != "" {
+			fmt.Printf("- **Status**: %s\n", status)
+		}
+		fmt.Printf("- **Period**: %s to %s\n", cycleDate(cycle.StartsAt), cycleDate(cycle.EndsAt))
+		fmt.Printf("- **Progress**: %.0f%%\n", cycle.Progress*100)
+		if cycle.Description != nil && *cycle.Description != "" {
+			fmt.Printf("- **Description**: %s\n", *cycle.Description)
+		}
+		fmt.Println()
+		renderIssueCollection(issues, plaintext, jsonOut, "No issues in this cycle", "issues", "## Issues")
+		return
+	}
+
+	fmt.Println()
+	fmt.Printf("%s %s", color.New(color.FgMagenta, color.Bold).Sprint("🔄 Cycle:"), name)
+	if teamKey != "" {
+		fmt.Printf(" (%s)", color.New(color.FgCyan).Sprint(teamKey))
+	}
+	fmt.Println()
+	fmt.Println(strings.Repeat("─", 50))
+
+	if status := cycleStatus(*cycle); status != "" {
+		fmt.Printf("%s %s\n", color.New(color.Bold).Sprint("Status:"), status)
+	}
+	fmt.Printf("%s %s → %s\n", color.New(color.Bold).Sprint("Period:"), cycleDate(cycle.StartsAt), cycleDate(cycle.EndsAt))
+	fmt.Printf("%s %.0f%%\n", color.New(color.Bold).Sprint("Progress:"), cycle.Progress*100)
+	if cycle.Description != nil && *cycle.Description != "" {
+		fmt.Printf("\n%s\n%s\n", color.New(color.Bold).Sprint("Description:"), *cycle.Description)
+	}
+	fmt.Println()
+
+	renderIssueCollection(issues, plaintext, jsonOut, "No issues in this cycle", "issues", "")
+}
+
+var cycleListCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "List cycles",
+	Long:    `List cycles, most recent first, optionally limited to one team.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		client, err := auth.NewClient()
+		if err != nil {
+			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		limit := resolveListLimit(cmd)
+
+		filter := make(map[string]interface{})
+		if teamKey := teamKeyOrDefault(cmd); teamKey != "" {
+			filter["team"] = map[string]interface{}{"key": map[string]interface{}{"eq": teamKey}}
+		}
+
+		paginator := api.NewPaginator(client.CyclePages(filter), limit)
+		cycles, err := paginator.All(context.Background())
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to list cycles: %v", err), plaintext, jsonOut)
+			os.Exit(exitCodeFor(err))
+		}
+
+		sort.SliceStable(cycles, func(i, j int) bool {
+			return cycles[i].StartsAt > cycles[j].StartsAt
+		})
+
+		if jsonOut {
+			output.JSON(cycles)
+			return
+		}
+
+		if len(cycles) == 0 {
+			output.Info("No cycles found", plaintext, jsonOut)
+			return
+		}
+
+		if plaintext {
+			fmt.Println("Number\tName\tTeam\tStarts\tEnds\tProgress\tStatus")
+			for _, cycle := range cycles {
+				teamKey := ""
+				if cycle.Team != nil {
+					teamKey = cycle.Team.Key
+				}
+				fmt.Printf("%d\t%s\t%s\t%s\t%s\t%.0f%%\t%s\n",
+					cycle.Number,
+					cycle.Name,
+					teamKey,
+					cycleDate(cycle.StartsAt),
+					cycleDate(cycle.EndsAt),
+					cycle.Progress*100,
+					cycleStatus(cycle),
+				)
+			}
+			return
+		}
+
+		headers := []string{"Number", "Name", "Team", "Starts", "Ends", "Progress", "Status"}
+		rows := [][]string{}
+
+		for _, cycle := range cycles {
+			teamKey := ""
+			if cycle.Team != nil {
+				teamKey = cycle.Team.Key
+			}
+
+			status := cycleStatus(cycle)
+			switch status {
+			case "Active":
+				status = color.New(color.FgGreen, color.Bold).Sprint(status)
+			case "Next", "Upcoming":
+				status = color.New(color.FgBlue).Sprint(status)
+			case "Previous", "Completed":
+				status = color.New(color.FgWhite, color.Faint).Sprint(status)
+			}
+
+			rows = append(rows, []string{
+				color.New(color.FgMagenta, color.Bold).Sprintf("%d", cycle.Number),
+				truncateString(cycle.Name, 30),
+				color.New(color.FgCyan).Sprint(teamKey),
+				cycleDate(cycle.StartsAt),
+				cycleDate(cycle.EndsAt),
+				fmt.Sprintf("%.0f%%", cycle.Progress*100),
+				status,
+			})
+		}
+
+		output.Table(output.TableData{
+			Headers: headers,
+			Rows:    rows,
+		}, plaintext, jsonOut)
+
+		fmt.Printf("\n%s %d cycles\n",
+			color.New(color.FgGreen).Sprint("✓"),
+			len(cycles))
+
+		if paginator.PageInfo().HasNextPage {
+			fmt.Printf("%s Use --limit or --all to see more results\n",
+				color.New(color.FgYellow).Sprint("ℹ️"))
+		}
+	},
+}
+
+var cycleGetCmd = &cobra.Command{
+	Use:     "get CYCLE",
+	Aliases: []string{"show"},
+	Short:   "Get cycle details",
+	Long: `Get a cycle and the issues in it.
+
+CYCLE is current, next, previous, or a cycle number (all of which need --team
+or the team setting), or a cycle ID.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		client, err := auth.NewClient()
+		if err != nil {
+			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		ctx := context.Background()
+		teamKey := teamKeyOrDefault(cmd)
+
+		cycleID := args[0]
+		if teamKey != "" {
+			found, err := client.FindTeamCycle(ctx, teamKey, args[0])
+			if err != nil {
+				output.Error(fmt.Sprintf("Failed to find cycle: %v", err), plaintext, jsonOut)
+				os.Exit(exitCodeFor(err))
+			}
+			cycleID = found.ID
+		} else {
+			filter, err := api.CycleFilter(args[0])
+			if err != nil {
+				output.Error(err.Error(), plaintext, jsonOut)
+				os.Exit(1)
+			}
+			if _, byID := filter["id"]; !byID {
+				output.Error(fmt.Sprintf("--team is required to look up cycle '%s' (or set a default with 'linctl config set team KEY')", args[0]), plaintext, jsonOut)
+				os.Exit(1)
+			}
+		}
+
+		cycle, err := client.GetCycle(ctx, cycleID)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to get cycle: %v", err), plaintext, jsonOut)
+			os.Exit(exitCodeFor(err))
+		}
+
+		printCycle(cycle, plaintext, jsonOut)
+	},
+}
+
+var cycleCurrentCmd = &cobra.Command{
+	Use:     "current",
+	Aliases: []string{"active"},
+	Short:   "Show the active cycle",
+	Long: `Show the active cycle and its issues.
+
+Without --team or the team setting this works when exactly one of your teams
+has an active cycle.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		client, err := auth.NewClient()
+		if err != nil {
+			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		ctx := context.Background()
+
+		filter, _ := api.CycleFilter("current")
+		if teamKey := teamKeyOrDefault(cmd); teamKey != "" {
+			filter["team"] = map[string]interface{}{"key": map[string]interface{}{"eq": teamKey}}
+		}
+
+		cycles, err := client.GetCycles(ctx, filter, 10, "")
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to get active cycle: %v", err), plaintext, jsonOut)
+			os.Exit(exitCodeFor(err))
+		}
+
+		if len(cycles.Nodes) == 0 {
+			output.Error("No active cycle found", plaintext, jsonOut)
+			os.Exit(exitNotFound)
+		}
+
+		if len(cycles.Nodes) > 1 {
+			teams := []string{}
+			for _, cycle := range cycles.Nodes {
+				if cycle.Team != nil {
+					teams = append(teams, cycle.Team.Key)
+				}
+			}
+			output.Error(fmt.Sprintf("Several teams have an active cycle (%s). Use --team to pick one.", strings.Join(teams, ", ")), plaintext, jsonOut)
+			os.Exit(1)
+		}
+
+		cycle, err := client.GetCycle(ctx, cycles.Nodes[0].ID)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to get cycle: %v", err), plaintext, jsonOut)
+			os.Exit(exitCodeFor(err))
+		}
+
+		printCycle(cycle, plaintext, jsonOut)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(cycleCmd)
+	cycleCmd.AddCommand(cycleListCmd)
+	cycleCmd.AddCommand(cycleGetCmd)
+	cycleCmd.AddCommand(cycleCurrentCmd)
+
+	// Cycle list flags
+	cycleListCmd.Flags().StringP("team", "t", "", "Filter by team key (default: the team setting)")
+	cycleListCmd.Flags().IntP("limit", "l", 50, "Maximum number of cycles to fetch (pages automatically)")
+	cycleListCmd.Flags().Bool("all", false, "Fetch every matching cycle, ignoring --limit")
+
+	// Cycle get flags
+	cycleGetCmd.Flags().StringP("team", "t", "", "Team key (default: the team setting; required unless CYCLE is an ID)")
+
+	// Cycle current flags
+	cycleCurrentCmd.Flags().StringP("team", "t", "", "Team key (default: the team setting)")
+}
@@ -0,0 +1,127 @@
+package cmd
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/yjiky/linctl/pkg/api"
+)
+
+// addCycles gives ENG a previous, an active and a next cycle, with ENG-1 in
+// the active one, and OPS a single unnamed active cycle
+func addCycles(env *testEnv) (previous, current, next api.Cycle) {
+	previous = env.fake.AddCycle("ENG", api.Cycle{Name: "Sprint 1", StartsAt: "2030-01-01T00:00:00.000Z", EndsAt: "2030-01-14T00:00:00.000Z", IsPrevious: true, IsPast: true, Progress: 1})
+	current = env.fake.AddCycle("ENG", api.Cycle{Name: "Sprint 2", StartsAt: "2030-01-15T00:00:00.000Z", EndsAt: "2030-01-28T00:00:00.000Z", IsActive: true, Progress: 0.25})
+	next = env.fake.AddCycle("ENG", api.Cycle{StartsAt: "2030-01-29T00:00:00.000Z", EndsAt: "2030-02-11T00:00:00.000Z", IsNext: true, IsFuture: true})
+	env.fake.AddCycle("OPS", api.Cycle{StartsAt: "2030-01-10T00:00:00.000Z", EndsAt: "2030-01-24T00:00:00.000Z", IsActive: true})
+	env.run("issue", "update", "ENG-1", "--cycle", "current")
+	return previous, current, next
+}
+
+func TestCycleList(t *testing.T) {
+	env := newWorkspace(t)
+	addCycles(env)
+
+	var cycles []api.Cycle
+	env.runJSON(&cycles, "cycle", "list", "--team", "ENG")
+	if len(cycles) != 3 || cycles[0].Number != 3 || cycles[2].Number != 1 {
+		t.Errorf("cycle list = %+v, want ENG's three cycles, most recent first", cycles)
+	}
+
+	env.runJSON(&cycles, "sprint", "ls", "--limit", "2")
+	if len(cycles) != 2 {
+		t.Errorf("cycle list --limit 2 returned %d cycles", len(cycles))
+	}
+
+	out := env.run("cycle", "list", "--plaintext")
+	assertContains(t, out,
+		"Number\tName\tTeam\tStarts\tEnds\tProgress\tStatus",
+		"3\t\tENG\t2030-01-29\t2030-02-11\t0%\tNext",
+		"2\tSprint 2\tENG\t2030-01-15\t2030-01-28\t25%\tActive",
+		"1\tSprint 1\tENG\t2030-01-01\t2030-01-14\t100%\tPrevious",
+		"1\t\tOPS\t2030-01-10\t2030-01-24\t0%\tActive",
+	)
+
+	env.fake.AddTeam(api.Team{Key: "OLD", Name: "Archive"})
+	out = env.run("cycle", "list", "--team", "OLD", "--plaintext")
+	assertContains(t, out, "No cycles found")
+}
+
+func TestCycleGet(t *testing.T) {
+	env := newWorkspace(t)
+	previous, current, _ := addCycles(env)
+
+	var cycle api.Cycle
+	env.runJSON(&cycle, "cycle", "get", "current", "--team", "ENG")
+	if cycle.ID != current.ID || cycle.Issues == nil || len(cycle.Issues.Nodes) != 1 || cycle.Issues.Nodes[0].Identifier != "ENG-1" {
+		t.Errorf("cycle get current = %+v, want Sprint 2 with ENG-1", cycle)
+	}
+
+	env.runJSON(&cycle, "cycle", "show", previous.ID)
+	if cycle.Name != "Sprint 1" {
+		t.Errorf("cycle get by ID = %q, want Sprint 1", cycle.Name)
+	}
+
+	out := env.run("cycle", "get", "3", "--team", "ENG", "--plaintext")
+	assertContains(t, out, "# Cycle 3", "- **Team**: ENG", "- **Status**: Next", "- **Period**: 2030-01-29 to 2030-02-11", "No issues in this cycle")
+
+	out = env.run("cycle", "get", "previous", "--team", "ENG", "--plaintext")
+	assertContains(t, out, "# Sprint 1", "- **Progress**: 100%")
+
+	out = env.expectExit(exitError, "cycle", "get", "next")
+	assertContains(t, out, "--team is required to look up cycle 'next'")
+	env.expectExit(exitError, "cycle", "get", "0", "--team", "ENG")
+	out = env.expectExit(exitNotFound, "cycle", "get", "9", "--team", "ENG")
+	assertContains(t, out, "9 in team ENG")
+
+	env.run("config", "set", "team", "ENG")
+	out = env.run("cycle", "get", "3", "--plaintext")
+	assertContains(t, out, "# Cycle 3", "- **Team**: ENG")
+
+	var cycles []api.Cycle
+	env.runJSON(&cycles, "cycle", "list")
+	if len(cycles) != 3 {
+		t.Errorf("cycle list with the team setting returned %d cycles, want ENG's 3", len(cycles))
+	}
+	env.runJSON(&cycles, "cycle", "list", "--team", "")
+	if len(cycles) != 4 {
+		t.Errorf("cycle list --team \"\" returned %d cycles, want all 4", len(cycles))
+	}
+}
+
+func TestCycleGetPaged(t *testing.T) {
+	env := newWorkspace(t)
+	_, current, _ := addCycles(env)
+	const count = 260
+	for n := 0; n < count; n++ {
+		env.fake.AddIssue(api.Issue{Title: fmt.Sprintf("Task %d", n), Team: &api.Team{Key: "ENG"}, Cycle: &api.Cycle{ID: current.ID}})
+	}
+
+	out := env.run("cycle", "get", current.ID, "--plaintext")
+	assertContains(t, out, "## Task 259", fmt.Sprintf("Total: %d issues", count+1))
+	if after, _ := env.lastVariables("CycleIssues")["after"].(string); after == "" {
+		t.Error("CycleIssues was not requested after the first page")
+	}
+}
+
+func TestCycleCurrent(t *testing.T) {
+	env := newWorkspace(t)
+	_, current, _ := addCycles(env)
+
+	out := env.run("cycle", "current", "--team", "ENG", "--plaintext")
+	assertContains(t, out, "# Sprint 2", "- **Status**: Active", "## Issues", "## Fix login redirect", "Total: 1 issues")
+
+	out = env.expectExit(exitError, "cycle", "current")
+	assertContains(t, out, "Several teams have an active cycle (ENG, OPS). Use --team to pick one.")
+
+	env.run("config", "set", "team", "ENG")
+	var cycle api.Cycle
+	env.runJSON(&cycle, "cycle", "active")
+	if cycle.ID != current.ID {
+		t.Errorf("cycle current with the team setting = %q, want Sprint 2", cycle.Name)
+	}
+
+	env.fake.AddTeam(api.Team{Key: "OLD", Name: "Archive"})
+	out = env.expectExit(exitNotFound, "cycle", "current", "--team", "OLD")
+	assertContains(t, out, "No active cycle found")
+}
@@ -0,0 +1,560 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/yjiky/linctl/pkg/api"
+	"github.com/yjiky/linctl/pkg/auth"
+	"github.com/yjiky/linctl/pkg/output"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// docCmd represents the doc command
+var docCmd = &cobra.Command{
+	Use:     "doc",
+	Aliases: []string{"document"},
+	Short:   "Manage Linear documents",
+	Long: `Manage Linear documents including listing, viewing, creating, updating, and exporting them.
+
+Examples:
+  linctl doc list --project PROJECT-ID          # List a project's documents
+  linctl doc get DOC-ID                         # Show a document
+  linctl doc create --project PROJECT-ID --file spec.md
+  linctl doc update DOC-ID --file spec.md
+  linctl doc export --project PROJECT-ID --dir docs/specs`,
+}
+
+// renderMarkdown applies light terminal styling to markdown: headings, lists,
+// quotes and code blocks. Everything else is printed as written.
+func renderMarkdown(content string) string {
+	heading := color.New(color.FgCyan, color.Bold)
+	faint := color.New(color.FgWhite, color.Faint)
+	code := color.New(color.FgYellow)
+
+	var b strings.Builder
+	inCode := false
+	for _, line := range strings.Split(content, "\n") {
+		trimmed := strings.TrimSpace(line)
+
+		if strings.HasPrefix(trimmed, "```") {
+			inCode = !inCode
+			b.WriteString(faint.Sprint(strings.Repeat("┄", 40)) + "\n")
+			continue
+		}
+
+		switch {
+		case inCode:
+			b.WriteString("  " + code.Sprint(line) + "\n")
+		case strings.HasPrefix(trimmed, "#"):
+			text := strings.TrimSpace(strings.TrimLeft(trimmed, "#"))
+			if strings.HasPrefix(trimmed, "# ") {
+				text = strings.ToUpper(text)
+			}
+			b.WriteString(heading.Sprint(text) + "\n")
+		case strings.HasPrefix(trimmed, "- [ ] "), strings.HasPrefix(trimmed, "* [ ] "):
+			b.WriteString(strings.Replace(line, trimmed[:6], "☐ ", 1) + "\n")
+		case strings.HasPrefix(trimmed, "- [x] "), strings.HasPrefix(trimmed, "* [x] "):
+			b.WriteString(strings.Replace(line, trimmed[:6], color.New(color.FgGreen).Sprint("☑ "), 1) + "\n")
+		case strings.HasPrefix(trimmed, "- "), strings.HasPrefix(trimmed, "* "):
+			b.WriteString(strings.Replace(line, trimmed[:2], "• ", 1) + "\n")
+		case strings.HasPrefix(trimmed, ">"):
+			b.WriteString(faint.Sprint("│ "+strings.TrimSpace(strings.TrimPrefix(trimmed, ">"))) + "\n")
+		default:
+			b.WriteString(line + "\n")
+		}
+	}
+
+	return strings.TrimRight(b.String(), "\n")
+}
+
+// documentFrontMatter renders a document as markdown with YAML front-matter
+func documentFrontMatter(doc *api.Document) string {
+	var b strings.Builder
+	b.WriteString("---\n")
+	fmt.Fprintf(&b, "id: %s\n", doc.ID)
+	fmt.Fprintf(&b, "title: %s\n", strconv.Quote(doc.Title))
+	if doc.SlugId != "" {
+		fmt.Fprintf(&b, "slug: %s\n", doc.SlugId)
+	}
+	if doc.Project != nil {
+		fmt.Fprintf(&b, "project: %s\n", strconv.Quote(doc.Project.Name))
+		fmt.Fprintf(&b, "project_id: %s\n", doc.Project.ID)
+	}
+	if doc.Creator != nil {
+		fmt.Fprintf(&b, "creator: %s\n", strconv.Quote(doc.Creator.Name))
+	}
+	if doc.URL != "" {
+		fmt.Fprintf(&b, "url: %s\n", doc.URL)
+	}
+	fmt.Fprintf(&b, "created_at: %s\n", doc.CreatedAt.UTC().Format(time.RFC3339))
+	fmt.Fprintf(&b, "updated_at: %s\n", doc.UpdatedAt.UTC().Format(time.RFC3339))
+	b.WriteString("---\n\n")
+	b.WriteString(strings.TrimRight(doc.Content, "\n"))
+	b.WriteString("\n")
+	return b.String()
+}
+
+// readDocumentContent reads --content or --file (use - for stdin)
+func readDocumentContent(cmd *cobra.Command) (string, bool, error) {
+	content, _ := cmd.Flags().GetString("content")
+	file, _ := cmd.Flags().GetString("file")
+
+	switch {
+	case cmd.Flags().Changed("content") && file != "":
+		return "", false, fmt.Errorf("use either --content or --file, not both")
+	case file != "":
+		data, err := readTextFile(file)
+		if err != nil {
+			return "", false, err
+		}
+		return data, true, nil
+	case cmd.Flags().Changed("content"):
+		return content, true, nil
+	default:
+		return "", false, nil
+	}
+}
+
+var docListCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "List documents",
+	Long:    `List documents in your workspace, optionally limited to one project.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		client, err := auth.NewClient()
+		if err != nil {
+			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		ctx := context.Background()
+		limit := resolveListLimit(cmd)
+
+		filter := make(map[string]interface{})
+		if projectRef, _ := cmd.Flags().GetString("project"); projectRef != "" {
+			projectID, err := resolveProjectID(ctx, client, projectRef)
+			if err != nil {
+				output.Error(fmt.Sprintf("Failed to find project '%s': %v", projectRef, err), plaintext, jsonOut)
+				os.Exit(exitCodeFor(err))
+			}
+			filter["project"] = map[string]interface{}{"id": map[string]interface{}{"eq": projectID}}
+		}
+
+		paginator := api.NewPaginator(client.DocumentPages(filter), limit)
+		docs, err := paginator.All(ctx)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to list documents: %v", err), plaintext, jsonOut)
+			os.Exit(exitCodeFor(err))
+		}
+
+		if jsonOut {
+			output.JSON(docs)
+			return
+		}
+
+		if len(docs) == 0 {
+			output.Info("No documents found", plaintext, jsonOut)
+			return
+		}
+
+		if plaintext {
+			fmt.Println("ID\tTitle\tProject\tUpdated")
+			for _, doc := range docs {
+				project := ""
+				if doc.Project != nil {
+					project = doc.Project.Name
+				}
+				fmt.Printf("%s\t%s\t%s\t%s\n", doc.ID, doc.Title, project, doc.UpdatedAt.Format("2006-01-02"))
+			}
+			return
+		}
+
+		headers := []string{"Title", "Project", "Updated", "ID"}
+		rows := [][]string{}
+		for _, doc := range docs {
+			project := ""
+			if doc.Project != nil {
+				project = truncateString(doc.Project.Name, 25)
+			}
+			rows = append(rows, []string{
+				color.New(color.FgCyan, color.Bold).Sprint(truncateString(doc.Title, 40)),
+				project,
+				doc.UpdatedAt.Format("2006-01-02"),
+				color.New(color.FgWhite, color.Faint).Sprint(doc.ID),
+			})
+		}
+
+		output.Table(output.TableData{
+			Headers: headers,
+			Rows:    rows,
+		}, plaintext, jsonOut)
+
+		fmt.Printf("\n%s %d documents\n",
+			color.New(color.FgGreen).Sprint("✓"),
+			len(docs))
+
+		if paginator.PageInfo().HasNextPage {
+			fmt.Printf("%s Use --limit or --all to see more results\n",
+				color.New(color.FgYellow).Sprint("ℹ️"))
+		}
+	},
+}
+
+var docGetCmd = &cobra.Command{
+	Use:     "get DOC-ID",
+	Aliases: []string{"show"},
+	Short:   "Show a document",
+	Long: `Show a document with its markdown content.
+
+Plaintext output prints the raw markdown, so it can be piped or saved as is.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		client, err := auth.NewClient()
+		if err != nil {
+			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		doc, err := client.GetDocument(context.Background(), args[0])
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to get document: %v", err), plaintext, jsonOut)
+			os.Exit(exitCodeFor(err))
+		}
+
+		if jsonOut {
+			output.JSON(doc)
+			return
+		}
+
+		if plaintext {
+			fmt.Printf("# %s\n\n", doc.Title)
+			fmt.Println(strings.TrimRight(doc.Content, "\n"))
+			return
+		}
+
+		fmt.Println()
+		fmt.Printf("%s %s\n", color.New(color.FgCyan, color.Bold).Sprint("📄"), color.New(color.Bold).Sprint(doc.Title))
+		meta := []string{}
+		if doc.Project != nil {
+			meta = append(meta, "Project: "+doc.Project.Name)
+		}
+		if doc.UpdatedBy != nil {
+			meta = append(meta, "Updated by "+doc.UpdatedBy.Name)
+		}
+		meta = append(meta, formatTimeAgo(doc.UpdatedAt))
+		fmt.Println(color.New(color.FgWhite, color.Faint).Sprint(strings.Join(meta, " • ")))
+		fmt.Println(strings.Repeat("─", 50))
+		fmt.Println()
+		fmt.Println(renderMarkdown(doc.Content))
+		fmt.Println()
+		if doc.URL != "" {
+			fmt.Printf("%s %s\n", color.New(color.Bold).Sprint("URL:"), color.New(color.FgBlue, color.Underline).Sprint(doc.URL))
+		}
+	},
+}
+
+var docCreateCmd = &cobra.Command{
+	Use:     "create",
+	Aliases: []string{"new"},
+	Short:   "Create a document",
+	Long: `Create a document from --content or a markdown --file (use - for stdin).
+
+Without --title, the file name (minus extension) is used as the title.
+
+Examples:
+  linctl doc create --project PROJECT-ID --file spec.md
+  linctl doc create --project PROJECT-ID --title "RFC: Search" --file rfc.md
+  cat notes.md | linctl doc create --title "Notes" --file -`,
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		content, _, err := readDocumentContent(cmd)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(1)
+		}
+
+		title, _ := cmd.Flags().GetString("title")
+		if strings.TrimSpace(title) == "" {
+			if file, _ := cmd.Flags().GetString("file"); file != "" && file != "-" {
+				title = strings.TrimSuffix(filepath.Base(file), filepath.Ext(file))
+			}
+		}
+		if strings.TrimSpace(title) == "" {
+			output.Error("Title is required (--title)", plaintext, jsonOut)
+			os.Exit(1)
+		}
+
+		client, err := auth.NewClient()
+		if err != nil {
+			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		ctx := context.Background()
+
+		input := map[string]interface{}{
+			"title":   title,
+			"content": content,
+		}
+
+		if projectRef, _ := cmd.Flags().GetString("project"); projectRef != "" {
+			projectID, err := resolveProjectID(ctx, client, projectRef)
+			if err != nil {
+				output.Error(fmt.Sprintf("Failed to find project '%s': %v", projectRef, err), plaintext, jsonOut)
+				os.Exit(exitCodeFor(err))
+			}
+			input["projectId"] = projectID
+		}
+		if icon, _ := cmd.Flags().GetString("icon"); icon != "" {
+			input["icon"] = icon
+		}
+
+		doc, err := client.CreateDocument(ctx, input)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to create document: %v", err), plaintext, jsonOut)
+			os.Exit(exitCodeFor(err))
+		}
+
+		if jsonOut {
+			output.JSON(doc)
+		} else if plaintext {
+			fmt.Printf("Created document %s (%s)\n", doc.Title, doc.ID)
+			if doc.URL != "" {
+				fmt.Printf("URL: %s\n", doc.URL)
+			}
+		} else {
+			fmt.Printf("%s Created document %s\n",
+				color.New(color.FgGreen).Sprint("✓"),
+				color.New(color.FgCyan, color.Bold).Sprint(doc.Title))
+			fmt.Printf("  ID:  %s\n", doc.ID)
+			if doc.URL != "" {
+				fmt.Printf("  URL: %s\n", color.New(color.FgBlue, color.Underline).Sprint(doc.URL))
+			}
+		}
+	},
+}
+
+var docUpdateCmd = &cobra.Command{
+	Use:     "update DOC-ID",
+	Aliases: []string{"edit"},
+	Short:   "Update a document",
+	Long: `Update a document's title, content, icon or project.
+
+Examples:
+  linctl doc update DOC-ID --file spec.md
+  linctl doc update DOC-ID --title "RFC: Search (accepted)"
+  linctl doc update DOC-ID --project PROJECT-ID`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		input := make(map[string]interface{})
+
+		content, changed, err := readDocumentContent(cmd)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(1)
+		}
+		if changed {
+			input["content"] = content
+		}
+
+		if cmd.Flags().Changed("title") {
+			title, _ := cmd.Flags().GetString("title")
+			input["title"] = title
+		}
+		if cmd.Flags().Changed("icon") {
+			icon, _ := cmd.Flags().GetString("icon")
+			input["icon"] = icon
+		}
+
+		client, err := auth.NewClient()
+		if err != nil {
+			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		ctx := context.Background()
+
+		if cmd.Flags().Changed("project") {
+			projectRef, _ := cmd.Flags().GetString("project")
+			projectID, err := resolveProjectID(ctx, client, projectRef)
+			if err != nil {
+				output.Error(fmt.Sprintf("Failed to find project '%s': %v", projectRef, err), plaintext, jsonOut)
+				os.Exit(exitCodeFor(err))
+			}
+			input["projectId"] = projectID
+		}
+
+		if len(input) == 0 {
+			output.Error("No updates specified. Use flags to specify what to update.", plaintext, jsonOut)
+			os.Exit(1)
+		}
+
+		doc, err := client.UpdateDocument(ctx, args[0], input)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to update document: %v", err), plaintext, jsonOut)
+			os.Exit(exitCodeFor(err))
+		}
+
+		if jsonOut {
+			output.JSON(doc)
+		} else {
+			output.Success(fmt.Sprintf("Updated document %s", doc.Title), plaintext, jsonOut)
+		}
+	},
+}
+
+var docExportCmd = &cobra.Command{
+	Use:   "export [DOC-ID...]",
+	Short: "Export documents as markdown files",
+	Long: `Write documents to a directory as markdown files with YAML front-matter
+(id, title, project, creator, url and timestamps). Existing files are overwritten,
+so re-running an export keeps a git checkout in sync with Linear.
+
+Pass document IDs to export specific documents, or --project to export all of a
+project's documents. With neither, every document in the workspace is exported.
+
+Examples:
+  linctl doc export --project PROJECT-ID --dir docs/specs
+  linctl doc export DOC-ID --dir .`,
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		client, err := auth.NewClient()
+		if err != nil {
+			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		ctx := context.Background()
+		dir, _ := cmd.Flags().GetString("dir")
+
+		docs := []api.Document{}
+		if len(args) > 0 {
+			for _, id := range args {
+				doc, err := client.GetDocument(ctx, id)
+				if err != nil {
+					output.Error(fmt.Sprintf("Failed to get document '%s': %v", id, err), plaintext, jsonOut)
+					os.Exit(exitCodeFor(err))
+				}
+				docs = append(docs, *doc)
+			}
+		} else {
+			filter := make(map[string]interface{})
+			if projectRef, _ := cmd.Flags().GetString("project"); projectRef != "" {
+				projectID, err := resolveProjectID(ctx, client, projectRef)
+				if err != nil {
+					output.Error(fmt.Sprintf("Failed to find project '%s': %v", projectRef, err), plaintext, jsonOut)
+					os.Exit(exitCodeFor(err))
+				}
+				filter["project"] = map[string]interface{}{"id": map[string]interface{}{"eq": projectID}}
+			}
+
+			docs, err = api.NewPaginator(client.DocumentPages(filter), 0).All(ctx)
+			if err != nil {
+				output.Error(fmt.Sprintf("Failed to list documents: %v", err), plaintext, jsonOut)
+				os.Exit(exitCodeFor(err))
+			}
+		}
+
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			output.Error(fmt.Sprintf("Failed to create directory: %v", err), plaintext, jsonOut)
+			os.Exit(1)
+		}
+
+		type exportedDocument struct {
+			ID    string `json:"id"`
+			Title string `json:"title"`
+			Path  string `json:"path"`
+		}
+		exported := []exportedDocument{}
+		used := map[string]bool{}
+
+		for i := range docs {
+			doc := &docs[i]
+
+			name := sanitizeFilename(doc.Title)
+			if name == "" {
+				name = doc.SlugId
+			}
+			if name == "" {
+				name = doc.ID
+			}
+			if used[strings.ToLower(name)] {
+				suffix := doc.SlugId
+				if suffix == "" {
+					suffix = doc.ID
+				}
+				name = fmt.Sprintf("%s-%s", name, suffix)
+			}
+			used[strings.ToLower(name)] = true
+
+			path := filepath.Join(dir, name+".md")
+			if err := os.WriteFile(path, []byte(documentFrontMatter(doc)), 0o644); err != nil {
+				output.Error(fmt.Sprintf("Failed to write %s: %v", path, err), plaintext, jsonOut)
+				os.Exit(1)
+			}
+			exported = append(exported, exportedDocument{ID: doc.ID, Title: doc.Title, Path: path})
+
+			if !jsonOut {
+				if plaintext {
+					fmt.Println(path)
+				} else {
+					fmt.Printf("%s %s → %s\n", color.New(color.FgGreen).Sprint("✓"), doc.Title, path)
+				}
+			}
+		}
+
+		if jsonOut {
+			output.JSON(exported)
+		} else if !plaintext {
+			fmt.Printf("\n%s Exported %d documents to %s\n", color.New(color.FgGreen).Sprint("✓"), len(exported), dir)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(docCmd)
+	docCmd.AddCommand(docListCmd)
+	docCmd.AddCommand(docGetCmd)
+	docCmd.AddCommand(docCreateCmd)
+	docCmd.AddCommand(docUpdateCmd)
+	docCmd.AddCommand(docExportCmd)
+
+	// List flags
+	docListCmd.Flags().String("project", "", "Filter by project ID or slug")
+	docListCmd.Flags().IntP("limit", "l", 50, "Maximum number of documents to fetch (pages automatically)")
+	docListCmd.Flags().Bool("all", false, "Fetch every matching document, ignoring --limit")
+
+	// Create and update flags
+	for _, c := range []*cobra.Command{docCreateCmd, docUpdateCmd} {
+		c.Flags().String("title", "", "Document title")
+		c.Flags().String("content", "", "Document content (markdown)")
+		c.Flags().StringP("file", "f", "", "Read content from a markdown file, or - for stdin")
+		c.Flags().String("project", "", "Project ID or slug")
+		c.Flags().String("icon", "", "Document icon (emoji or icon name)")
+	}
+
+	// Export flags
+	docExportCmd.Flags().String("project", "", "Export only this project's documents")
+	docExportCmd.Flags().StringP("dir", "d", ".", "Directory to write markdown files to")
+}
@@ -0,0 +1,237 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/yjiky/linctl/pkg/api"
+)
+
+// serveDocuments serves the document operations, which linearfake does not
+// model, from docs. Created and updated documents are written back to it.
+func serveDocuments(env *testEnv, docs *[]api.Document) {
+	find := func(id string) *api.Document {
+		for i := range *docs {
+			if (*docs)[i].ID == id || (*docs)[i].SlugId == id {
+				return &(*docs)[i]
+			}
+		}
+		return nil
+	}
+	apply := func(doc *api.Document, input map[string]interface{}) {
+		for field, value := range input {
+			switch field {
+			case "title":
+				doc.Title = value.(string)
+			case "content":
+				doc.Content = value.(string)
+			case "icon":
+				icon := value.(string)
+				doc.Icon = &icon
+			case "projectId":
+				project, _ := env.fake.Project(value.(string))
+				doc.Project = &api.Project{ID: project.ID, Name: project.Name}
+			}
+		}
+		doc.UpdatedAt = time.Now().UTC()
+	}
+
+	env.fake.Handle("Documents", func(variables map[string]interface{}) (interface{}, error) {
+		projectID := ""
+		if filter, ok := variables["filter"].(map[string]interface{}); ok && filter["project"] != nil {
+			projectID = filter["project"].(map[string]interface{})["id"].(map[string]interface{})["eq"].(string)
+		}
+		nodes := []api.Document{}
+		for _, doc := range *docs {
+			if projectID == "" || (doc.Project != nil && doc.Project.ID == projectID) {
+				nodes = append(nodes, doc)
+			}
+		}
+		return map[string]interface{}{"documents": api.Documents{Nodes: nodes}}, nil
+	})
+	env.fake.Handle("Document", func(variables map[string]interface{}) (interface{}, error) {
+		return map[string]interface{}{"document": find(variables["id"].(string))}, nil
+	})
+	env.fake.Handle("CreateDocument", func(variables map[string]interface{}) (interface{}, error) {
+		id := fmt.Sprintf("doc-%d", len(*docs)+1)
+		doc := api.Document{ID: id, SlugId: id, CreatedAt: time.Now().UTC(), URL: "https://linear.app/linearfake/document/" + id}
+		apply(&doc, variables["input"].(map[string]interface{}))
+		*docs = append(*docs, doc)
+		return map[string]interface{}{"documentCreate": map[string]interface{}{"success": true, "document": doc}}, nil
+	})
+	env.fake.Handle("UpdateDocument", func(variables map[string]interface{}) (interface{}, error) {
+		doc := find(variables["id"].(string))
+		if doc == nil {
+			return nil, &api.Error{Code: api.CodeInvalidInput, Message: "Entity not found: Document"}
+		}
+		apply(doc, variables["input"].(map[string]interface{}))
+		return map[string]interface{}{"documentUpdate": map[string]interface{}{"success": true, "document": doc}}, nil
+	})
+}
+
+// newDocuments returns a workspace with a spec in the Website project and a
+// workspace-wide handbook
+func newDocuments(t *testing.T) (*testEnv, *[]api.Document) {
+	env := newWorkspace(t)
+	website, _ := env.fake.Project("Website")
+	updated := time.Date(2030, 1, 2, 15, 4, 5, 0, time.UTC)
+	docs := &[]api.Document{
+		{
+			ID:        "doc-spec",
+			SlugId:    "a1b2c3",
+			Title:     "Login spec",
+			Content:   "## Flow\n\n- Redirect back after login\n",
+			Project:   &api.Project{ID: website.ID, Name: website.Name},
+			Creator:   &api.User{Name: "Alice Smith"},
+			CreatedAt: updated.Add(-time.Hour),
+			UpdatedAt: updated,
+			URL:       "https://linear.app/linearfake/document/login-spec-a1b2c3",
+		},
+		{ID: "doc-handbook", Title: "On-call handbook", Content: "Page the on-call engineer.", CreatedAt: updated, UpdatedAt: updated},
+	}
+	serveDocuments(env, docs)
+	return env, docs
+}
+
+func TestDocList(t *testing.T) {
+	env, _ := newDocuments(t)
+
+	var docs []api.Document
+	env.runJSON(&docs, "doc", "list")
+	if len(docs) != 2 {
+		t.Errorf("doc list returned %d documents, want 2", len(docs))
+	}
+
+	website := env.projectID("Website")
+	out := env.run("document", "ls", "--project", website, "--plaintext")
+	assertContains(t, out, "ID\tTitle\tProject\tUpdated\ndoc-spec\tLogin spec\tWebsite\t2030-01-02\n")
+	if got := env.lastVariables("Documents")["filter"]; fmt.Sprint(got) != fmt.Sprintf("map[project:map[id:map[eq:%s]]]", website) {
+		t.Errorf("filter = %v, want the Website project", got)
+	}
+
+	env.run("project", "create", "--name", "Empty", "--team", "OPS")
+	out = env.run("doc", "list", "--project", env.projectID("Empty"), "--plaintext")
+	assertContains(t, out, "No documents found")
+
+	env.expectExit(exitNotFound, "doc", "list", "--project", "no-such-project")
+}
+
+func TestDocGet(t *testing.T) {
+	env, _ := newDocuments(t)
+
+	out := env.run("doc", "get", "doc-spec", "--plaintext")
+	if want := "# Login spec\n\n## Flow\n\n- Redirect back after login\n"; out != want {
+		t.Errorf("doc get = %q, want %q", out, want)
+	}
+
+	out = env.run("doc", "show", "doc-spec")
+	assertContains(t, out, "Login spec", "Project: Website", "• Redirect back after login")
+
+	env.expectExit(exitNotFound, "doc", "get", "doc-missing")
+}
+
+func TestDocCreate(t *testing.T) {
+	env, docs := newDocuments(t)
+
+	file := filepath.Join(t.TempDir(), "Search RFC.md")
+	if err := os.WriteFile(file, []byte("# Search\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	var doc api.Document
+	env.runJSON(&doc, "doc", "create", "--file", file, "--project", env.projectID("Website"), "--icon", "📄")
+	if doc.Title != "Search RFC" || doc.Content != "# Search\n" || doc.Project == nil || doc.Project.Name != "Website" {
+		t.Errorf("created document = %+v", doc)
+	}
+
+	out := env.runWithInput("Piped notes\n", "doc", "new", "--title", "Notes", "--file", "-", "--plaintext")
+	assertContains(t, out, "Created document Notes (doc-4)", "URL: https://linear.app/linearfake/document/doc-4")
+	if got := (*docs)[3].Content; got != "Piped notes\n" {
+		t.Errorf("content = %q, want the piped notes", got)
+	}
+
+	tests := []struct {
+		name string
+		code int
+		args []string
+		want string
+	}{
+		{"no title", exitError, []string{"--content", "Body"}, "Title is required (--title)"},
+		{"content and file", exitError, []string{"--title", "X", "--content", "Body", "--file", file}, "use either --content or --file, not both"},
+		{"missing file", exitError, []string{"--file", filepath.Join(t.TempDir(), "missing.md")}, "missing.md"},
+		{"unknown project", exitNotFound, []string{"--title", "X", "--project", "no-such-project"}, "Failed to find project 'no-such-project'"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := env.expectExit(tt.code, append([]string{"doc", "create"}, tt.args...)...)
+			assertContains(t, out, tt.want)
+		})
+	}
+}
+
+func TestDocUpdate(t *testing.T) {
+	env, docs := newDocuments(t)
+
+	out := env.run("doc", "update", "doc-handbook", "--title", "Handbook", "--content", "Updated", "--project", env.projectID("Website"), "--plaintext")
+	assertContains(t, out, "Updated document Handbook")
+	if doc := (*docs)[1]; doc.Title != "Handbook" || doc.Content != "Updated" || doc.Project == nil {
+		t.Errorf("updated document = %+v", doc)
+	}
+	if got := env.lastVariables("UpdateDocument")["input"].(map[string]interface{}); len(got) != 3 {
+		t.Errorf("update input = %v, want title, content and projectId", got)
+	}
+
+	out = env.expectExit(exitError, "doc", "edit", "doc-handbook")
+	assertContains(t, out, "No updates specified")
+	env.expectExit(exitNotFound, "doc", "update", "doc-missing", "--title", "X")
+}
+
+func TestDocExport(t *testing.T) {
+	env, docs := newDocuments(t)
+	*docs = append(*docs, api.Document{ID: "doc-copy", SlugId: "d4e5f6", Title: "Login spec", Content: "Copy"})
+	dir := filepath.Join(t.TempDir(), "docs")
+
+	out := env.run("doc", "export", "--dir", dir, "--plaintext")
+	spec := filepath.Join(dir, "Login-spec.md")
+	assertContains(t, out, spec, filepath.Join(dir, "On-call-handbook.md"), filepath.Join(dir, "Login-spec-d4e5f6.md"))
+
+	data, err := os.ReadFile(spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `---
+id: doc-spec
+title: "Login spec"
+slug: a1b2c3
+project: "Website"
+project_id: ` + env.projectID("Website") + `
+creator: "Alice Smith"
+url: https://linear.app/linearfake/document/login-spec-a1b2c3
+created_at: 2030-01-02T14:04:05Z
+updated_at: 2030-01-02T15:04:05Z
+---
+
+## Flow
+
+- Redirect back after login
+`
+	if string(data) != want {
+		t.Errorf("exported file =\n%s\nwant\n%s", data, want)
+	}
+
+	var exported []map[string]string
+	env.runJSON(&exported, "doc", "export", "--project", env.projectID("Website"), "--dir", dir)
+	if len(exported) != 1 || exported[0]["path"] != spec {
+		t.Errorf("export --project = %v, want only the spec", exported)
+	}
+
+	env.runJSON(&exported, "doc", "export", "doc-handbook", "--dir", dir)
+	if len(exported) != 1 || exported[0]["id"] != "doc-handbook" {
+		t.Errorf("export by ID = %v", exported)
+	}
+
+	env.expectExit(exitNotFound, "doc", "export", "doc-missing", "--dir", dir)
+}
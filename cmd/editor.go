@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// editorCommand returns the user's editor from $VISUAL or $EDITOR, split into
+// program and arguments (e.g. "code --wait")
+func editorCommand() []string {
+	for _, env := range []string{"VISUAL", "EDITOR"} {
+		if fields := strings.Fields(os.Getenv(env)); len(fields) > 0 {
+			return fields
+		}
+	}
+	if runtime.GOOS == "windows" {
+		return []string{"notepad"}
+	}
+	return []string{"vi"}
+}
+
+// editText opens the user's editor on a temporary markdown file holding
+// initial and returns the saved text with surrounding whitespace trimmed
+func editText(initial, pattern string) (string, error) {
+	file, err := os.CreateTemp("", pattern)
+	if err != nil {
+		return "", fmt.Errorf("failed to create temp file: %v", err)
+	}
+	path := file.Name()
+	defer os.Remove(path)
+
+	if _, err := file.WriteString(initial); err != nil {
+		file.Close()
+		return "", fmt.Errorf("failed to write temp file: %v", err)
+	}
+	if err := file.Close(); err != nil {
+		return "", fmt.Errorf("failed to write temp file: %v", err)
+	}
+
+	editor := editorCommand()
+	command := exec.Command(editor[0], append(editor[1:], path)...)
+	command.Stdin = os.Stdin
+	command.Stdout = os.Stdout
+	command.Stderr = os.Stderr
+	if err := command.Run(); err != nil {
+		return "", fmt.Errorf("editor %s failed: %v", editor[0], err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read temp file: %v", err)
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+// useEditor reports whether to edit text in $EDITOR: either --editor was
+// given, or the text flag was omitted in an interactive terminal session
+func useEditor(cmd *cobra.Command, textFlag string, plaintext, jsonOut bool) bool {
+	if editor, _ := cmd.Flags().GetBool("editor"); editor {
+		return true
+	}
+	if cmd.Flags().Changed(textFlag) || plaintext || jsonOut {
+		return false
+	}
+	return isTerminal(os.Stdin) && isTerminal(os.Stdout)
+}
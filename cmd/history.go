@@ -0,0 +1,234 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/yjiky/linctl/pkg/api"
+	"github.com/yjiky/linctl/pkg/auth"
+	"github.com/yjiky/linctl/pkg/output"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// issueHistoryEvent is a history entry with its label IDs resolved
+type issueHistoryEvent struct {
+	api.IssueHistoryEntry
+	AddedLabels   []api.Label `json:"addedLabels,omitempty"`
+	RemovedLabels []api.Label `json:"removedLabels,omitempty"`
+}
+
+// historyActorName returns who made a change, falling back for automations
+func historyActorName(entry api.IssueHistoryEntry) string {
+	if entry.Actor != nil && entry.Actor.Name != "" {
+		return entry.Actor.Name
+	}
+	return "Linear"
+}
+
+// labelNamesFor maps label IDs to names, keeping the ID for labels that no longer exist
+func labelNamesFor(ids []string, labelNames map[string]string) []string {
+	names := make([]string, len(ids))
+	for i, id := range ids {
+		if name, ok := labelNames[id]; ok {
+			names[i] = name
+		} else {
+			names[i] = id
+		}
+	}
+	return names
+}
+
+// describeHistoryChanges lists the changes in a history entry as short sentences.
+// When labelNames is nil, label changes are summarised as counts.
+func describeHistoryChanges(entry api.IssueHistoryEntry, labelNames map[string]string) []string {
+	changes := []string{}
+
+	if entry.FromState != nil && entry.ToState != nil {
+		changes = append(changes, fmt.Sprintf("State: %s → %s", entry.FromState.Name, entry.ToState.Name))
+	} else if entry.ToState != nil {
+		changes = append(changes, fmt.Sprintf("State set to %s", entry.ToState.Name))
+	}
+	if entry.FromAssignee != nil && entry.ToAssignee != nil {
+		changes = append(changes, fmt.Sprintf("Assignee: %s → %s", entry.FromAssignee.Name, entry.ToAssignee.Name))
+	} else if entry.FromAssignee != nil && entry.ToAssignee == nil {
+		changes = append(changes, fmt.Sprintf("Unassigned from %s", entry.FromAssignee.Name))
+	} else if entry.FromAssignee == nil && entry.ToAssignee != nil {
+		changes = append(changes, fmt.Sprintf("Assigned to %s", entry.ToAssignee.Name))
+	}
+	if entry.FromPriority != nil && entry.ToPriority != nil {
+		changes = append(changes, fmt.Sprintf("Priority: %s → %s", priorityToString(*entry.FromPriority), priorityToString(*entry.ToPriority)))
+	}
+	if entry.FromTitle != nil && entry.ToTitle != nil {
+		changes = append(changes, fmt.Sprintf("Title: \"%s\" → \"%s\"", *entry.FromTitle, *entry.ToTitle))
+	}
+	if entry.FromCycle != nil && entry.ToCycle != nil {
+		changes = append(changes, fmt.Sprintf("Cycle: %s → %s", cycleDisplayName(entry.FromCycle), cycleDisplayName(entry.ToCycle)))
+	} else if entry.FromCycle != nil {
+		changes = append(changes, fmt.Sprintf("Removed from cycle %s", cycleDisplayName(entry.FromCycle)))
+	} else if entry.ToCycle != nil {
+		changes = append(changes, fmt.Sprintf("Added to cycle %s", cycleDisplayName(entry.ToCycle)))
+	}
+	if entry.FromProject != nil && entry.ToProject != nil {
+		changes = append(changes, fmt.Sprintf("Project: %s → %s", entry.FromProject.Name, entry.ToProject.Name))
+	} else if entry.FromProject != nil {
+		changes = append(changes, fmt.Sprintf("Removed from project %s", entry.FromProject.Name))
+	} else if entry.ToProject != nil {
+		changes = append(changes, fmt.Sprintf("Added to project %s", entry.ToProject.Name))
+	}
+	if len(entry.AddedLabelIds) > 0 {
+		if labelNames == nil {
+			changes = append(changes, fmt.Sprintf("Added %d label(s)", len(entry.AddedLabelIds)))
+		} else {
+			changes = append(changes, fmt.Sprintf("Added label(s): %s", strings.Join(labelNamesFor(entry.AddedLabelIds, labelNames), ", ")))
+		}
+	}
+	if len(entry.RemovedLabelIds) > 0 {
+		if labelNames == nil {
+			changes = append(changes, fmt.Sprintf("Removed %d label(s)", len(entry.RemovedLabelIds)))
+		} else {
+			changes = append(changes, fmt.Sprintf("Removed label(s): %s", strings.Join(labelNamesFor(entry.RemovedLabelIds, labelNames), ", ")))
+		}
+	}
+
+	return changes
+}
+
+// cycleDisplayName prefers a cycle's name and falls back to its number
+func cycleDisplayName(cycle *api.Cycle) string {
+	if cycle.Name != "" {
+		return cycle.Name
+	}
+	return fmt.Sprintf("Cycle %d", cycle.Number)
+}
+
+var issueHistoryCmd = &cobra.Command{
+	Use:   "history ISSUE-ID",
+	Short: "Show an issue's full change history",
+	Long: `Show every recorded change to an issue as a chronological timeline,
+including state, assignee, priority, title, cycle, project and label changes.
+
+Examples:
+  linctl issue history LIN-123
+  linctl issue history LIN-123 --plaintext
+  linctl issue history LIN-123 --json`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		client, err := auth.NewClient()
+		if err != nil {
+			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		ctx := context.Background()
+
+		paginator := api.NewPaginator(client.IssueHistoryPages(args[0]), 0)
+		entries, err := paginator.All(ctx)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to get issue history: %v", err), plaintext, jsonOut)
+			os.Exit(exitCodeFor(err))
+		}
+
+		sort.SliceStable(entries, func(i, j int) bool {
+			return entries[i].CreatedAt.Before(entries[j].CreatedAt)
+		})
+
+		// Resolve every label ID mentioned in the timeline with a single lookup
+		seen := map[string]bool{}
+		labelIDs := []string{}
+		for _, entry := range entries {
+			for _, id := range append(append([]string{}, entry.AddedLabelIds...), entry.RemovedLabelIds...) {
+				if !seen[id] {
+					seen[id] = true
+					labelIDs = append(labelIDs, id)
+				}
+			}
+		}
+
+		labels, err := client.GetLabelsByID(ctx, labelIDs)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to resolve labels: %v", err), plaintext, jsonOut)
+			os.Exit(exitCodeFor(err))
+		}
+		labelsByID := map[string]api.Label{}
+		labelNames := map[string]string{}
+		for _, label := range labels {
+			labelsByID[label.ID] = label
+			labelNames[label.ID] = label.Name
+		}
+
+		if jsonOut {
+			events := make([]issueHistoryEvent, 0, len(entries))
+			for _, entry := range entries {
+				event := issueHistoryEvent{IssueHistoryEntry: entry}
+				for _, id := range entry.AddedLabelIds {
+					label, ok := labelsByID[id]
+					if !ok {
+						label = api.Label{ID: id}
+					}
+					event.AddedLabels = append(event.AddedLabels, label)
+				}
+				for _, id := range entry.RemovedLabelIds {
+					label, ok := labelsByID[id]
+					if !ok {
+						label = api.Label{ID: id}
+					}
+					event.RemovedLabels = append(event.RemovedLabels, label)
+				}
+				events = append(events, event)
+			}
+			output.JSON(events)
+			return
+		}
+
+		if len(entries) == 0 {
+			output.Info(fmt.Sprintf("No history recorded for %s", args[0]), plaintext, jsonOut)
+			return
+		}
+
+		if plaintext {
+			fmt.Printf("# History for %s\n", args[0])
+			for _, entry := range entries {
+				fmt.Printf("\n- **%s** by %s", entry.CreatedAt.Format("2006-01-02 15:04"), historyActorName(entry))
+				changes := describeHistoryChanges(entry, labelNames)
+				if len(changes) > 0 {
+					fmt.Printf("\n  - %s", strings.Join(changes, "\n  - "))
+				}
+				fmt.Println()
+			}
+			return
+		}
+
+		fmt.Printf("%s %s\n\n",
+			color.New(color.FgCyan, color.Bold).Sprint(args[0]),
+			color.New(color.FgWhite, color.Bold).Sprint("History"))
+
+		for _, entry := range entries {
+			fmt.Printf("%s  %s\n",
+				color.New(color.FgWhite, color.Faint).Sprint(entry.CreatedAt.Local().Format("2006-01-02 15:04")),
+				color.New(color.FgCyan).Sprint(historyActorName(entry)))
+
+			changes := describeHistoryChanges(entry, labelNames)
+			if len(changes) == 0 {
+				fmt.Printf("  %s\n", color.New(color.FgWhite, color.Faint).Sprint("(no tracked fields changed)"))
+			}
+			for _, change := range changes {
+				fmt.Printf("  • %s\n", change)
+			}
+			fmt.Println()
+		}
+
+		fmt.Printf("%s %d events\n", color.New(color.FgGreen).Sprint("✓"), len(entries))
+	},
+}
+
+func init() {
+	issueCmd.AddCommand(issueHistoryCmd)
+}
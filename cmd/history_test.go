@@ -0,0 +1,122 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/yjiky/linctl/pkg/api"
+)
+
+// serveHistory serves ENG-1's history two entries per page, newest first so
+// the command has to sort it
+func serveHistory(env *testEnv) {
+	bug := ""
+	for _, label := range env.fake.Labels() {
+		if label.Name == "bug" {
+			bug = label.ID
+		}
+	}
+	start := time.Date(2030, 1, 2, 9, 30, 0, 0, time.UTC)
+	low, high := 4, 2
+	oldTitle, newTitle := "Login redirect", "Fix login redirect"
+	entries := []api.IssueHistoryEntry{
+		{
+			ID:              "history-3",
+			CreatedAt:       start.Add(2 * time.Hour),
+			Actor:           &api.User{Name: "Bob Jones"},
+			FromPriority:    &low,
+			ToPriority:      &high,
+			AddedLabelIds:   []string{bug},
+			RemovedLabelIds: []string{"deleted-label"},
+		},
+		{
+			ID:         "history-2",
+			CreatedAt:  start.Add(time.Hour),
+			Actor:      &api.User{Name: "Alice Smith"},
+			FromState:  &api.State{Name: "Backlog"},
+			ToState:    &api.State{Name: "Todo"},
+			ToAssignee: &api.User{Name: "Alice Smith"},
+		},
+		{
+			ID:        "history-1",
+			CreatedAt: start,
+			FromTitle: &oldTitle,
+			ToTitle:   &newTitle,
+		},
+	}
+
+	env.fake.Handle("IssueHistory", func(variables map[string]interface{}) (interface{}, error) {
+		if variables["id"] != "ENG-1" {
+			return map[string]interface{}{"issue": nil}, nil
+		}
+		page, info := entries[:2], api.PageInfo{HasNextPage: true, EndCursor: "page-2"}
+		if variables["after"] == "page-2" {
+			page, info = entries[2:], api.PageInfo{}
+		}
+		return map[string]interface{}{
+			"issue": map[string]interface{}{
+				"id":      "issue-1",
+				"history": api.IssueHistory{Nodes: page, PageInfo: info},
+			},
+		}, nil
+	})
+}
+
+func TestIssueHistory(t *testing.T) {
+	env := newWorkspace(t)
+	serveHistory(env)
+
+	var events []issueHistoryEvent
+	env.runJSON(&events, "issue", "history", "ENG-1")
+	if len(events) != 3 || events[0].ID != "history-1" || events[2].ID != "history-3" {
+		t.Fatalf("history events = %+v, want all three oldest first", events)
+	}
+	if added := events[2].AddedLabels; len(added) != 1 || added[0].Name != "bug" {
+		t.Errorf("added labels = %+v, want bug", added)
+	}
+	if removed := events[2].RemovedLabels; len(removed) != 1 || removed[0].ID != "deleted-label" || removed[0].Name != "" {
+		t.Errorf("removed labels = %+v, want the unresolved ID", removed)
+	}
+
+	out := env.run("issue", "history", "ENG-1", "--plaintext")
+	assertContains(t, out,
+		"# History for ENG-1",
+		"- **2030-01-02 09:30** by Linear\n  - Title: \"Login redirect\" → \"Fix login redirect\"",
+		"- **2030-01-02 10:30** by Alice Smith\n  - State: Backlog → Todo\n  - Assigned to Alice Smith",
+		"  - Priority: Low → High\n  - Added label(s): bug\n  - Removed label(s): deleted-label",
+	)
+
+	out = env.run("issue", "history", "ENG-1")
+	assertContains(t, out, "• State: Backlog → Todo", "3 events")
+
+	env.expectExit(exitNotFound, "issue", "history", "ENG-404")
+}
+
+func TestIssueHistoryEmpty(t *testing.T) {
+	env := newWorkspace(t)
+	env.fake.Respond("IssueHistory", map[string]interface{}{
+		"issue": map[string]interface{}{"id": "issue-2", "history": api.IssueHistory{}},
+	})
+
+	out := env.run("issue", "history", "ENG-2", "--plaintext")
+	assertContains(t, out, "No history recorded for ENG-2")
+}
+
+func TestDescribeHistoryChanges(t *testing.T) {
+	entry := api.IssueHistoryEntry{
+		FromAssignee:  &api.User{Name: "Bob Jones"},
+		ToCycle:       &api.Cycle{Number: 7},
+		FromProject:   &api.Project{Name: "Website"},
+		AddedLabelIds: []string{"label-1", "label-2"},
+	}
+	want := []string{"Unassigned from Bob Jones", "Added to cycle Cycle 7", "Removed from project Website", "Added 2 label(s)"}
+	got := describeHistoryChanges(entry, nil)
+	if len(got) != len(want) {
+		t.Fatalf("describeHistoryChanges = %q, want %q", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("change %d = %q, want %q", i, got[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,412 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/yjiky/linctl/pkg/api"
+	"github.com/yjiky/linctl/pkg/auth"
+	"github.com/yjiky/linctl/pkg/output"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// initiativeCmd represents the initiative command
+var initiativeCmd = &cobra.Command{
+	Use:     "initiative",
+	Aliases: []string{"initiatives"},
+	Short:   "Manage Linear initiatives",
+	Long: `Manage Linear initiatives and roll up the progress of their projects.
+
+Initiatives can be referenced by ID, slug, or name.
+
+Examples:
+  linctl initiative list                         # List initiatives with rollups
+  linctl initiative get "Q3 Growth"              # Show an initiative's projects
+  linctl initiative link "Q3 Growth" PROJECT-ID  # Add a project to an initiative
+  linctl initiative unlink "Q3 Growth" PROJECT-ID`,
+}
+
+// averageProgress returns the mean progress of a set of projects
+func averageProgress(projects *api.Projects) float64 {
+	if projects == nil || len(projects.Nodes) == 0 {
+		return 0
+	}
+	total := 0.0
+	for _, project := range projects.Nodes {
+		total += project.Progress
+	}
+	return total / float64(len(projects.Nodes))
+}
+
+// projectCount returns the number of projects in a collection
+func projectCount(projects *api.Projects) int {
+	if projects == nil {
+		return 0
+	}
+	return len(projects.Nodes)
+}
+
+// progressColor picks a display color for a 0-1 progress value
+func progressColor(progress float64) *color.Color {
+	switch {
+	case progress >= 0.75:
+		return color.New(color.FgGreen)
+	case progress >= 0.5:
+		return color.New(color.FgYellow)
+	default:
+		return color.New(color.FgRed)
+	}
+}
+
+// printRollupProjects prints the projects of an initiative or roadmap with
+// progress, health and target dates
+func printRollupProjects(projects *api.Projects, plaintext bool) {
+	if projects == nil || len(projects.Nodes) == 0 {
+		if plaintext {
+			fmt.Println("No projects")
+		} else {
+			fmt.Printf("%s No projects linked\n", color.New(color.FgYellow).Sprint("ℹ️"))
+		}
+		return
+	}
+
+	if plaintext {
+		fmt.Println("Name\tState\tProgress\tHealth\tTarget\tLead\tID")
+		for _, project := range projects.Nodes {
+			target := ""
+			if project.TargetDate != nil {
+				target = *project.TargetDate
+			}
+			lead := ""
+			if project.Lead != nil {
+				lead = project.Lead.Name
+			}
+			fmt.Printf("%s\t%s\t%.0f%%\t%s\t%s\t%s\t%s\n",
+				project.Name, project.State, project.Progress*100, project.Health, target, lead, project.ID)
+		}
+		return
+	}
+
+	headers := []string{"Project", "State", "Progress", "Health", "Target", "Lead"}
+	rows := [][]string{}
+	for _, project := range projects.Nodes {
+		target := color.New(color.FgWhite, color.Faint).Sprint("—")
+		if project.TargetDate != nil {
+			target = *project.TargetDate
+		}
+		lead := color.New(color.FgYellow).Sprint("Unassigned")
+		if project.Lead != nil {
+			lead = project.Lead.Name
+		}
+		health := project.Health
+		if health != "" {
+			health = healthColor(health).Sprint(health)
+		}
+		rows = append(rows, []string{
+			color.New(color.FgCyan).Sprint(truncateString(project.Name, 35)),
+			project.State,
+			progressColor(project.Progress).Sprintf("%.0f%%", project.Progress*100),
+			health,
+			target,
+			lead,
+		})
+	}
+
+	output.Table(output.TableData{
+		Headers: headers,
+		Rows:    rows,
+	}, false, false)
+}
+
+// resolveInitiative finds an initiative by ID or slug, falling back to a
+// case-insensitive name match over the initiatives' names
+func resolveInitiative(ctx context.Context, client *api.Client, ref string) (*api.Initiative, error) {
+	ref = strings.TrimSpace(ref)
+	initiative, err := client.GetInitiative(ctx, ref)
+	if err == nil || !api.IsNotFound(err) {
+		return initiative, err
+	}
+
+	initiatives, err := api.NewPaginator(client.InitiativeNamePages(), 0).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, initiative := range initiatives {
+		if strings.EqualFold(initiative.Name, ref) {
+			return client.GetInitiative(ctx, initiative.ID)
+		}
+	}
+
+	return nil, &api.Error{Code: api.CodeEntityNotFound, Message: fmt.Sprintf("initiative not found: %s", ref)}
+}
+
+var initiativeListCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "List initiatives",
+	Long:    `List initiatives with their status, health, target date and average project progress.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		client, err := auth.NewClient()
+		if err != nil {
+			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		limit := resolveListLimit(cmd)
+
+		paginator := api.NewPaginator(client.InitiativePages(), limit)
+		initiatives, err := paginator.All(context.Background())
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to list initiatives: %v", err), plaintext, jsonOut)
+			os.Exit(exitCodeFor(err))
+		}
+
+		if jsonOut {
+			output.JSON(initiatives)
+			return
+		}
+
+		if len(initiatives) == 0 {
+			output.Info("No initiatives found", plaintext, jsonOut)
+			return
+		}
+
+		if plaintext {
+			fmt.Println("Name\tStatus\tHealth\tTarget\tOwner\tProjects\tProgress\tID")
+			for _, initiative := range initiatives {
+				target := ""
+				if initiative.TargetDate != nil {
+					target = *initiative.TargetDate
+				}
+				owner := ""
+				if initiative.Owner != nil {
+					owner = initiative.Owner.Name
+				}
+				fmt.Printf("%s\t%s\t%s\t%s\t%s\t%d\t%.0f%%\t%s\n",
+					initiative.Name,
+					initiative.Status,
+					initiative.Health,
+					target,
+					owner,
+					projectCount(initiative.Projects),
+					averageProgress(initiative.Projects)*100,
+					initiative.ID,
+				)
+			}
+			return
+		}
+
+		headers := []string{"Name", "Status", "Health", "Target", "Owner", "Projects", "Progress"}
+		rows := [][]string{}
+		for _, initiative := range initiatives {
+			target := color.New(color.FgWhite, color.Faint).Sprint("—")
+			if initiative.TargetDate != nil {
+				target = *initiative.TargetDate
+			}
+			owner := color.New(color.FgYellow).Sprint("Unassigned")
+			if initiative.Owner != nil {
+				owner = initiative.Owner.Name
+			}
+			health := initiative.Health
+			if health != "" {
+				health = healthColor(health).Sprint(health)
+			}
+			progress := averageProgress(initiative.Projects)
+			rows = append(rows, []string{
+				color.New(color.FgCyan, color.Bold).Sprint(truncateString(initiative.Name, 35)),
+				initiative.Status,
+				health,
+				target,
+				owner,
+				fmt.Sprintf("%d", projectCount(initiative.Projects)),
+				progressColor(progress).Sprintf("%.0f%%", progress*100),
+			})
+		}
+
+		output.Table(output.TableData{
+			Headers: headers,
+			Rows:    rows,
+		}, plaintext, jsonOut)
+
+		fmt.Printf("\n%s %d initiatives\n",
+			color.New(color.FgGreen).Sprint("✓"),
+			len(initiatives))
+
+		if paginator.PageInfo().HasNextPage {
+			fmt.Printf("%s Use --limit or --all to see more results\n",
+				color.New(color.FgYellow).Sprint("ℹ️"))
+		}
+	},
+}
+
+var initiativeGetCmd = &cobra.Command{
+	Use:     "get INITIATIVE",
+	Aliases: []string{"show"},
+	Short:   "Show an initiative and its projects",
+	Long:    `Show an initiative with each of its projects' progress, health and target date.`,
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		client, err := auth.NewClient()
+		if err != nil {
+			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		ctx := context.Background()
+
+		initiative, err := resolveInitiative(ctx, client, args[0])
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to find initiative: %v", err), plaintext, jsonOut)
+			os.Exit(exitCodeFor(err))
+		}
+
+		if jsonOut {
+			output.JSON(initiative)
+			return
+		}
+
+		progress := averageProgress(initiative.Projects)
+
+		if plaintext {
+			fmt.Printf("# %s\n\n", initiative.Name)
+			if initiative.Description != "" {
+				fmt.Printf("%s\n\n", initiative.Description)
+			}
+			fmt.Printf("- **ID**: %s\n", initiative.ID)
+			if initiative.Status != "" {
+				fmt.Printf("- **Status**: %s\n", initiative.Status)
+			}
+			if initiative.Health != "" {
+				fmt.Printf("- **Health**: %s\n", initiative.Health)
+			}
+			if initiative.TargetDate != nil {
+				fmt.Printf("- **Target Date**: %s\n", *initiative.TargetDate)
+			}
+			if initiative.Owner != nil {
+				fmt.Printf("- **Owner**: %s\n", initiative.Owner.Name)
+			}
+			fmt.Printf("- **Progress**: %.0f%% across %d projects\n", progress*100, projectCount(initiative.Projects))
+			if initiative.URL != "" {
+				fmt.Printf("- **URL**: %s\n", initiative.URL)
+			}
+			fmt.Printf("\n## Projects\n")
+			printRollupProjects(initiative.Projects, true)
+			return
+		}
+
+		fmt.Println()
+		fmt.Printf("%s %s\n", color.New(color.FgMagenta, color.Bold).Sprint("🎯 Initiative:"), initiative.Name)
+		fmt.Println(strings.Repeat("─", 50))
+		if initiative.Description != "" {
+			fmt.Printf("%s\n\n", initiative.Description)
+		}
+		if initiative.Status != "" {
+			fmt.Printf("%s %s\n", color.New(color.Bold).Sprint("Status:"), initiative.Status)
+		}
+		if initiative.Health != "" {
+			fmt.Printf("%s %s\n", color.New(color.Bold).Sprint("Health:"), healthColor(initiative.Health).Sprint(initiative.Health))
+		}
+		if initiative.TargetDate != nil {
+			fmt.Printf("%s %s\n", color.New(color.Bold).Sprint("Target:"), *initiative.TargetDate)
+		}
+		if initiative.Owner != nil {
+			fmt.Printf("%s %s\n", color.New(color.Bold).Sprint("Owner:"), initiative.Owner.Name)
+		}
+		fmt.Printf("%s %s across %d projects\n",
+			color.New(color.Bold).Sprint("Progress:"),
+			progressColor(progress).Sprintf("%.0f%%", progress*100),
+			projectCount(initiative.Projects))
+		fmt.Println()
+		printRollupProjects(initiative.Projects, false)
+	},
+}
+
+// runProjectLinks links or unlinks projects against an initiative or roadmap,
+// reporting each project like the issue bulk actions do
+func runProjectLinks(client *api.Client, projectRefs []string, verb string, action func(ctx context.Context, projectID string) error, plaintext, jsonOut bool) {
+	runBatchAction(projectRefs, verb, func(ctx context.Context, ref string) error {
+		projectID, err := resolveProjectID(ctx, client, ref)
+		if err != nil {
+			return err
+		}
+		return action(ctx, projectID)
+	}, plaintext, jsonOut)
+}
+
+var initiativeLinkCmd = &cobra.Command{
+	Use:   "link INITIATIVE PROJECT-ID...",
+	Short: "Add projects to an initiative",
+	Args:  cobra.MinimumNArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		client, err := auth.NewClient()
+		if err != nil {
+			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		ctx := context.Background()
+
+		initiative, err := resolveInitiative(ctx, client, args[0])
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to find initiative: %v", err), plaintext, jsonOut)
+			os.Exit(exitCodeFor(err))
+		}
+
+		runProjectLinks(client, args[1:], "Linked", func(ctx context.Context, projectID string) error {
+			return client.LinkInitiativeProject(ctx, initiative.ID, projectID)
+		}, plaintext, jsonOut)
+	},
+}
+
+var initiativeUnlinkCmd = &cobra.Command{
+	Use:   "unlink INITIATIVE PROJECT-ID...",
+	Short: "Remove projects from an initiative",
+	Args:  cobra.MinimumNArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		client, err := auth.NewClient()
+		if err != nil {
+			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		ctx := context.Background()
+
+		initiative, err := resolveInitiative(ctx, client, args[0])
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to find initiative: %v", err), plaintext, jsonOut)
+			os.Exit(exitCodeFor(err))
+		}
+
+		runProjectLinks(client, args[1:], "Unlinked", func(ctx context.Context, projectID string) error {
+			return client.UnlinkInitiativeProject(ctx, initiative.ID, projectID)
+		}, plaintext, jsonOut)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(initiativeCmd)
+	initiativeCmd.AddCommand(initiativeListCmd)
+	initiativeCmd.AddCommand(initiativeGetCmd)
+	initiativeCmd.AddCommand(initiativeLinkCmd)
+	initiativeCmd.AddCommand(initiativeUnlinkCmd)
+
+	initiativeListCmd.Flags().IntP("limit", "l", 50, "Maximum number of initiatives to fetch (pages automatically)")
+	initiativeListCmd.Flags().Bool("all", false, "Fetch every initiative, ignoring --limit")
+}
@@ -0,0 +1,251 @@
+package cmd
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/yjiky/linctl/pkg/api"
+)
+
+// rollups serves initiatives or roadmaps, which linearfake does not model,
+// with their project links. The operations of both follow the same shape,
+// named after kind ("Initiative" or "Roadmap").
+type rollups struct {
+	env   *testEnv
+	kind  string
+	items []map[string]interface{}
+	links [][2]string // item ID, project ID
+}
+
+func serveRollups(env *testEnv, kind string, items ...map[string]interface{}) *rollups {
+	r := &rollups{env: env, kind: kind, items: items}
+	field := strings.ToLower(kind[:1]) + kind[1:]
+
+	env.fake.Handle(kind+"s", func(map[string]interface{}) (interface{}, error) {
+		nodes := []map[string]interface{}{}
+		for _, item := range r.items {
+			nodes = append(nodes, r.view(item))
+		}
+		return map[string]interface{}{field + "s": map[string]interface{}{"nodes": nodes, "pageInfo": api.PageInfo{}}}, nil
+	})
+	env.fake.Handle(kind+"Names", func(map[string]interface{}) (interface{}, error) {
+		nodes := []map[string]interface{}{}
+		for _, item := range r.items {
+			nodes = append(nodes, map[string]interface{}{"id": item["id"], "slugId": item["slugId"], "name": item["name"]})
+		}
+		return map[string]interface{}{field + "s": map[string]interface{}{"nodes": nodes, "pageInfo": api.PageInfo{}}}, nil
+	})
+	env.fake.Handle(kind, func(variables map[string]interface{}) (interface{}, error) {
+		for _, item := range r.items {
+			if item["id"] == variables["id"] || (item["slugId"] != nil && item["slugId"] == variables["id"]) {
+				view := r.view(item)
+				view["projects"] = projectPage(r.linked(item["id"]), variables)
+				return map[string]interface{}{field: view}, nil
+			}
+		}
+		return map[string]interface{}{field: nil}, nil
+	})
+	env.fake.Handle(kind+"Projects", func(variables map[string]interface{}) (interface{}, error) {
+		return map[string]interface{}{field: map[string]interface{}{
+			"id":       variables["id"],
+			"projects": projectPage(r.linked(variables["id"]), variables),
+		}}, nil
+	})
+	env.fake.Handle("Link"+kind+"Project", func(variables map[string]interface{}) (interface{}, error) {
+		input := variables["input"].(map[string]interface{})
+		r.links = append(r.links, [2]string{input[field+"Id"].(string), input["projectId"].(string)})
+		return map[string]interface{}{field + "ToProjectCreate": map[string]interface{}{"success": true}}, nil
+	})
+	env.fake.Handle(kind+"ToProjects", func(map[string]interface{}) (interface{}, error) {
+		nodes := []map[string]interface{}{}
+		for i, link := range r.links {
+			nodes = append(nodes, map[string]interface{}{
+				"id":      fmt.Sprintf("link-%d", i),
+				field:     map[string]interface{}{"id": link[0]},
+				"project": map[string]interface{}{"id": link[1]},
+			})
+		}
+		return map[string]interface{}{field + "ToProjects": map[string]interface{}{"nodes": nodes, "pageInfo": api.PageInfo{}}}, nil
+	})
+	env.fake.Handle("Unlink"+kind+"Project", func(variables map[string]interface{}) (interface{}, error) {
+		var i int
+		if _, err := fmt.Sscanf(variables["id"].(string), "link-%d", &i); err != nil || i >= len(r.links) {
+			return nil, &api.Error{Code: api.CodeInvalidInput, Message: "Entity not found: " + kind + "ToProject"}
+		}
+		r.links = append(r.links[:i], r.links[i+1:]...)
+		return map[string]interface{}{field + "ToProjectDelete": map[string]interface{}{"success": true}}, nil
+	})
+	return r
+}
+
+// view renders an item with its linked projects
+func (r *rollups) view(item map[string]interface{}) map[string]interface{} {
+	view := map[string]interface{}{"projects": map[string]interface{}{"nodes": r.linked(item["id"])}}
+	for key, value := range item {
+		view[key] = value
+	}
+	return view
+}
+
+// linked returns the projects linked to an item
+func (r *rollups) linked(id interface{}) []api.Project {
+	projects := []api.Project{}
+	for _, link := range r.links {
+		if link[0] == id {
+			project, _ := r.env.fake.Project(link[1])
+			projects = append(projects, api.Project{ID: project.ID, Name: project.Name, State: project.State, Progress: project.Progress, Health: project.Health, Lead: project.Lead})
+		}
+	}
+	return projects
+}
+
+// projectPage returns the page of projects that the first and after
+// variables of a request ask for
+func projectPage(projects []api.Project, variables map[string]interface{}) map[string]interface{} {
+	start := 0
+	if after, _ := variables["after"].(string); after != "" {
+		for i, project := range projects {
+			if project.ID == after {
+				start = i + 1
+			}
+		}
+	}
+	end := len(projects)
+	if first, ok := variables["first"].(float64); ok && start+int(first) < end {
+		end = start + int(first)
+	}
+
+	page := projects[start:end]
+	pageInfo := api.PageInfo{HasNextPage: end < len(projects)}
+	if len(page) > 0 {
+		pageInfo.EndCursor = page[len(page)-1].ID
+	}
+	return map[string]interface{}{"nodes": page, "pageInfo": pageInfo}
+}
+
+// projects returns the names of the projects linked to an item, sorted
+func (r *rollups) projects(id string) []string {
+	names := []string{}
+	for _, link := range r.links {
+		if link[0] == id {
+			project, _ := r.env.fake.Project(link[1])
+			names = append(names, project.Name)
+		}
+	}
+	return names
+}
+
+// newInitiatives returns a workspace with a second project and two
+// initiatives, the first of which contains Website
+func newInitiatives(t *testing.T) (*testEnv, *rollups) {
+	env := newWorkspace(t)
+	env.fake.AddProject(api.Project{Name: "Mobile app", State: "planned", Teams: &api.Teams{Nodes: []api.Team{{Key: "ENG"}}}})
+	initiatives := serveRollups(env, "Initiative",
+		map[string]interface{}{"id": "init-growth", "slugId": "q3g", "name": "Q3 Growth", "status": "Active", "health": api.HealthOnTrack, "targetDate": "2030-09-30", "owner": map[string]interface{}{"name": "Alice Smith"}},
+		map[string]interface{}{"id": "init-infra", "name": "Infrastructure", "status": "Planned"},
+	)
+	initiatives.links = append(initiatives.links, [2]string{"init-growth", env.projectID("Website")})
+	return env, initiatives
+}
+
+func TestInitiativeList(t *testing.T) {
+	env, _ := newInitiatives(t)
+
+	var initiatives []api.Initiative
+	env.runJSON(&initiatives, "initiative", "list")
+	if len(initiatives) != 2 || projectCount(initiatives[0].Projects) != 1 {
+		t.Errorf("initiative list = %+v", initiatives)
+	}
+
+	out := env.run("initiatives", "ls", "--plaintext")
+	assertContains(t, out,
+		"Name\tStatus\tHealth\tTarget\tOwner\tProjects\tProgress\tID",
+		"Q3 Growth\tActive\tonTrack\t2030-09-30\tAlice Smith\t1\t0%\tinit-growth",
+		"Infrastructure\tPlanned\t\t\t\t0\t0%\tinit-infra",
+	)
+
+	env.run("initiative", "list", "--all")
+	if first := env.lastVariables("Initiatives")["first"]; first != float64(50) {
+		t.Errorf("initiative list --all asked for pages of %v, want 50 to stay under the complexity limit", first)
+	}
+
+	env.fake.Respond("Initiatives", map[string]interface{}{"initiatives": api.Initiatives{Nodes: []api.Initiative{}}})
+	out = env.run("initiative", "list", "--plaintext")
+	assertContains(t, out, "No initiatives found")
+}
+
+func TestInitiativeGet(t *testing.T) {
+	env, _ := newInitiatives(t)
+
+	var initiative api.Initiative
+	env.runJSON(&initiative, "initiative", "get", "q3g")
+	if initiative.ID != "init-growth" || projectCount(initiative.Projects) != 1 {
+		t.Errorf("initiative get by slug = %+v", initiative)
+	}
+	if _, ok := env.fake.LastRequest("InitiativeNames"); ok {
+		t.Error("initiative get by slug listed initiatives")
+	}
+
+	out := env.run("initiative", "show", "q3 growth", "--plaintext")
+	assertContains(t, out,
+		"# Q3 Growth",
+		"- **Status**: Active",
+		"- **Target Date**: 2030-09-30",
+		"- **Progress**: 0% across 1 projects",
+		"## Projects\nName\tState\tProgress\tHealth\tTarget\tLead\tID\nWebsite\tstarted\t0%\t\t\tAlice Smith\t"+env.projectID("Website"),
+	)
+
+	out = env.run("initiative", "get", "init-infra", "--plaintext")
+	assertContains(t, out, "## Projects\nNo projects")
+
+	out = env.expectExit(exitNotFound, "initiative", "get", "Q4 Growth")
+	assertContains(t, out, "initiative not found: Q4 Growth")
+}
+
+func TestInitiativeGetPaged(t *testing.T) {
+	env, initiatives := newInitiatives(t)
+	for n := 0; n < 260; n++ {
+		project := env.fake.AddProject(api.Project{Name: fmt.Sprintf("Project %d", n), State: "planned"})
+		initiatives.links = append(initiatives.links, [2]string{"init-infra", project.ID})
+	}
+
+	var initiative api.Initiative
+	env.runJSON(&initiative, "initiative", "get", "init-infra")
+	if got := projectCount(initiative.Projects); got != 260 {
+		t.Errorf("initiative get returned %d projects, want 260", got)
+	}
+	if after, _ := env.lastVariables("InitiativeProjects")["after"].(string); after == "" {
+		t.Error("InitiativeProjects was not requested after the first page")
+	}
+}
+
+func TestInitiativeLinkAndUnlink(t *testing.T) {
+	env, initiatives := newInitiatives(t)
+	website, mobile := env.projectID("Website"), env.projectID("Mobile app")
+
+	out := env.run("initiative", "link", "Infrastructure", website, mobile, "--plaintext")
+	assertContains(t, out, "Linked "+website, "Linked "+mobile)
+	if got := initiatives.projects("init-infra"); !reflect.DeepEqual(got, []string{"Website", "Mobile app"}) {
+		t.Errorf("Infrastructure projects = %v", got)
+	}
+
+	out = env.run("initiative", "unlink", "Q3 Growth", website, "--plaintext")
+	assertContains(t, out, "Unlinked "+website)
+	if got := initiatives.projects("init-growth"); len(got) != 0 {
+		t.Errorf("Q3 Growth projects = %v, want none", got)
+	}
+
+	var results []batchResult
+	stdout, _, code := env.runExit("initiative", "unlink", "Q3 Growth", mobile, "no-such-project", "--json")
+	if code != exitNotFound {
+		t.Errorf("unlink exited with %d, want %d", code, exitNotFound)
+	}
+	decodeJSON(t, stdout, &results)
+	if len(results) != 2 || results[0].Success || results[1].Success {
+		t.Errorf("unlink results = %+v, want both to fail", results)
+	}
+
+	env.expectExit(exitNotFound, "initiative", "link", "Q4 Growth", website)
+}
@@ -28,7 +28,9 @@ Examples:
   linctl issue list --newer-than 3_weeks_ago  # Show issues from last 3 weeks
   linctl issue search "login bug" --team ENG
   linctl issue get LIN-123
-  linctl issue create --title "Bug fix" --team ENG`,
+  linctl issue create --title "Bug fix" --team ENG
+  linctl issue archive LIN-123 LIN-124
+  linctl issue delete LIN-123 --yes`,
 }
 
 var issueListCmd = &cobra.Command{
@@ -40,21 +42,16 @@ var issueListCmd = &cobra.Command{
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
 
-		authHeader, err := auth.GetAuthHeader()
+		client, err := auth.NewClient()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitAuth)
 		}
 
-		client := api.NewClient(authHeader)
-
 		// Build filter from flags
 		filter := buildIssueFilter(cmd)
 
-		limit, _ := cmd.Flags().GetInt("limit")
-		if limit == 0 {
-			limit = 50
-		}
+		limit := resolveListLimit(cmd)
 
 		// Get sort option
 		sortBy, _ := cmd.Flags().GetString("sort")
@@ -74,11 +71,13 @@ var issueListCmd = &cobra.Command{
 			}
 		}
 
-		issues, err := client.GetIssues(context.Background(), filter, limit, "", orderBy)
+		paginator := api.NewPaginator(client.IssuePages(filter, orderBy, false), limit)
+		nodes, err := paginator.All(context.Background())
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to fetch issues: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCodeFor(err))
 		}
+		issues := &api.Issues{Nodes: nodes, PageInfo: paginator.PageInfo()}
 
 		renderIssueCollection(issues, plaintext, jsonOut, "No issues found", "issues", "# Issues")
 	},
@@ -186,7 +185,7 @@ func renderIssueCollection(issues *api.Issues, plaintext, jsonOut bool, emptyMes
 		summaryLabel)
 
 	if issues.PageInfo.HasNextPage {
-		fmt.Printf("%s Use --limit to see more results\n",
+		fmt.Printf("%s Use --limit or --all to see more results\n",
 			color.New(color.FgYellow).Sprint("ℹ️"))
 	}
 }
@@ -212,20 +211,15 @@ Examples:
 			os.Exit(1)
 		}
 
-		authHeader, err := auth.GetAuthHeader()
+		client, err := auth.NewClient()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitAuth)
 		}
 
-		client := api.NewClient(authHeader)
-
 		filter := buildIssueFilter(cmd)
 
-		limit, _ := cmd.Flags().GetInt("limit")
-		if limit == 0 {
-			limit = 50
-		}
+		limit := resolveListLimit(cmd)
 
 		sortBy, _ := cmd.Flags().GetString("sort")
 		orderBy := ""
@@ -245,11 +239,13 @@ Examples:
 
 		includeArchived, _ := cmd.Flags().GetBool("include-archived")
 
-		issues, err := client.IssueSearch(context.Background(), query, filter, limit, "", orderBy, includeArchived)
+		paginator := api.NewPaginator(client.IssueSearchPages(query, filter, orderBy, includeArchived), limit)
+		nodes, err := paginator.All(context.Background())
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to search issues: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCodeFor(err))
 		}
+		issues := &api.Issues{Nodes: nodes, PageInfo: paginator.PageInfo()}
 
 		emptyMsg := fmt.Sprintf("No matches found for %q", query)
 		renderIssueCollection(issues, plaintext, jsonOut, emptyMsg, "matches", "# Search Results")
@@ -266,17 +262,16 @@ var issueGetCmd = &cobra.Command{
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
 
-		authHeader, err := auth.GetAuthHeader()
+		client, err := auth.NewClient()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitAuth)
 		}
 
-		client := api.NewClient(authHeader)
 		issue, err := client.GetIssue(context.Background(), args[0])
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to fetch issue: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCodeFor(err))
 		}
 
 		if jsonOut {
@@ -379,6 +374,9 @@ var issueGetCmd = &cobra.Command{
 				if issue.Project.Description != "" {
 					fmt.Printf("- **Description**: %s\n", issue.Project.Description)
 				}
+				if issue.ProjectMilestone != nil {
+					fmt.Printf("- **Milestone**: %s\n", issue.ProjectMilestone.Name)
+				}
 			}
 
 			if issue.Cycle != nil {
@@ -516,37 +514,8 @@ var issueGetCmd = &cobra.Command{
 			if issue.History != nil && len(issue.History.Nodes) > 0 {
 				fmt.Printf("\n## Recent History\n")
 				for _, entry := range issue.History.Nodes {
-					fmt.Printf("\n- **%s** by %s", entry.CreatedAt.Format("2006-01-02 15:04"), entry.Actor.Name)
-					changes := []string{}
-
-					if entry.FromState != nil && entry.ToState != nil {
-						changes = append(changes, fmt.Sprintf("State: %s → %s", entry.FromState.Name, entry.ToState.Name))
-					}
-					if entry.FromAssignee != nil && entry.ToAssignee != nil {
-						changes = append(changes, fmt.Sprintf("Assignee: %s → %s", entry.FromAssignee.Name, entry.ToAssignee.Name))
-					} else if entry.FromAssignee != nil && entry.ToAssignee == nil {
-						changes = append(changes, fmt.Sprintf("Unassigned from %s", entry.FromAssignee.Name))
-					} else if entry.FromAssignee == nil && entry.ToAssignee != nil {
-						changes = append(changes, fmt.Sprintf("Assigned to %s", entry.ToAssignee.Name))
-					}
-					if entry.FromPriority != nil && entry.ToPriority != nil {
-						changes = append(changes, fmt.Sprintf("Priority: %s → %s", priorityToString(*entry.FromPriority), priorityToString(*entry.ToPriority)))
-					}
-					if entry.FromTitle != nil && entry.ToTitle != nil {
-						changes = append(changes, fmt.Sprintf("Title: \"%s\" → \"%s\"", *entry.FromTitle, *entry.ToTitle))
-					}
-					if entry.FromCycle != nil && entry.ToCycle != nil {
-						changes = append(changes, fmt.Sprintf("Cycle: %s → %s", entry.FromCycle.Name, entry.ToCycle.Name))
-					}
-					if entry.FromProject != nil && entry.ToProject != nil {
-						changes = append(changes, fmt.Sprintf("Project: %s → %s", entry.FromProject.Name, entry.ToProject.Name))
-					}
-					if len(entry.AddedLabelIds) > 0 {
-						changes = append(changes, fmt.Sprintf("Added %d label(s)", len(entry.AddedLabelIds)))
-					}
-					if len(entry.RemovedLabelIds) > 0 {
-						changes = append(changes, fmt.Sprintf("Removed %d label(s)", len(entry.RemovedLabelIds)))
-					}
+					fmt.Printf("\n- **%s** by %s", entry.CreatedAt.Format("2006-01-02 15:04"), historyActorName(entry))
+					changes := describeHistoryChanges(entry, nil)
 
 					if len(changes) > 0 {
 						fmt.Printf("\n  - %s", strings.Join(changes, "\n  - "))
@@ -600,6 +569,11 @@ var issueGetCmd = &cobra.Command{
 				color.New(color.FgWhite, color.Faint).Sprintf("%.0f%%", issue.Project.Progress*100))
 		}
 
+		if issue.ProjectMilestone != nil {
+			fmt.Printf("Milestone: %s\n",
+				color.New(color.FgBlue).Sprint(issue.ProjectMilestone.Name))
+		}
+
 		if issue.Cycle != nil {
 			fmt.Printf("Cycle: %s\n",
 				color.New(color.FgMagenta).Sprint(issue.Cycle.Name))
@@ -730,7 +704,7 @@ func buildIssueFilter(cmd *cobra.Command) map[string]interface{} {
 		}
 	}
 
-	if team, _ := cmd.Flags().GetString("team"); team != "" {
+	if team := teamKeyOrDefault(cmd); team != "" {
 		filter["team"] = map[string]interface{}{"key": map[string]interface{}{"eq": team}}
 	}
 
@@ -759,6 +733,36 @@ func buildIssueFilter(cmd *cobra.Command) map[string]interface{} {
 		filter["priority"] = map[string]interface{}{"eq": priority}
 	}
 
+	if cycleRef, _ := cmd.Flags().GetString("cycle"); strings.TrimSpace(cycleRef) != "" {
+		if strings.EqualFold(strings.TrimSpace(cycleRef), "none") {
+			filter["cycle"] = map[string]interface{}{"null": true}
+		} else {
+			cycleFilter, err := api.CycleFilter(cycleRef)
+			if err != nil {
+				plaintext := viper.GetBool("plaintext")
+				jsonOut := viper.GetBool("json")
+				output.Error(fmt.Sprintf("Invalid cycle value: %v", err), plaintext, jsonOut)
+				os.Exit(1)
+			}
+			filter["cycle"] = cycleFilter
+		}
+	}
+
+	if milestoneRef, _ := cmd.Flags().GetString("milestone"); strings.TrimSpace(milestoneRef) != "" {
+		if strings.EqualFold(strings.TrimSpace(milestoneRef), "none") {
+			filter["projectMilestone"] = map[string]interface{}{"null": true}
+		} else {
+			milestoneFilter, err := api.MilestoneFilter(milestoneRef)
+			if err != nil {
+				plaintext := viper.GetBool("plaintext")
+				jsonOut := viper.GetBool("json")
+				output.Error(fmt.Sprintf("Invalid milestone value: %v", err), plaintext, jsonOut)
+				os.Exit(1)
+			}
+			filter["projectMilestone"] = milestoneFilter
+		}
+	}
+
 	// Handle newer-than filter
 	newerThan, _ := cmd.Flags().GetString("newer-than")
 	createdAt, err := utils.ParseTimeExpression(newerThan)
@@ -766,7 +770,7 @@ func buildIssueFilter(cmd *cobra.Command) map[string]interface{} {
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
 		output.Error(fmt.Sprintf("Invalid newer-than value: %v", err), plaintext, jsonOut)
-		os.Exit(1)
+		os.Exit(exitCodeFor(err))
 	}
 	if createdAt != "" {
 		filter["createdAt"] = map[string]interface{}{"gte": createdAt}
@@ -837,6 +841,48 @@ func resolveIssueLabelIDs(ctx context.Context, client *api.Client, teamKey strin
 	return resolved, nil
 }
 
+// resolveUserID resolves 'me', an email address, or a display name to a user ID
+func resolveUserID(ctx context.Context, client *api.Client, ref string) (string, error) {
+	ref = strings.TrimSpace(ref)
+	if ref == "me" {
+		viewer, err := client.GetViewer(ctx)
+		if err != nil {
+			return "", err
+		}
+		return viewer.ID, nil
+	}
+
+	users, err := api.NewPaginator(client.UserPages(""), 0).All(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	for _, user := range users {
+		if strings.EqualFold(user.Email, ref) || user.Name == ref || user.ID == ref {
+			return user.ID, nil
+		}
+	}
+
+	return "", &api.Error{Code: api.CodeEntityNotFound, Message: fmt.Sprintf("user not found: %s", ref)}
+}
+
+// resolveUserIDs resolves a comma-separated list of users (see resolveUserID)
+func resolveUserIDs(ctx context.Context, client *api.Client, value string) ([]string, error) {
+	ids := []string{}
+	for _, raw := range strings.Split(value, ",") {
+		ref := strings.TrimSpace(raw)
+		if ref == "" {
+			continue
+		}
+		id, err := resolveUserID(ctx, client, ref)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
 func priorityToString(priority int) string {
 	switch priority {
 	case 0:
@@ -861,6 +907,30 @@ func truncateString(s string, maxLen int) string {
 	return s[:maxLen-3] + "..."
 }
 
+// resolveListLimit returns the number of results a list command should fetch.
+// Zero means every page, which is what --all asks for. Without --limit, the
+// limit configured for the command in ~/.linctl.yaml applies.
+func resolveListLimit(cmd *cobra.Command) int {
+	if all, _ := cmd.Flags().GetBool("all"); all {
+		return 0
+	}
+
+	if !cmd.Flags().Changed("limit") {
+		return settings.LimitFor(commandPath(cmd))
+	}
+
+	limit, _ := cmd.Flags().GetInt("limit")
+	if limit <= 0 {
+		limit = settings.Limit
+	}
+	return limit
+}
+
+// commandPath returns the command's path below the root, e.g. "issue list"
+func commandPath(cmd *cobra.Command) string {
+	return strings.TrimPrefix(cmd.CommandPath(), cmd.Root().Name()+" ")
+}
+
 var issueAssignCmd = &cobra.Command{
 	Use:   "assign [issue-id]",
 	Short: "Assign issue to yourself",
@@ -870,19 +940,17 @@ var issueAssignCmd = &cobra.Command{
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
 
-		authHeader, err := auth.GetAuthHeader()
+		client, err := auth.NewClient()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitAuth)
 		}
 
-		client := api.NewClient(authHeader)
-
 		// Get current user
 		viewer, err := client.GetViewer(context.Background())
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to get current user: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCodeFor(err))
 		}
 
 		// Update issue with assignee
@@ -893,7 +961,7 @@ var issueAssignCmd = &cobra.Command{
 		issue, err := client.UpdateIssue(context.Background(), args[0], input)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to assign issue: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCodeFor(err))
 		}
 
 		if jsonOut {
@@ -913,19 +981,43 @@ var issueCreateCmd = &cobra.Command{
 	Use:     "create",
 	Aliases: []string{"new"},
 	Short:   "Create a new issue",
-	Long:    `Create a new issue in Linear.`,
+	Long: `Create a new issue in Linear.
+
+With --file, the issue is read from a markdown file (use - for stdin). Its
+YAML front-matter can set title, team, labels, priority, assignee, project,
+parent, estimate and due_date; the body becomes the description. Without a
+front-matter title, a leading "# Heading" is used. Command-line flags
+override the file.
+
+With --template, the issue starts from a Linear issue template: its title
+and team are used when not given, and Linear fills in the rest.
+
+Examples:
+  linctl issue create --title "Fix login" --team ENG
+  linctl issue create --file bug.md
+  linctl issue create --template "Bug report" --team ENG --title "Crash on save"
+
+Example file:
+  ---
+  team: ENG
+  labels: [Bug, Backend]
+  priority: high
+  assignee: me
+  due_date: 2024-12-31
+  ---
+  # Checkout fails for guest users
+
+  Steps to reproduce...`,
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
 
-		authHeader, err := auth.GetAuthHeader()
+		client, err := auth.NewClient()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitAuth)
 		}
 
-		client := api.NewClient(authHeader)
-
 		// Get flags
 		title, _ := cmd.Flags().GetString("title")
 		description, _ := cmd.Flags().GetString("description")
@@ -933,22 +1025,115 @@ var issueCreateCmd = &cobra.Command{
 		priority, _ := cmd.Flags().GetInt("priority")
 		assignToMe, _ := cmd.Flags().GetBool("assign-me")
 		labelsValue, _ := cmd.Flags().GetString("labels")
+		cycleRef, _ := cmd.Flags().GetString("cycle")
+		projectRef, _ := cmd.Flags().GetString("project")
+		milestoneRef, _ := cmd.Flags().GetString("milestone")
+		file, _ := cmd.Flags().GetString("file")
+		templateRef, _ := cmd.Flags().GetString("template")
+
+		explicitPriority := cmd.Flags().Changed("priority")
+		var assigneeRef, parentRef, dueDate string
+		var estimate *float64
+
+		// Fill in anything not given on the command line from the file
+		if file != "" {
+			content, err := readTextFile(file)
+			if err != nil {
+				output.Error(err.Error(), plaintext, jsonOut)
+				os.Exit(1)
+			}
+			meta, body, err := parseIssueMarkdown(content)
+			if err != nil {
+				output.Error(fmt.Sprintf("Failed to parse %s: %v", file, err), plaintext, jsonOut)
+				os.Exit(1)
+			}
+
+			if !cmd.Flags().Changed("title") {
+				title = meta.Title
+			}
+			if !cmd.Flags().Changed("description") {
+				description = body
+			}
+			if !cmd.Flags().Changed("team") {
+				teamKey = meta.Team
+			}
+			if !cmd.Flags().Changed("labels") && len(meta.Labels) > 0 {
+				labelsValue = strings.Join(meta.Labels, ",")
+			}
+			if !cmd.Flags().Changed("project") {
+				projectRef = meta.Project
+			}
+			if !explicitPriority && meta.Priority != "" {
+				priority, err = parsePriority(meta.Priority)
+				if err != nil {
+					output.Error(err.Error(), plaintext, jsonOut)
+					os.Exit(1)
+				}
+				explicitPriority = true
+			}
+			if !assignToMe {
+				assigneeRef = meta.Assignee
+			}
+			parentRef = meta.Parent
+			estimate = meta.Estimate
+			dueDate = meta.DueDate
+		}
+
+		// The team setting applies before the template is resolved, so that a
+		// template name is looked up in the default team
+		defaultTeam := false
+		if teamKey == "" {
+			teamKey = teamKeyOrDefault(cmd)
+			defaultTeam = teamKey != ""
+		}
+
+		var template *api.Template
+		if templateRef != "" {
+			template, err = resolveIssueTemplate(context.Background(), client, templateRef, teamKey)
+			if err != nil {
+				output.Error(fmt.Sprintf("Failed to find template '%s': %v", templateRef, err), plaintext, jsonOut)
+				os.Exit(exitCodeFor(err))
+			}
+			data, err := template.Data()
+			if err != nil {
+				output.Error(fmt.Sprintf("Failed to read template '%s': %v", template.Name, err), plaintext, jsonOut)
+				os.Exit(1)
+			}
+			if templateTitle, ok := data["title"].(string); ok && title == "" {
+				title = templateTitle
+			}
+			// A template picked by ID belongs to its own team
+			if (teamKey == "" || defaultTeam) && template.Team != nil {
+				teamKey = template.Team.Key
+			}
+		}
 
 		if title == "" {
-			output.Error("Title is required (--title)", plaintext, jsonOut)
+			output.Error("Title is required (--title, front-matter, or template)", plaintext, jsonOut)
 			os.Exit(1)
 		}
 
 		if teamKey == "" {
-			output.Error("Team is required (--team)", plaintext, jsonOut)
+			output.Error("Team is required (--team, front-matter, template, or the team setting)", plaintext, jsonOut)
 			os.Exit(1)
 		}
 
+		// Write the description in $EDITOR when asked to, or when it was not
+		// given any other way in an interactive session
+		editor, _ := cmd.Flags().GetBool("editor")
+		if editor || (file == "" && templateRef == "" && useEditor(cmd, "description", plaintext, jsonOut)) {
+			description, err = editText(description, "linctl-issue-*.md")
+			if err != nil {
+				output.Error(err.Error(), plaintext, jsonOut)
+				os.Exit(1)
+			}
+		}
+
 		// Get team ID from key
 		team, err := client.GetTeam(context.Background(), teamKey)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to find team '%s': %v", teamKey, err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCodeFor(err))
 		}
 
 		// Build input
@@ -961,7 +1146,13 @@ var issueCreateCmd = &cobra.Command{
 			input["description"] = description
 		}
 
-		if priority >= 0 && priority <= 4 {
+		// Template values apply unless overridden, so only send the default
+		// priority when no template is used
+		if template != nil {
+			input["templateId"] = template.ID
+		}
+
+		if (template == nil || explicitPriority) && priority >= 0 && priority <= 4 {
 			input["priority"] = priority
 		}
 
@@ -969,18 +1160,42 @@ var issueCreateCmd = &cobra.Command{
 			viewer, err := client.GetViewer(context.Background())
 			if err != nil {
 				output.Error(fmt.Sprintf("Failed to get current user: %v", err), plaintext, jsonOut)
-				os.Exit(1)
+				os.Exit(exitCodeFor(err))
 			}
 			input["assigneeId"] = viewer.ID
+		} else if assigneeRef != "" {
+			assigneeID, err := resolveUserID(context.Background(), client, assigneeRef)
+			if err != nil {
+				output.Error(fmt.Sprintf("Failed to resolve assignee: %v", err), plaintext, jsonOut)
+				os.Exit(exitCodeFor(err))
+			}
+			input["assigneeId"] = assigneeID
 		}
 
-		if cmd.Flags().Changed("labels") {
+		if parentRef != "" {
+			parentIssue, err := client.GetIssue(context.Background(), parentRef)
+			if err != nil {
+				output.Error(fmt.Sprintf("Failed to resolve parent issue '%s': %v", parentRef, err), plaintext, jsonOut)
+				os.Exit(exitCodeFor(err))
+			}
+			input["parentId"] = parentIssue.ID
+		}
+
+		if estimate != nil {
+			input["estimate"] = *estimate
+		}
+
+		if dueDate != "" {
+			input["dueDate"] = dueDate
+		}
+
+		if labelsValue != "" {
 			trimmed := strings.TrimSpace(labelsValue)
 			if trimmed != "" && !strings.EqualFold(trimmed, "none") {
 				labelIDs, err := resolveIssueLabelIDs(context.Background(), client, team.Key, trimmed)
 				if err != nil {
 					output.Error(fmt.Sprintf("Failed to resolve labels: %v", err), plaintext, jsonOut)
-					os.Exit(1)
+					os.Exit(exitCodeFor(err))
 				}
 				if len(labelIDs) > 0 {
 					input["labelIds"] = labelIDs
@@ -988,11 +1203,44 @@ var issueCreateCmd = &cobra.Command{
 			}
 		}
 
+		if cmd.Flags().Changed("cycle") {
+			cycleID, err := resolveCycleID(context.Background(), client, team.Key, cycleRef)
+			if err != nil {
+				output.Error(fmt.Sprintf("Failed to resolve cycle '%s': %v", cycleRef, err), plaintext, jsonOut)
+				os.Exit(exitCodeFor(err))
+			}
+			if cycleID != nil {
+				input["cycleId"] = cycleID
+			}
+		}
+
+		projectID := ""
+		if strings.TrimSpace(projectRef) != "" {
+			projectID, err = resolveProjectID(context.Background(), client, projectRef)
+			if err != nil {
+				output.Error(fmt.Sprintf("Failed to find project '%s': %v", projectRef, err), plaintext, jsonOut)
+				os.Exit(exitCodeFor(err))
+			}
+			input["projectId"] = projectID
+		}
+
+		if strings.TrimSpace(milestoneRef) != "" {
+			milestone, err := resolveMilestone(context.Background(), client, projectID, milestoneRef)
+			if err != nil {
+				output.Error(fmt.Sprintf("Failed to resolve milestone '%s': %v", milestoneRef, err), plaintext, jsonOut)
+				os.Exit(exitCodeFor(err))
+			}
+			input["projectMilestoneId"] = milestone.ID
+			if milestone.Project != nil && projectID == "" {
+				input["projectId"] = milestone.Project.ID
+			}
+		}
+
 		// Create issue
 		issue, err := client.CreateIssue(context.Background(), input)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to create issue: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCodeFor(err))
 		}
 
 		if jsonOut {
@@ -1011,6 +1259,252 @@ var issueCreateCmd = &cobra.Command{
 	},
 }
 
+// buildIssueUpdateInput collects the issue fields set on the command line into
+// an IssueUpdateInput. getIssue returns the issue being updated and is only
+// called when a value has to be resolved against its team or project. rename
+// maps update flag names to the names cmd uses for them, for commands where
+// the plain names are taken (issue bulk-update uses --set-state etc.).
+func buildIssueUpdateInput(ctx context.Context, cmd *cobra.Command, client *api.Client, rename map[string]string, getIssue func() (*api.Issue, error)) (map[string]interface{}, error) {
+	input := make(map[string]interface{})
+	flags := cmd.Flags()
+	name := func(flag string) string {
+		if renamed, ok := rename[flag]; ok {
+			return renamed
+		}
+		return flag
+	}
+
+	// Handle title update
+	if flags.Changed(name("title")) {
+		title, _ := flags.GetString(name("title"))
+		input["title"] = title
+	}
+
+	// Handle description update
+	if flags.Changed(name("description")) {
+		description, _ := flags.GetString(name("description"))
+		input["description"] = description
+	}
+
+	// Handle assignee update
+	if flags.Changed(name("assignee")) {
+		assignee, _ := flags.GetString(name("assignee"))
+		switch assignee {
+		case "me":
+			// Get current user
+			viewer, err := client.GetViewer(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get current user: %w", err)
+			}
+			input["assigneeId"] = viewer.ID
+		case "unassigned", "":
+			input["assigneeId"] = nil
+		default:
+			// Look up user by email or name
+			userID, err := resolveUserID(ctx, client, assignee)
+			if err != nil {
+				return nil, fmt.Errorf("failed to resolve assignee: %w", err)
+			}
+
+			input["assigneeId"] = userID
+		}
+	}
+
+	// Handle state update
+	if flags.Changed(name("state")) {
+		stateName, _ := flags.GetString(name("state"))
+
+		// First, get the issue to know which team it belongs to
+		issue, err := getIssue()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get issue: %w", err)
+		}
+
+		// Get available states for the team
+		states, err := client.GetTeamStates(ctx, issue.Team.Key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get team states: %w", err)
+		}
+
+		// Find the state by name (case-insensitive)
+		var stateID string
+		for _, state := range states {
+			if strings.EqualFold(state.Name, stateName) {
+				stateID = state.ID
+				break
+			}
+		}
+
+		if stateID == "" {
+			// Show available states
+			var stateNames []string
+			for _, state := range states {
+				stateNames = append(stateNames, state.Name)
+			}
+			return nil, fmt.Errorf("state '%s' not found. Available states: %s", stateName, strings.Join(stateNames, ", "))
+		}
+
+		input["stateId"] = stateID
+	}
+
+	if flags.Changed(name("labels")) {
+		labelsValue, _ := flags.GetString(name("labels"))
+		trimmed := strings.TrimSpace(labelsValue)
+
+		if trimmed == "" || strings.EqualFold(trimmed, "none") {
+			input["labelIds"] = []string{}
+		} else {
+			issue, err := getIssue()
+			if err != nil {
+				return nil, fmt.Errorf("failed to get issue: %w", err)
+			}
+
+			if issue.Team == nil || issue.Team.Key == "" {
+				return nil, fmt.Errorf("issue has no team, cannot resolve labels")
+			}
+
+			labelIDs, err := resolveIssueLabelIDs(ctx, client, issue.Team.Key, trimmed)
+			if err != nil {
+				return nil, fmt.Errorf("failed to resolve labels: %w", err)
+			}
+
+			input["labelIds"] = labelIDs
+		}
+	}
+
+	// Handle incremental label changes; these keep the issue's other labels
+	if flags.Changed(name("add-label")) || flags.Changed(name("remove-label")) {
+		if flags.Changed(name("labels")) {
+			return nil, fmt.Errorf("use either --%s or --%s/--%s, not both", name("labels"), name("add-label"), name("remove-label"))
+		}
+
+		issue, err := getIssue()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get issue: %w", err)
+		}
+
+		if issue.Team == nil || issue.Team.Key == "" {
+			return nil, fmt.Errorf("issue has no team, cannot resolve labels")
+		}
+
+		for flag, field := range map[string]string{
+			"add-label":    "addedLabelIds",
+			"remove-label": "removedLabelIds",
+		} {
+			values, _ := flags.GetStringSlice(name(flag))
+			if len(values) == 0 {
+				continue
+			}
+			labelIDs, err := resolveIssueLabelIDs(ctx, client, issue.Team.Key, strings.Join(values, ","))
+			if err != nil {
+				return nil, fmt.Errorf("failed to resolve labels: %w", err)
+			}
+			if len(labelIDs) > 0 {
+				input[field] = labelIDs
+			}
+		}
+	}
+
+	// Handle priority update
+	if flags.Changed(name("priority")) {
+		priority, _ := flags.GetInt(name("priority"))
+		input["priority"] = priority
+	}
+
+	// Handle due date update
+	if flags.Changed(name("due-date")) {
+		dueDate, _ := flags.GetString(name("due-date"))
+		if dueDate == "" {
+			input["dueDate"] = nil
+		} else {
+			input["dueDate"] = dueDate
+		}
+	}
+
+	// Handle project update
+	if flags.Changed(name("project")) {
+		projectID, _ := flags.GetString(name("project"))
+		if projectID == "" || strings.EqualFold(projectID, "none") {
+			input["projectId"] = nil
+		} else {
+			// Linear expects a project UUID here (see `linctl project list`)
+			input["projectId"] = projectID
+		}
+	}
+
+	// Handle cycle update
+	if flags.Changed(name("cycle")) {
+		cycleRef, _ := flags.GetString(name("cycle"))
+
+		issue, err := getIssue()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get issue: %w", err)
+		}
+
+		if issue.Team == nil || issue.Team.Key == "" {
+			return nil, fmt.Errorf("issue has no team, cannot resolve cycle")
+		}
+
+		cycleID, err := resolveCycleID(ctx, client, issue.Team.Key, cycleRef)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve cycle '%s': %w", cycleRef, err)
+		}
+		input["cycleId"] = cycleID
+	}
+
+	// Handle milestone update; names are looked up in --project if given,
+	// otherwise in the issue's current project
+	if flags.Changed(name("milestone")) {
+		milestoneRef, _ := flags.GetString(name("milestone"))
+		trimmed := strings.TrimSpace(milestoneRef)
+		if trimmed == "" || strings.EqualFold(trimmed, "none") {
+			input["projectMilestoneId"] = nil
+		} else {
+			projectID, _ := input["projectId"].(string)
+			if projectID == "" && !flags.Changed(name("project")) {
+				issue, err := getIssue()
+				if err != nil {
+					return nil, fmt.Errorf("failed to get issue: %w", err)
+				}
+				if issue.Project != nil {
+					projectID = issue.Project.ID
+				}
+			}
+
+			milestone, err := resolveMilestone(ctx, client, projectID, trimmed)
+			if err != nil {
+				return nil, fmt.Errorf("failed to resolve milestone '%s': %w", milestoneRef, err)
+			}
+			input["projectMilestoneId"] = milestone.ID
+			if milestone.Project != nil && projectID == "" {
+				input["projectId"] = milestone.Project.ID
+			}
+		}
+	}
+
+	// Handle parent update
+	if flags.Changed(name("parent")) {
+		parentRef, _ := flags.GetString(name("parent"))
+		trimmed := strings.TrimSpace(parentRef)
+		if trimmed == "" || strings.EqualFold(trimmed, "none") {
+			input["parentId"] = nil
+		} else {
+			// Resolve parent identifier -> UUID
+			parentIssue, err := client.GetIssue(ctx, trimmed)
+			if err != nil {
+				return nil, fmt.Errorf("failed to resolve parent issue '%s': %w", trimmed, err)
+			}
+			if parentIssue == nil || parentIssue.ID == "" {
+				return nil, &api.Error{Code: api.CodeEntityNotFound, Message: fmt.Sprintf("Parent issue not found: %s", trimmed)}
+			}
+
+			input["parentId"] = parentIssue.ID
+		}
+	}
+
+	return input, nil
+}
+
 var issueUpdateCmd = &cobra.Command{
 	Use:   "update [issue-id]",
 	Short: "Update an issue",
@@ -1019,11 +1513,16 @@ var issueUpdateCmd = &cobra.Command{
 Examples:
   linctl issue update LIN-123 --title "New title"
   linctl issue update LIN-123 --description "Updated description"
+  linctl issue update LIN-123 --editor
+  linctl issue update LIN-123 --description ""   # clear the description
   linctl issue update LIN-123 --assignee john.doe@company.com
 	linctl issue update LIN-123 --labels "Bug,Backend"
+  linctl issue update LIN-123 --add-label Bug --remove-label Triage
   linctl issue update LIN-123 --state "In Progress"
   linctl issue update LIN-123 --priority 1
   linctl issue update LIN-123 --due-date "2024-12-31"
+  linctl issue update LIN-123 --cycle next
+  linctl issue update LIN-123 --milestone Beta
 	linctl issue update CHILD-123 --parent EPIC-999
   linctl issue update LIN-123 --title "New title" --assignee me --priority 2`,
 	Args: cobra.ExactArgs(1),
@@ -1031,13 +1530,12 @@ Examples:
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
 
-		authHeader, err := auth.GetAuthHeader()
+		client, err := auth.NewClient()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitAuth)
 		}
 
-		client := api.NewClient(authHeader)
 		var currentIssue *api.Issue
 		getCurrentIssue := func() (*api.Issue, error) {
 			if currentIssue != nil {
@@ -1053,174 +1551,42 @@ Examples:
 			return currentIssue, nil
 		}
 
-		// Build update input
-		input := make(map[string]interface{})
-
-		// Handle title update
-		if cmd.Flags().Changed("title") {
-			title, _ := cmd.Flags().GetString("title")
-			input["title"] = title
-		}
-
-		// Handle description update
-		if cmd.Flags().Changed("description") {
-			description, _ := cmd.Flags().GetString("description")
-			input["description"] = description
+		input, err := buildIssueUpdateInput(context.Background(), cmd, client, nil, getCurrentIssue)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to update issue %s: %v", args[0], err), plaintext, jsonOut)
+			os.Exit(exitCodeFor(err))
 		}
 
-		// Handle assignee update
-		if cmd.Flags().Changed("assignee") {
-			assignee, _ := cmd.Flags().GetString("assignee")
-			switch assignee {
-			case "me":
-				// Get current user
-				viewer, err := client.GetViewer(context.Background())
-				if err != nil {
-					output.Error(fmt.Sprintf("Failed to get current user: %v", err), plaintext, jsonOut)
-					os.Exit(1)
-				}
-				input["assigneeId"] = viewer.ID
-			case "unassigned", "":
-				input["assigneeId"] = nil
-			default:
-				// Look up user by email
-				users, err := client.GetUsers(context.Background(), 100, "", "")
-				if err != nil {
-					output.Error(fmt.Sprintf("Failed to get users: %v", err), plaintext, jsonOut)
-					os.Exit(1)
-				}
-
-				var foundUser *api.User
-				for _, user := range users.Nodes {
-					if user.Email == assignee || user.Name == assignee {
-						foundUser = &user
-						break
-					}
-				}
-
-				if foundUser == nil {
-					output.Error(fmt.Sprintf("User not found: %s", assignee), plaintext, jsonOut)
-					os.Exit(1)
-				}
-
-				input["assigneeId"] = foundUser.ID
+		// Edit the current description in $EDITOR; like git commit, nothing
+		// is sent if it comes back unchanged or empty. Clearing the
+		// description takes an explicit --description "".
+		if editor, _ := cmd.Flags().GetBool("editor"); editor {
+			if cmd.Flags().Changed("description") {
+				output.Error("Use either --description or --editor, not both", plaintext, jsonOut)
+				os.Exit(1)
 			}
-		}
 
-		// Handle state update
-		if cmd.Flags().Changed("state") {
-			stateName, _ := cmd.Flags().GetString("state")
-
-			// First, get the issue to know which team it belongs to
 			issue, err := getCurrentIssue()
 			if err != nil {
 				output.Error(fmt.Sprintf("Failed to get issue: %v", err), plaintext, jsonOut)
-				os.Exit(1)
+				os.Exit(exitCodeFor(err))
 			}
 
-			// Get available states for the team
-			states, err := client.GetTeamStates(context.Background(), issue.Team.Key)
+			edited, err := editText(issue.Description, "linctl-issue-*.md")
 			if err != nil {
-				output.Error(fmt.Sprintf("Failed to get team states: %v", err), plaintext, jsonOut)
+				output.Error(err.Error(), plaintext, jsonOut)
 				os.Exit(1)
 			}
 
-			// Find the state by name (case-insensitive)
-			var stateID string
-			for _, state := range states {
-				if strings.EqualFold(state.Name, stateName) {
-					stateID = state.ID
-					break
-				}
-			}
-
-			if stateID == "" {
-				// Show available states
-				var stateNames []string
-				for _, state := range states {
-					stateNames = append(stateNames, state.Name)
-				}
-				output.Error(fmt.Sprintf("State '%s' not found. Available states: %s", stateName, strings.Join(stateNames, ", ")), plaintext, jsonOut)
+			switch {
+			case edited == "" && strings.TrimSpace(issue.Description) != "":
+				output.Error("Empty description, nothing updated", plaintext, jsonOut)
 				os.Exit(1)
-			}
-
-			input["stateId"] = stateID
-		}
-
-		if cmd.Flags().Changed("labels") {
-			labelsValue, _ := cmd.Flags().GetString("labels")
-			trimmed := strings.TrimSpace(labelsValue)
-
-			if trimmed == "" || strings.EqualFold(trimmed, "none") {
-				input["labelIds"] = []string{}
-			} else {
-				issue, err := getCurrentIssue()
-				if err != nil {
-					output.Error(fmt.Sprintf("Failed to get issue: %v", err), plaintext, jsonOut)
-					os.Exit(1)
-				}
-
-				if issue.Team == nil || issue.Team.Key == "" {
-					output.Error("Issue has no team, cannot resolve labels", plaintext, jsonOut)
-					os.Exit(1)
-				}
-
-				labelIDs, err := resolveIssueLabelIDs(context.Background(), client, issue.Team.Key, trimmed)
-				if err != nil {
-					output.Error(fmt.Sprintf("Failed to resolve labels: %v", err), plaintext, jsonOut)
-					os.Exit(1)
-				}
-
-				input["labelIds"] = labelIDs
-			}
-		}
-
-		// Handle priority update
-		if cmd.Flags().Changed("priority") {
-			priority, _ := cmd.Flags().GetInt("priority")
-			input["priority"] = priority
-		}
-
-		// Handle due date update
-		if cmd.Flags().Changed("due-date") {
-			dueDate, _ := cmd.Flags().GetString("due-date")
-			if dueDate == "" {
-				input["dueDate"] = nil
-			} else {
-				input["dueDate"] = dueDate
-			}
-		}
-
-		// Handle project update
-		if cmd.Flags().Changed("project") {
-			projectID, _ := cmd.Flags().GetString("project")
-			if projectID == "" || strings.EqualFold(projectID, "none") {
-				input["projectId"] = nil
-			} else {
-				// Linear expects a project UUID here (see `linctl project list`)
-				input["projectId"] = projectID
-			}
-		}
-
-		// Handle parent update
-		if cmd.Flags().Changed("parent") {
-			parentRef, _ := cmd.Flags().GetString("parent")
-			trimmed := strings.TrimSpace(parentRef)
-			if trimmed == "" || strings.EqualFold(trimmed, "none") {
-				input["parentId"] = nil
-			} else {
-				// Resolve parent identifier -> UUID
-				parentIssue, err := client.GetIssue(context.Background(), trimmed)
-				if err != nil {
-					output.Error(fmt.Sprintf("Failed to resolve parent issue '%s': %v", trimmed, err), plaintext, jsonOut)
-					os.Exit(1)
-				}
-				if parentIssue == nil || parentIssue.ID == "" {
-					output.Error(fmt.Sprintf("Parent issue not found: %s", trimmed), plaintext, jsonOut)
-					os.Exit(1)
-				}
-
-				input["parentId"] = parentIssue.ID
+			case edited != strings.TrimSpace(issue.Description):
+				input["description"] = edited
+			case len(input) == 0:
+				output.Info("Description unchanged, nothing to update", plaintext, jsonOut)
+				return
 			}
 		}
 
@@ -1237,19 +1603,19 @@ Examples:
 			childIssue, err := getCurrentIssue()
 			if err != nil {
 				output.Error(fmt.Sprintf("Failed to resolve child issue '%s': %v", args[0], err), plaintext, jsonOut)
-				os.Exit(1)
+				os.Exit(exitCodeFor(err))
 			}
 			if childIssue == nil || childIssue.ID == "" {
 				output.Error(fmt.Sprintf("Child issue not found: %s", args[0]), plaintext, jsonOut)
-				os.Exit(1)
+				os.Exit(exitNotFound)
 			}
 			updateID = childIssue.ID
 		}
 
 		issue, err := client.UpdateIssue(context.Background(), updateID, input)
 		if err != nil {
-			output.Error(fmt.Sprintf("Failed to update issue: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			output.Error(fmt.Sprintf("Failed to update issue %s: %v", args[0], err), plaintext, jsonOut)
+			os.Exit(exitCodeFor(err))
 		}
 
 		if jsonOut {
@@ -1262,6 +1628,113 @@ Examples:
 	},
 }
 
+var issueArchiveCmd = &cobra.Command{
+	Use:   "archive ISSUE-ID...",
+	Short: "Archive one or more issues",
+	Long: `Archive one or more issues. Archived issues can be restored with 'linctl issue unarchive'.
+
+Examples:
+  linctl issue archive LIN-123
+  linctl issue archive LIN-123 LIN-124 LIN-125`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		client, err := auth.NewClient()
+		if err != nil {
+			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		runBatchAction(args, "Archived", func(ctx context.Context, id string) error {
+			return client.ArchiveIssue(ctx, id, false)
+		}, plaintext, jsonOut)
+	},
+}
+
+var issueUnarchiveCmd = &cobra.Command{
+	Use:   "unarchive ISSUE-ID...",
+	Short: "Restore archived or trashed issues",
+	Long: `Restore one or more archived or trashed issues.
+
+Examples:
+  linctl issue unarchive LIN-123
+  linctl issue unarchive LIN-123 LIN-124`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		client, err := auth.NewClient()
+		if err != nil {
+			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		runBatchAction(args, "Restored", func(ctx context.Context, id string) error {
+			return client.UnarchiveIssue(ctx, id)
+		}, plaintext, jsonOut)
+	},
+}
+
+var issueDeleteCmd = &cobra.Command{
+	Use:     "delete ISSUE-ID...",
+	Aliases: []string{"rm"},
+	Short:   "Delete one or more issues",
+	Long: `Delete one or more issues.
+
+By default issues are moved to the trash, where they can be restored with
+'linctl issue unarchive' for 30 days. Use --permanent to delete them for good
+(requires admin permissions).
+
+You are asked to confirm unless --yes is given.
+
+Examples:
+  linctl issue delete LIN-123
+  linctl issue delete LIN-123 LIN-124 --yes
+  linctl issue delete LIN-123 --permanent`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		permanent, _ := cmd.Flags().GetBool("permanent")
+		yes, _ := cmd.Flags().GetBool("yes")
+
+		if !yes {
+			prompt := fmt.Sprintf("Move %d issue(s) to the trash (%s)?", len(args), strings.Join(args, ", "))
+			if permanent {
+				prompt = fmt.Sprintf("Permanently delete %d issue(s) (%s)? This cannot be undone.", len(args), strings.Join(args, ", "))
+			}
+			confirmed, err := confirmAction(prompt)
+			if err != nil {
+				output.Error(err.Error(), plaintext, jsonOut)
+				os.Exit(1)
+			}
+			if !confirmed {
+				output.Info("Aborted", plaintext, jsonOut)
+				return
+			}
+		}
+
+		client, err := auth.NewClient()
+		if err != nil {
+			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		verb := "Trashed"
+		if permanent {
+			verb = "Deleted"
+		}
+
+		runBatchAction(args, verb, func(ctx context.Context, id string) error {
+			return client.DeleteIssue(ctx, id, permanent)
+		}, plaintext, jsonOut)
+	},
+}
+
 func init() {
 	rootCmd.AddCommand(issueCmd)
 	issueCmd.AddCommand(issueListCmd)
@@ -1270,14 +1743,20 @@ func init() {
 	issueCmd.AddCommand(issueAssignCmd)
 	issueCmd.AddCommand(issueCreateCmd)
 	issueCmd.AddCommand(issueUpdateCmd)
+	issueCmd.AddCommand(issueArchiveCmd)
+	issueCmd.AddCommand(issueUnarchiveCmd)
+	issueCmd.AddCommand(issueDeleteCmd)
 
 	// Issue list flags
 	issueListCmd.Flags().StringP("assignee", "a", "", "Filter by assignee (email or 'me')")
 	issueListCmd.Flags().StringP("state", "s", "", "Filter by state name")
-	issueListCmd.Flags().StringP("team", "t", "", "Filter by team key")
+	issueListCmd.Flags().StringP("team", "t", "", "Filter by team key (default: the team setting)")
 	issueListCmd.Flags().String("labels", "", "Filter by comma-separated label names")
 	issueListCmd.Flags().IntP("priority", "r", -1, "Filter by priority (0=None, 1=Urgent, 2=High, 3=Normal, 4=Low)")
-	issueListCmd.Flags().IntP("limit", "l", 50, "Maximum number of issues to fetch")
+	issueListCmd.Flags().String("cycle", "", "Filter by cycle: current, next, previous, a cycle number, or 'none'")
+	issueListCmd.Flags().String("milestone", "", "Filter by project milestone name or ID, or 'none'")
+	issueListCmd.Flags().IntP("limit", "l", 50, "Maximum number of issues to fetch (pages automatically)")
+	issueListCmd.Flags().Bool("all", false, "Fetch every matching issue, ignoring --limit")
 	issueListCmd.Flags().BoolP("include-completed", "c", false, "Include completed and canceled issues")
 	issueListCmd.Flags().StringP("sort", "o", "linear", "Sort order: linear (default), created, updated")
 	issueListCmd.Flags().StringP("newer-than", "n", "", "Show issues created after this time (default: 6_months_ago, use 'all_time' for no filter)")
@@ -1285,28 +1764,34 @@ func init() {
 	// Issue search flags
 	issueSearchCmd.Flags().StringP("assignee", "a", "", "Filter by assignee (email or 'me')")
 	issueSearchCmd.Flags().StringP("state", "s", "", "Filter by state name")
-	issueSearchCmd.Flags().StringP("team", "t", "", "Filter by team key")
+	issueSearchCmd.Flags().StringP("team", "t", "", "Filter by team key (default: the team setting)")
 	issueSearchCmd.Flags().String("labels", "", "Filter by comma-separated label names")
 	issueSearchCmd.Flags().IntP("priority", "r", -1, "Filter by priority (0=None, 1=Urgent, 2=High, 3=Normal, 4=Low)")
-	issueSearchCmd.Flags().IntP("limit", "l", 50, "Maximum number of issues to fetch")
+	issueSearchCmd.Flags().IntP("limit", "l", 50, "Maximum number of issues to fetch (pages automatically)")
+	issueSearchCmd.Flags().Bool("all", false, "Fetch every matching issue, ignoring --limit")
 	issueSearchCmd.Flags().BoolP("include-completed", "c", false, "Include completed and canceled issues")
 	issueSearchCmd.Flags().Bool("include-archived", false, "Include archived issues in results")
 	issueSearchCmd.Flags().StringP("sort", "o", "linear", "Sort order: linear (default), created, updated")
 	issueSearchCmd.Flags().StringP("newer-than", "n", "", "Show issues created after this time (default: 6_months_ago, use 'all_time' for no filter)")
 
 	// Issue create flags
-	issueCreateCmd.Flags().StringP("title", "", "", "Issue title (required)")
+	issueCreateCmd.Flags().StringP("title", "", "", "Issue title (required unless set by --file or --template)")
 	issueCreateCmd.Flags().StringP("description", "d", "", "Issue description")
-	issueCreateCmd.Flags().StringP("team", "t", "", "Team key (required)")
+	issueCreateCmd.Flags().StringP("team", "t", "", "Team key (default: --file, --template, or the team setting)")
 	issueCreateCmd.Flags().Int("priority", 3, "Priority (0=None, 1=Urgent, 2=High, 3=Normal, 4=Low)")
 	issueCreateCmd.Flags().BoolP("assign-me", "m", false, "Assign to yourself")
 	issueCreateCmd.Flags().String("labels", "", "Comma-separated label names or IDs to set on the issue")
-	_ = issueCreateCmd.MarkFlagRequired("title")
-	_ = issueCreateCmd.MarkFlagRequired("team")
+	issueCreateCmd.Flags().String("cycle", "", "Cycle to add the issue to: current, next, previous, or a cycle number")
+	issueCreateCmd.Flags().String("project", "", "Project to add the issue to")
+	issueCreateCmd.Flags().String("milestone", "", "Project milestone name (requires --project) or ID")
+	issueCreateCmd.Flags().StringP("file", "f", "", "Read the issue from a markdown file with YAML front-matter, or - for stdin")
+	issueCreateCmd.Flags().String("template", "", "Issue template name or ID to start from")
+	issueCreateCmd.Flags().BoolP("editor", "e", false, "Write the description in $VISUAL/$EDITOR (default when --description is omitted on a terminal)")
 
 	// Issue update flags
 	issueUpdateCmd.Flags().String("title", "", "New title for the issue")
 	issueUpdateCmd.Flags().StringP("description", "d", "", "New description for the issue")
+	issueUpdateCmd.Flags().BoolP("editor", "e", false, "Edit the current description in $VISUAL/$EDITOR")
 	issueUpdateCmd.Flags().StringP("assignee", "a", "", "Assignee (email, name, 'me', or 'unassigned')")
 	issueUpdateCmd.Flags().StringP("state", "s", "", "State name (e.g., 'Todo', 'In Progress', 'Done')")
 	issueUpdateCmd.Flags().Int("priority", -1, "Priority (0=None, 1=Urgent, 2=High, 3=Normal, 4=Low)")
@@ -1314,4 +1799,12 @@ func init() {
 	issueUpdateCmd.Flags().String("project", "", "Project ID (UUID) to set on the issue; use empty or 'none' to remove")
 	issueUpdateCmd.Flags().String("parent", "", "Parent issue ID/identifier to set on the issue; use empty or 'none' to remove")
 	issueUpdateCmd.Flags().String("labels", "", "Comma-separated label names or IDs; use empty or 'none' to remove all labels")
+	issueUpdateCmd.Flags().StringSlice("add-label", nil, "Label name or ID to add, keeping existing labels (repeatable)")
+	issueUpdateCmd.Flags().StringSlice("remove-label", nil, "Label name or ID to remove, keeping other labels (repeatable)")
+	issueUpdateCmd.Flags().String("cycle", "", "Cycle: current, next, previous, or a cycle number; use empty or 'none' to remove")
+	issueUpdateCmd.Flags().String("milestone", "", "Project milestone name or ID; use empty or 'none' to remove")
+
+	// Issue delete flags
+	issueDeleteCmd.Flags().Bool("permanent", false, "Permanently delete instead of moving to the trash (admin only)")
+	issueDeleteCmd.Flags().BoolP("yes", "y", false, "Skip the confirmation prompt")
 }
@@ -0,0 +1,367 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/yjiky/linctl/pkg/api"
+	"github.com/yjiky/linctl/pkg/auth"
+	"github.com/yjiky/linctl/pkg/output"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// bulkUpdateRenames maps issue update flags to their bulk-update names, where
+// the plain names select issues instead
+var bulkUpdateRenames = map[string]string{
+	"assignee":  "set-assignee",
+	"state":     "set-state",
+	"priority":  "set-priority",
+	"labels":    "set-labels",
+	"cycle":     "set-cycle",
+	"milestone": "set-milestone",
+}
+
+// bulkSelectionFlags are the issue list filters accepted by bulk-update
+var bulkSelectionFlags = []string{"assignee", "state", "team", "labels", "priority", "cycle", "milestone"}
+
+// bulkChangeFlags are the update flags accepted by bulk-update, in display order
+var bulkChangeFlags = []string{"set-state", "set-assignee", "set-priority", "set-labels", "add-label", "remove-label", "due-date", "project", "set-cycle", "set-milestone"}
+
+// bulkUpdateResult is the per-issue outcome of a bulk update
+type bulkUpdateResult struct {
+	Identifier string                 `json:"identifier"`
+	Title      string                 `json:"title,omitempty"`
+	Success    bool                   `json:"success"`
+	Error      string                 `json:"error,omitempty"`
+	Input      map[string]interface{} `json:"input,omitempty"`
+}
+
+// forEachBounded calls fn for 0..n-1 with at most limit calls running at once
+func forEachBounded(n, limit int, fn func(i int)) {
+	if limit < 1 {
+		limit = 1
+	}
+
+	var wg sync.WaitGroup
+	slots := make(chan struct{}, limit)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		slots <- struct{}{}
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-slots }()
+			fn(i)
+		}(i)
+	}
+	wg.Wait()
+}
+
+// describeBulkChanges lists the changes requested on the command line as
+// "field: value" pairs
+func describeBulkChanges(cmd *cobra.Command) map[string]string {
+	changes := make(map[string]string)
+	for _, flag := range bulkChangeFlags {
+		if !cmd.Flags().Changed(flag) {
+			continue
+		}
+		value := cmd.Flags().Lookup(flag).Value.String()
+		if slice, err := cmd.Flags().GetStringSlice(flag); err == nil {
+			value = strings.Join(slice, ", ")
+		}
+		changes[strings.TrimPrefix(flag, "set-")] = value
+	}
+	return changes
+}
+
+// readBulkIdentifiers returns the issue identifiers given as arguments, or
+// read from stdin when the only argument is "-" or stdin is piped
+func readBulkIdentifiers(args []string) ([]string, error) {
+	if len(args) > 0 && !(len(args) == 1 && args[0] == "-") {
+		return args, nil
+	}
+	if len(args) == 0 && isTerminal(os.Stdin) {
+		return nil, nil
+	}
+
+	content, err := readTextFile("-")
+	if err != nil {
+		return nil, err
+	}
+	return strings.Fields(content), nil
+}
+
+var issueBulkUpdateCmd = &cobra.Command{
+	Use:   "bulk-update [ISSUE-ID...]",
+	Short: "Apply the same update to many issues",
+	Long: `Apply the same changes to every issue matching a filter, or to a list of
+issue identifiers given as arguments or on stdin (one per line or space-separated).
+
+Issues are selected with the same flags as 'linctl issue list'. Changes use the
+'linctl issue update' flags; those whose names clash with a filter are prefixed
+with --set- (--set-state, --set-assignee, --set-priority, --set-labels,
+--set-cycle, --set-milestone).
+
+Use --dry-run to preview the change set without updating anything.
+
+Examples:
+  linctl issue bulk-update --team ENG --labels "Flaky" --set-state Done
+  linctl issue bulk-update --team ENG --cycle current --state Todo --set-cycle next --dry-run
+  linctl issue bulk-update LIN-1 LIN-2 --add-label Backend --set-assignee me
+  cat ids.txt | linctl issue bulk-update --set-priority 2`,
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		concurrency, _ := cmd.Flags().GetInt("concurrency")
+
+		changes := describeBulkChanges(cmd)
+		if len(changes) == 0 {
+			output.Error("No updates specified. Use flags to specify what to update.", plaintext, jsonOut)
+			os.Exit(1)
+		}
+
+		selecting := false
+		for _, flag := range bulkSelectionFlags {
+			if cmd.Flags().Changed(flag) {
+				selecting = true
+			}
+		}
+
+		// Only fall back to stdin when no filter selects the issues, so a
+		// filtered run never blocks waiting for input
+		var identifiers []string
+		if len(args) > 0 || !selecting {
+			ids, err := readBulkIdentifiers(args)
+			if err != nil {
+				output.Error(err.Error(), plaintext, jsonOut)
+				os.Exit(1)
+			}
+			identifiers = ids
+		}
+
+		switch {
+		case len(identifiers) > 0 && selecting:
+			output.Error("Use either issue identifiers or filter flags, not both", plaintext, jsonOut)
+			os.Exit(1)
+		case len(identifiers) == 0 && !selecting:
+			output.Error("Select issues with filter flags (e.g. --team, --state, --labels) or pass issue identifiers", plaintext, jsonOut)
+			os.Exit(1)
+		}
+
+		client, err := auth.NewClient()
+		if err != nil {
+			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		ctx := context.Background()
+
+		// Select the issues
+		var issues []api.Issue
+		var lastErr error
+		results := []bulkUpdateResult{}
+		if len(identifiers) > 0 {
+			fetched := make([]*api.Issue, len(identifiers))
+			fetchErrs := make([]error, len(identifiers))
+			forEachBounded(len(identifiers), concurrency, func(i int) {
+				fetched[i], fetchErrs[i] = client.GetIssue(ctx, identifiers[i])
+			})
+			for i, issue := range fetched {
+				if fetchErrs[i] != nil {
+					lastErr = fetchErrs[i]
+					results = append(results, bulkUpdateResult{Identifier: identifiers[i], Error: fetchErrs[i].Error()})
+					continue
+				}
+				issues = append(issues, *issue)
+			}
+		} else {
+			issues, err = api.NewPaginator(client.IssuePages(buildIssueFilter(cmd), "", false), 0).All(ctx)
+			if err != nil {
+				output.Error(fmt.Sprintf("Failed to list issues: %v", err), plaintext, jsonOut)
+				os.Exit(exitCodeFor(err))
+			}
+		}
+
+		if len(issues) == 0 && len(results) == 0 {
+			output.Info("No issues matched", plaintext, jsonOut)
+			return
+		}
+
+		// Resolve the change set once per team and project, since names are
+		// looked up in the issue's team (states, labels, cycles) and project
+		// (milestones)
+		type resolved struct {
+			input map[string]interface{}
+			err   error
+		}
+		cache := make(map[string]resolved)
+		inputs := make([]resolved, len(issues))
+		for i := range issues {
+			issue := &issues[i]
+			key := ""
+			if issue.Team != nil {
+				key = issue.Team.Key
+			}
+			if issue.Project != nil {
+				key += "/" + issue.Project.ID
+			}
+			if _, ok := cache[key]; !ok {
+				input, err := buildIssueUpdateInput(ctx, cmd, client, bulkUpdateRenames, func() (*api.Issue, error) {
+					return issue, nil
+				})
+				cache[key] = resolved{input: input, err: err}
+			}
+			inputs[i] = cache[key]
+		}
+
+		// Apply the updates
+		updates := make([]bulkUpdateResult, len(issues))
+		updateErrs := make([]error, len(issues))
+		forEachBounded(len(issues), concurrency, func(i int) {
+			result := bulkUpdateResult{Identifier: issues[i].Identifier, Title: issues[i].Title, Success: true}
+			switch {
+			case inputs[i].err != nil:
+				result.Success = false
+				result.Error = inputs[i].err.Error()
+				updateErrs[i] = inputs[i].err
+			case dryRun:
+				result.Input = inputs[i].input
+			default:
+				if _, err := client.UpdateIssue(ctx, issues[i].ID, inputs[i].input); err != nil {
+					result.Success = false
+					result.Error = err.Error()
+					updateErrs[i] = err
+				}
+			}
+			updates[i] = result
+		})
+		results = append(results, updates...)
+		for _, err := range updateErrs {
+			if err != nil {
+				lastErr = err
+			}
+		}
+
+		sort.SliceStable(results, func(i, j int) bool {
+			return results[i].Success && !results[j].Success
+		})
+
+		if jsonOut {
+			output.JSON(map[string]interface{}{
+				"dryRun":  dryRun,
+				"changes": changes,
+				"results": results,
+			})
+		} else {
+			printBulkUpdateReport(results, changes, dryRun, plaintext)
+		}
+
+		if lastErr != nil {
+			os.Exit(exitCodeFor(lastErr))
+		}
+	},
+}
+
+// printBulkUpdateReport prints the change set and the per-issue outcome
+func printBulkUpdateReport(results []bulkUpdateResult, changes map[string]string, dryRun bool, plaintext bool) {
+	fields := make([]string, 0, len(changes))
+	for field := range changes {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	verb := "Updated"
+	if dryRun {
+		verb = "Would update"
+	}
+
+	succeeded := 0
+	if plaintext {
+		fmt.Println("Changes:")
+		for _, field := range fields {
+			fmt.Printf("- %s: %s\n", field, changes[field])
+		}
+		fmt.Println()
+		for _, result := range results {
+			if result.Success {
+				succeeded++
+				fmt.Printf("%s %s\t%s\n", verb, result.Identifier, result.Title)
+			} else {
+				fmt.Printf("Failed %s\t%s\n", result.Identifier, result.Error)
+			}
+		}
+		fmt.Printf("\n%s %d issue(s), %d failed\n", verb, succeeded, len(results)-succeeded)
+		return
+	}
+
+	fmt.Printf("\n%s Changes\n", color.New(color.FgCyan, color.Bold).Sprint("✏️"))
+	for _, field := range fields {
+		fmt.Printf("  %s %s\n",
+			color.New(color.FgWhite, color.Faint).Sprintf("%s:", field),
+			color.New(color.FgCyan).Sprint(changes[field]))
+	}
+	fmt.Println()
+
+	for _, result := range results {
+		if result.Success {
+			succeeded++
+			fmt.Printf("%s %s %s %s\n",
+				color.New(color.FgGreen).Sprint("✓"),
+				verb,
+				color.New(color.FgCyan, color.Bold).Sprint(result.Identifier),
+				truncateString(result.Title, 60))
+		} else {
+			fmt.Printf("%s %s %s\n",
+				color.New(color.FgRed).Sprint("✗"),
+				color.New(color.FgCyan, color.Bold).Sprint(result.Identifier),
+				color.New(color.FgRed).Sprint(result.Error))
+		}
+	}
+
+	summary := fmt.Sprintf("%s %d issue(s)", verb, succeeded)
+	if failed := len(results) - succeeded; failed > 0 {
+		summary += color.New(color.FgRed).Sprintf(", %d failed", failed)
+	}
+	fmt.Printf("\n%s\n", summary)
+	if dryRun && succeeded > 0 {
+		fmt.Printf("%s Dry run: nothing was changed. Run again without --dry-run to apply.\n",
+			color.New(color.FgYellow).Sprint("ℹ️"))
+	}
+}
+
+func init() {
+	issueCmd.AddCommand(issueBulkUpdateCmd)
+
+	// Selection flags, shared with issue list
+	issueBulkUpdateCmd.Flags().StringP("assignee", "a", "", "Select issues by assignee (email or 'me')")
+	issueBulkUpdateCmd.Flags().StringP("state", "s", "", "Select issues by state name")
+	issueBulkUpdateCmd.Flags().StringP("team", "t", "", "Select issues by team key (default: the team setting)")
+	issueBulkUpdateCmd.Flags().String("labels", "", "Select issues by comma-separated label names")
+	issueBulkUpdateCmd.Flags().IntP("priority", "r", -1, "Select issues by priority (0=None, 1=Urgent, 2=High, 3=Normal, 4=Low)")
+	issueBulkUpdateCmd.Flags().String("cycle", "", "Select issues by cycle: current, next, previous, a cycle number, or 'none'")
+	issueBulkUpdateCmd.Flags().String("milestone", "", "Select issues by project milestone name or ID, or 'none'")
+	issueBulkUpdateCmd.Flags().BoolP("include-completed", "c", false, "Include completed and canceled issues")
+	issueBulkUpdateCmd.Flags().StringP("newer-than", "n", "", "Select issues created after this time (default: 6_months_ago, use 'all_time' for no filter)")
+
+	// Change flags, shared with issue update
+	issueBulkUpdateCmd.Flags().String("set-state", "", "State name to move issues to")
+	issueBulkUpdateCmd.Flags().String("set-assignee", "", "Assignee (email, name, 'me', or 'unassigned')")
+	issueBulkUpdateCmd.Flags().Int("set-priority", -1, "Priority (0=None, 1=Urgent, 2=High, 3=Normal, 4=Low)")
+	issueBulkUpdateCmd.Flags().String("set-labels", "", "Replace labels with these comma-separated names or IDs; 'none' removes all")
+	issueBulkUpdateCmd.Flags().StringSlice("add-label", nil, "Label name or ID to add (repeatable)")
+	issueBulkUpdateCmd.Flags().StringSlice("remove-label", nil, "Label name or ID to remove (repeatable)")
+	issueBulkUpdateCmd.Flags().String("due-date", "", "Due date (YYYY-MM-DD format, or empty to remove)")
+	issueBulkUpdateCmd.Flags().String("project", "", "Project ID (UUID) to set; use empty or 'none' to remove")
+	issueBulkUpdateCmd.Flags().String("set-cycle", "", "Cycle: current, next, previous, or a cycle number; 'none' removes")
+	issueBulkUpdateCmd.Flags().String("set-milestone", "", "Project milestone name or ID; 'none' removes")
+
+	issueBulkUpdateCmd.Flags().Bool("dry-run", false, "Show the issues and changes without updating anything")
+	issueBulkUpdateCmd.Flags().Int("concurrency", 4, "Number of issues to update at once")
+}
@@ -0,0 +1,125 @@
+package cmd
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// bulkReport is the JSON output of issue bulk-update
+type bulkReport struct {
+	DryRun  bool               `json:"dryRun"`
+	Changes map[string]string  `json:"changes"`
+	Results []bulkUpdateResult `json:"results"`
+}
+
+func TestIssueBulkUpdateByFilter(t *testing.T) {
+	env := newWorkspace(t)
+
+	var report bulkReport
+	env.runJSON(&report, "issue", "bulk-update", "--team", "ENG", "--set-state", "Done", "--add-label", "customer")
+	if len(report.Results) != 2 || report.Changes["state"] != "Done" || report.Changes["add-label"] != "customer" {
+		t.Fatalf("bulk update report = %+v", report)
+	}
+	for _, ref := range []string{"ENG-1", "ENG-2"} {
+		issue := env.issue(ref)
+		if issue.State == nil || issue.State.Name != "Done" {
+			t.Errorf("%s state = %+v, want Done", ref, issue.State)
+		}
+		if names := labelNames(issue); len(names) != 2 || (names[0] != "customer" && names[1] != "customer") {
+			t.Errorf("%s labels = %v, want customer added", ref, names)
+		}
+	}
+	if issue := env.issue("OPS-1"); issue.State != nil && issue.State.Name == "Done" {
+		t.Error("OPS-1 was updated by a filter on ENG")
+	}
+
+	out := env.run("issue", "bulk-update", "--team", "ENG", "--set-priority", "1", "--plaintext")
+	assertContains(t, out, "No issues matched")
+}
+
+func TestIssueBulkUpdateByIdentifiers(t *testing.T) {
+	env := newWorkspace(t)
+
+	out := env.run("issue", "bulk-update", "ENG-1", "OPS-1", "--set-assignee", "me", "--plaintext")
+	assertContains(t, out, "Changes:\n- assignee: me", "Updated ENG-1\tFix login redirect", "Updated OPS-1\tRotate credentials", "Updated 2 issue(s), 0 failed")
+	for _, ref := range []string{"ENG-1", "OPS-1"} {
+		if issue := env.issue(ref); issue.Assignee == nil || issue.Assignee.Email != "alice@example.com" {
+			t.Errorf("%s assignee = %+v, want alice", ref, issue.Assignee)
+		}
+	}
+
+	env.runWithInput("ENG-2\nENG-3\n", "issue", "bulk-update", "--set-priority", "1")
+	for _, ref := range []string{"ENG-2", "ENG-3"} {
+		if issue := env.issue(ref); issue.Priority != 1 {
+			t.Errorf("%s priority = %d, want 1 from stdin identifiers", ref, issue.Priority)
+		}
+	}
+
+	var report bulkReport
+	stdout, _, code := env.runExit("issue", "bulk-update", "ENG-1", "ENG-404", "--set-priority", "4", "--json")
+	if code != exitNotFound {
+		t.Errorf("bulk update with a missing issue exited with %d, want %d", code, exitNotFound)
+	}
+	decodeJSON(t, stdout, &report)
+	if len(report.Results) != 2 || !report.Results[0].Success || report.Results[1].Success || report.Results[1].Identifier != "ENG-404" {
+		t.Errorf("bulk update results = %+v", report.Results)
+	}
+	if issue := env.issue("ENG-1"); issue.Priority != 4 {
+		t.Errorf("ENG-1 priority = %d, want 4", issue.Priority)
+	}
+}
+
+func TestIssueBulkUpdateDryRun(t *testing.T) {
+	env := newWorkspace(t)
+
+	var report bulkReport
+	env.runJSON(&report, "issue", "bulk-update", "--assignee", "bob@example.com", "--set-priority", "1", "--dry-run")
+	if !report.DryRun || len(report.Results) != 2 {
+		t.Fatalf("dry run report = %+v", report)
+	}
+	for _, result := range report.Results {
+		if !reflect.DeepEqual(result.Input, map[string]interface{}{"priority": float64(1)}) {
+			t.Errorf("%s input = %v, want priority 1", result.Identifier, result.Input)
+		}
+	}
+	if issue := env.issue("ENG-2"); issue.Priority != 3 {
+		t.Errorf("dry run changed ENG-2 priority to %d", issue.Priority)
+	}
+	if _, ok := env.fake.LastRequest("UpdateIssue"); ok {
+		t.Error("dry run sent an UpdateIssue mutation")
+	}
+
+	out := env.run("issue", "bulk-update", "ENG-2", "--set-state", "Done", "--dry-run", "--plaintext")
+	assertContains(t, out, "Would update ENG-2\tAdd dark mode", "Would update 1 issue(s), 0 failed")
+}
+
+func TestIssueBulkUpdateErrors(t *testing.T) {
+	env := newWorkspace(t)
+
+	out := env.expectExit(exitError, "issue", "bulk-update", "--team", "ENG")
+	assertContains(t, out, "No updates specified")
+
+	out = env.expectExit(exitError, "issue", "bulk-update", "ENG-1", "--team", "ENG", "--set-priority", "1")
+	assertContains(t, out, "Use either issue identifiers or filter flags, not both")
+
+	var report bulkReport
+	stdout, _, code := env.runExit("issue", "bulk-update", "ENG-1", "OPS-1", "--add-label", "bug", "--json")
+	if code == 0 {
+		t.Error("adding an ENG label to an OPS issue succeeded")
+	}
+	decodeJSON(t, stdout, &report)
+	if len(report.Results) != 2 || !report.Results[0].Success || report.Results[1].Identifier != "OPS-1" || !strings.HasPrefix(report.Results[1].Error, "failed to resolve labels: ") {
+		t.Errorf("bulk update results = %+v", report.Results)
+	}
+}
+
+func TestForEachBounded(t *testing.T) {
+	done := make([]bool, 10)
+	forEachBounded(len(done), 0, func(i int) { done[i] = true })
+	for i, ok := range done {
+		if !ok {
+			t.Errorf("fn was not called for %d", i)
+		}
+	}
+}
@@ -0,0 +1,143 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/yjiky/linctl/pkg/api"
+	"gopkg.in/yaml.v3"
+)
+
+// stringList is a YAML value given either as a list or a comma-separated string
+type stringList []string
+
+func (l *stringList) UnmarshalYAML(value *yaml.Node) error {
+	switch value.Kind {
+	case yaml.SequenceNode:
+		var items []string
+		if err := value.Decode(&items); err != nil {
+			return err
+		}
+		*l = items
+	case yaml.ScalarNode:
+		items := []string{}
+		for _, item := range strings.Split(value.Value, ",") {
+			if trimmed := strings.TrimSpace(item); trimmed != "" {
+				items = append(items, trimmed)
+			}
+		}
+		*l = items
+	default:
+		return fmt.Errorf("line %d: expected a list or a comma-separated string", value.Line)
+	}
+	return nil
+}
+
+// issueFrontMatter is the YAML front-matter accepted by 'issue create --file'
+type issueFrontMatter struct {
+	Title    string     `yaml:"title"`
+	Team     string     `yaml:"team"`
+	Labels   stringList `yaml:"labels"`
+	Priority string     `yaml:"priority"`
+	Assignee string     `yaml:"assignee"`
+	Project  string     `yaml:"project"`
+	Parent   string     `yaml:"parent"`
+	Estimate *float64   `yaml:"estimate"`
+	DueDate  string     `yaml:"due_date"`
+}
+
+// parseIssueMarkdown splits a markdown issue into its front-matter and body.
+// Without a front-matter title, a leading "# Heading" becomes the title.
+func parseIssueMarkdown(content string) (*issueFrontMatter, string, error) {
+	meta := &issueFrontMatter{}
+	body := strings.ReplaceAll(content, "\r\n", "\n")
+
+	if strings.HasPrefix(body, "---\n") {
+		rest := body[len("---\n"):]
+		end := strings.Index(rest, "\n---")
+		if end < 0 {
+			return nil, "", fmt.Errorf("front-matter is not closed with ---")
+		}
+		if err := yaml.Unmarshal([]byte(rest[:end]), meta); err != nil {
+			return nil, "", fmt.Errorf("invalid front-matter: %v", err)
+		}
+		body = rest[end+len("\n---"):]
+		if newline := strings.Index(body, "\n"); newline >= 0 {
+			body = body[newline+1:]
+		} else {
+			body = ""
+		}
+	}
+
+	body = strings.TrimSpace(body)
+	if meta.Title == "" && strings.HasPrefix(body, "# ") {
+		heading, remainder, _ := strings.Cut(body, "\n")
+		meta.Title = strings.TrimSpace(strings.TrimPrefix(heading, "# "))
+		body = strings.TrimSpace(remainder)
+	}
+
+	return meta, body, nil
+}
+
+// parsePriority accepts a priority number (0-4) or name
+func parsePriority(value string) (int, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if number, err := strconv.Atoi(value); err == nil {
+		if number < 0 || number > 4 {
+			return 0, fmt.Errorf("invalid priority: %d (use 0-4)", number)
+		}
+		return number, nil
+	}
+
+	switch value {
+	case "none", "no priority":
+		return 0, nil
+	case "urgent":
+		return 1, nil
+	case "high":
+		return 2, nil
+	case "normal", "medium":
+		return 3, nil
+	case "low":
+		return 4, nil
+	default:
+		return 0, fmt.Errorf("invalid priority: %s. Valid values are: 0-4, none, urgent, high, normal, low", value)
+	}
+}
+
+// resolveIssueTemplate finds an issue template by ID or case-insensitive
+// name, preferring the given team's templates over workspace ones
+func resolveIssueTemplate(ctx context.Context, client *api.Client, ref, teamKey string) (*api.Template, error) {
+	templates, err := client.GetTemplates(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ref = strings.TrimSpace(ref)
+	var match *api.Template
+	for i := range templates {
+		template := &templates[i]
+		if template.Type != "" && template.Type != api.TemplateTypeIssue {
+			continue
+		}
+		if template.ID == ref {
+			return template, nil
+		}
+		if !strings.EqualFold(template.Name, ref) {
+			continue
+		}
+		if template.Team != nil && teamKey != "" && !strings.EqualFold(template.Team.Key, teamKey) {
+			continue
+		}
+		if match == nil || (template.Team != nil && teamKey != "") {
+			match = template
+		}
+	}
+
+	if match == nil {
+		return nil, &api.Error{Code: api.CodeEntityNotFound, Message: fmt.Sprintf("issue template not found: %s", ref)}
+	}
+	return match, nil
+}
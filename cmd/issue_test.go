@@ -0,0 +1,748 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/yjiky/linctl/pkg/api"
+)
+
+// identifiers returns the identifiers of issues, sorted
+func identifiers(issues []api.Issue) []string {
+	ids := make([]string, 0, len(issues))
+	for _, issue := range issues {
+		ids = append(ids, issue.Identifier)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// labelNames returns the names of an issue's labels, sorted
+func labelNames(issue api.Issue) []string {
+	names := []string{}
+	if issue.Labels != nil {
+		for _, label := range issue.Labels.Nodes {
+			names = append(names, label.Name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func TestBuildIssueFilter(t *testing.T) {
+	openStates := map[string]interface{}{
+		"type": map[string]interface{}{"nin": []string{"completed", "canceled"}},
+	}
+
+	tests := []struct {
+		name  string
+		flags map[string]string
+		want  map[string]interface{}
+	}{
+		{
+			name:  "defaults",
+			flags: map[string]string{},
+			want:  map[string]interface{}{"state": openStates},
+		},
+		{
+			name:  "assignee me",
+			flags: map[string]string{"assignee": "me"},
+			want: map[string]interface{}{
+				"state":    openStates,
+				"assignee": map[string]interface{}{"isMe": map[string]interface{}{"eq": true}},
+			},
+		},
+		{
+			name:  "assignee email",
+			flags: map[string]string{"assignee": "bob@example.com"},
+			want: map[string]interface{}{
+				"state":    openStates,
+				"assignee": map[string]interface{}{"email": map[string]interface{}{"eq": "bob@example.com"}},
+			},
+		},
+		{
+			name:  "state replaces the open states",
+			flags: map[string]string{"state": "Done"},
+			want: map[string]interface{}{
+				"state": map[string]interface{}{"name": map[string]interface{}{"eq": "Done"}},
+			},
+		},
+		{
+			name:  "include completed",
+			flags: map[string]string{"include-completed": "true"},
+			want:  map[string]interface{}{},
+		},
+		{
+			name:  "team and priority",
+			flags: map[string]string{"team": "ENG", "priority": "0"},
+			want: map[string]interface{}{
+				"state":    openStates,
+				"team":     map[string]interface{}{"key": map[string]interface{}{"eq": "ENG"}},
+				"priority": map[string]interface{}{"eq": 0},
+			},
+		},
+		{
+			name:  "single label",
+			flags: map[string]string{"labels": " bug ,"},
+			want: map[string]interface{}{
+				"state":  openStates,
+				"labels": map[string]interface{}{"name": map[string]interface{}{"eq": "bug"}},
+			},
+		},
+		{
+			name:  "several labels",
+			flags: map[string]string{"labels": "bug, feature"},
+			want: map[string]interface{}{
+				"state":  openStates,
+				"labels": map[string]interface{}{"name": map[string]interface{}{"in": []string{"bug", "feature"}}},
+			},
+		},
+		{
+			name:  "no cycle",
+			flags: map[string]string{"cycle": "None"},
+			want: map[string]interface{}{
+				"state": openStates,
+				"cycle": map[string]interface{}{"null": true},
+			},
+		},
+		{
+			name:  "current cycle",
+			flags: map[string]string{"cycle": "current"},
+			want: map[string]interface{}{
+				"state": openStates,
+				"cycle": map[string]interface{}{"isActive": map[string]interface{}{"eq": true}},
+			},
+		},
+		{
+			name:  "cycle number",
+			flags: map[string]string{"cycle": "7"},
+			want: map[string]interface{}{
+				"state": openStates,
+				"cycle": map[string]interface{}{"number": map[string]interface{}{"eq": 7}},
+			},
+		},
+		{
+			name:  "milestone name",
+			flags: map[string]string{"milestone": "Beta"},
+			want: map[string]interface{}{
+				"state":            openStates,
+				"projectMilestone": map[string]interface{}{"name": map[string]interface{}{"eqIgnoreCase": "Beta"}},
+			},
+		},
+		{
+			name:  "no milestone",
+			flags: map[string]string{"milestone": "none"},
+			want: map[string]interface{}{
+				"state":            openStates,
+				"projectMilestone": map[string]interface{}{"null": true},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resetCommands(issueListCmd)
+			t.Cleanup(func() { resetCommands(issueListCmd) })
+			for name, value := range tt.flags {
+				if err := issueListCmd.Flags().Set(name, value); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			filter := buildIssueFilter(issueListCmd)
+			if _, ok := filter["createdAt"]; !ok {
+				t.Errorf("filter has no createdAt, want the default newer-than")
+			}
+			delete(filter, "createdAt")
+			if !reflect.DeepEqual(filter, tt.want) {
+				t.Errorf("buildIssueFilter() = %#v, want %#v", filter, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildIssueFilterNewerThan(t *testing.T) {
+	resetCommands(issueListCmd)
+	t.Cleanup(func() { resetCommands(issueListCmd) })
+
+	_ = issueListCmd.Flags().Set("newer-than", "2024-01-01")
+	filter := buildIssueFilter(issueListCmd)
+	want := map[string]interface{}{"gte": "2024-01-01T00:00:00Z"}
+	if !reflect.DeepEqual(filter["createdAt"], want) {
+		t.Errorf("createdAt = %#v, want %#v", filter["createdAt"], want)
+	}
+
+	_ = issueListCmd.Flags().Set("newer-than", "all_time")
+	if filter := buildIssueFilter(issueListCmd); filter["createdAt"] != nil {
+		t.Errorf("createdAt = %#v with all_time, want none", filter["createdAt"])
+	}
+}
+
+func TestBuildIssueFilterInvalidValues(t *testing.T) {
+	env := newWorkspace(t)
+
+	out := env.expectExit(exitError, "issue", "list", "--newer-than", "yesterday")
+	assertContains(t, out, "Invalid newer-than value")
+
+	out = env.expectExit(exitError, "issue", "list", "--cycle", "-2")
+	assertContains(t, out, "Invalid cycle value")
+}
+
+func TestResolveIssueLabelIDs(t *testing.T) {
+	env := newWorkspace(t)
+	client := api.NewClientWithURL(env.fake.URL, "lin_api_test")
+	ctx := context.Background()
+
+	ids := map[string]string{}
+	for _, label := range env.fake.Labels() {
+		ids[label.Name] = label.ID
+	}
+
+	tests := []struct {
+		name   string
+		labels string
+		want   []string
+	}{
+		{"empty", " , ", []string{}},
+		{"names ignore case", "BUG, Feature", []string{ids["bug"], ids["feature"]}},
+		{"ids", ids["feature"], []string{ids["feature"]}},
+		{"duplicates", "bug," + ids["bug"] + ",Bug", []string{ids["bug"]}},
+		{"workspace label", "customer", []string{ids["customer"]}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolveIssueLabelIDs(ctx, client, "ENG", tt.labels)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("resolveIssueLabelIDs(%q) = %v, want %v", tt.labels, got, tt.want)
+			}
+		})
+	}
+
+	_, err := resolveIssueLabelIDs(ctx, client, "ENG", "bug,wontfix")
+	if err == nil {
+		t.Fatal("resolveIssueLabelIDs() succeeded with an unknown label")
+	}
+	assertContains(t, err.Error(), "label(s) not found: wontfix", "Available labels for ENG:", "bug", "customer")
+
+	_, err = resolveIssueLabelIDs(ctx, client, "OPS", "bug")
+	if err == nil {
+		t.Fatal("resolveIssueLabelIDs() resolved another team's label")
+	}
+}
+
+func TestIssueList(t *testing.T) {
+	env := newWorkspace(t)
+
+	tests := []struct {
+		name string
+		args []string
+		want []string
+	}{
+		{"open issues", nil, []string{"ENG-1", "ENG-2", "OPS-1"}},
+		{"include completed", []string{"--include-completed"}, []string{"ENG-1", "ENG-2", "ENG-3", "OPS-1"}},
+		{"state", []string{"--state", "Done"}, []string{"ENG-3"}},
+		{"assignee me", []string{"--assignee", "me"}, []string{"ENG-1"}},
+		{"assignee email", []string{"-a", "bob@example.com"}, []string{"ENG-2", "OPS-1"}},
+		{"team", []string{"--team", "OPS"}, []string{"OPS-1"}},
+		{"labels", []string{"--labels", "bug,feature"}, []string{"ENG-1", "ENG-2"}},
+		{"priority", []string{"--priority", "3"}, []string{"ENG-2"}},
+		{"limit", []string{"--limit", "1"}, []string{"ENG-1"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var issues []api.Issue
+			env.runJSON(&issues, append([]string{"issue", "list"}, tt.args...)...)
+			if got := identifiers(issues); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("issue list %v = %v, want %v", tt.args, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIssueListPlaintext(t *testing.T) {
+	env := newWorkspace(t)
+
+	out := env.run("issue", "list", "--team", "ENG", "--plaintext")
+	assertContains(t, out, "# Issues", "## Fix login redirect", "- **ID**: ENG-1", "- **Assignee**: Alice Smith", "Total: 2 issues")
+
+	out = env.run("issue", "list", "--team", "ENG", "--state", "Canceled", "--plaintext")
+	assertContains(t, out, "No issues found")
+}
+
+func TestIssueListSort(t *testing.T) {
+	env := newWorkspace(t)
+	env.run("issue", "update", "ENG-1", "--title", "Fix login redirect loop")
+
+	var issues []api.Issue
+	env.runJSON(&issues, "issue", "list", "--sort", "updated")
+	if len(issues) == 0 || issues[0].Identifier != "ENG-1" {
+		t.Errorf("issue list --sort updated starts with %v, want ENG-1", identifiers(issues[:1]))
+	}
+	if got := env.lastVariables("Issues")["orderBy"]; got != "updatedAt" {
+		t.Errorf("orderBy = %v, want updatedAt", got)
+	}
+
+	out := env.expectExit(exitError, "issue", "list", "--sort", "priority")
+	assertContains(t, out, "Invalid sort option: priority")
+}
+
+func TestIssueSearch(t *testing.T) {
+	env := newWorkspace(t)
+
+	var issues []api.Issue
+	env.runJSON(&issues, "issue", "search", "dark", "mode")
+	if got := identifiers(issues); !reflect.DeepEqual(got, []string{"ENG-2"}) {
+		t.Errorf("issue search = %v, want [ENG-2]", got)
+	}
+	if got := env.lastVariables("IssueSearch")["term"]; got != "dark mode" {
+		t.Errorf("search term = %v, want \"dark mode\"", got)
+	}
+
+	env.runJSON(&issues, "issue", "search", "update", "--include-completed", "--team", "ENG")
+	if got := identifiers(issues); !reflect.DeepEqual(got, []string{"ENG-3"}) {
+		t.Errorf("issue search --include-completed = %v, want [ENG-3]", got)
+	}
+
+	out := env.run("issue", "search", "nothing-matches", "--plaintext")
+	assertContains(t, out, `No matches found for "nothing-matches"`)
+
+	env.expectExit(exitError, "issue", "search", " ")
+}
+
+func TestIssueGet(t *testing.T) {
+	env := newWorkspace(t)
+	env.fake.AddComment("ENG-1", api.Comment{Body: "Reproduced on staging"})
+	env.fake.AddIssue(api.Issue{Title: "Write regression test", Team: &api.Team{Key: "ENG"}, Parent: &api.Issue{Identifier: "ENG-1"}})
+
+	var issue api.Issue
+	env.runJSON(&issue, "issue", "get", "ENG-1")
+	if issue.Identifier != "ENG-1" || issue.Title != "Fix login redirect" {
+		t.Errorf("issue get = %s %q", issue.Identifier, issue.Title)
+	}
+	if issue.Project == nil || issue.Project.Name != "Website" {
+		t.Errorf("issue project = %+v, want Website", issue.Project)
+	}
+
+	out := env.run("issue", "get", "ENG-1", "--plaintext")
+	assertContains(t, out,
+		"# ENG-1 - Fix login redirect",
+		"- **State**: Todo (unstarted)",
+		"- **Assignee**: Alice Smith (alice@example.com)",
+		"- **Priority**: High (2)",
+		"## Project\n- **Name**: Website",
+		"## Labels\n- bug",
+		"## Sub-issues\n- [ ] ENG-4: Write regression test (Unassigned)",
+		"Reproduced on staging",
+	)
+
+	out = env.run("issue", "show", "ENG-2")
+	assertContains(t, out, "ENG-2", "Add dark mode", "Assignee: ", "Bob Jones")
+}
+
+func TestIssueGetNotFound(t *testing.T) {
+	env := newWorkspace(t)
+
+	stdout, _, code := env.runExit("issue", "get", "ENG-404", "--json")
+	if code != exitNotFound {
+		t.Errorf("exit code = %d, want %d", code, exitNotFound)
+	}
+	var failure map[string]string
+	decodeJSON(t, stdout, &failure)
+	assertContains(t, failure["error"], "Failed to fetch issue", "Entity not found")
+}
+
+func TestIssueAssign(t *testing.T) {
+	env := newWorkspace(t)
+
+	out := env.run("issue", "assign", "ENG-2", "--plaintext")
+	assertContains(t, out, "Assigned ENG-2 to Alice Smith")
+	if issue := env.issue("ENG-2"); issue.Assignee == nil || issue.Assignee.Email != "alice@example.com" {
+		t.Errorf("ENG-2 assignee = %+v, want alice", issue.Assignee)
+	}
+
+	env.expectExit(exitNotFound, "issue", "assign", "ENG-404")
+}
+
+func TestIssueCreate(t *testing.T) {
+	env := newWorkspace(t)
+
+	var issue api.Issue
+	env.runJSON(&issue, "issue", "create",
+		"--title", "Crash on save",
+		"--team", "ENG",
+		"--description", "Stack trace attached",
+		"--priority", "1",
+		"--labels", "Bug,customer",
+		"--project", env.projectID("Website"),
+		"--assign-me",
+	)
+	if issue.Identifier != "ENG-4" {
+		t.Errorf("created %s, want ENG-4", issue.Identifier)
+	}
+
+	created := env.issue(issue.Identifier)
+	if created.Description != "Stack trace attached" || created.Priority != 1 {
+		t.Errorf("created issue = %q priority %d", created.Description, created.Priority)
+	}
+	if got := labelNames(created); !reflect.DeepEqual(got, []string{"bug", "customer"}) {
+		t.Errorf("labels = %v, want [bug customer]", got)
+	}
+	if created.Assignee == nil || created.Assignee.Email != "alice@example.com" {
+		t.Errorf("assignee = %+v, want alice", created.Assignee)
+	}
+	if created.Project == nil || created.Project.Name != "Website" {
+		t.Errorf("project = %+v, want Website", created.Project)
+	}
+
+	out := env.run("issue", "new", "--title", "Default priority", "--team", "OPS", "--plaintext")
+	assertContains(t, out, "Created issue OPS-2: Default priority")
+	if got := env.issue("OPS-2").Priority; got != 3 {
+		t.Errorf("default priority = %d, want 3", got)
+	}
+}
+
+func TestIssueCreateUsesTeamSetting(t *testing.T) {
+	env := newWorkspace(t)
+	env.run("config", "set", "team", "OPS")
+
+	var issue api.Issue
+	env.runJSON(&issue, "issue", "create", "--title", "Renew certificates")
+	if issue.Identifier != "OPS-2" {
+		t.Errorf("created %s, want OPS-2", issue.Identifier)
+	}
+}
+
+func TestIssueCreateFromFile(t *testing.T) {
+	env := newWorkspace(t)
+
+	path := filepath.Join(t.TempDir(), "bug.md")
+	content := `---
+team: ENG
+labels: [feature]
+priority: urgent
+assignee: bob@example.com
+parent: ENG-2
+estimate: 3
+due_date: 2030-01-31
+---
+# Dark mode for settings
+
+The settings page ignores the theme.
+`
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	var issue api.Issue
+	env.runJSON(&issue, "issue", "create", "--file", path, "--priority", "4")
+	created := env.issue(issue.Identifier)
+	if created.Title != "Dark mode for settings" || created.Description != "The settings page ignores the theme." {
+		t.Errorf("created %q: %q", created.Title, created.Description)
+	}
+	if created.Priority != 4 {
+		t.Errorf("priority = %d, want the --priority flag's 4", created.Priority)
+	}
+	if created.Assignee == nil || created.Assignee.Email != "bob@example.com" {
+		t.Errorf("assignee = %+v, want bob", created.Assignee)
+	}
+	if created.Parent == nil || created.Parent.Identifier != "ENG-2" {
+		t.Errorf("parent = %+v, want ENG-2", created.Parent)
+	}
+	if created.Estimate == nil || *created.Estimate != 3 {
+		t.Errorf("estimate = %v, want 3", created.Estimate)
+	}
+	if created.DueDate == nil || *created.DueDate != "2030-01-31" {
+		t.Errorf("due date = %v, want 2030-01-31", created.DueDate)
+	}
+	if got := labelNames(created); !reflect.DeepEqual(got, []string{"feature"}) {
+		t.Errorf("labels = %v, want [feature]", got)
+	}
+
+	out := env.runWithInput("# From stdin\n\nPiped body\n", "issue", "create", "--file", "-", "--team", "OPS", "--plaintext")
+	assertContains(t, out, "Created issue OPS-2: From stdin")
+	if got := env.issue("OPS-2").Description; got != "Piped body" {
+		t.Errorf("description = %q, want the piped body", got)
+	}
+}
+
+func TestIssueCreateFromTemplate(t *testing.T) {
+	env := newWorkspace(t)
+	env.fake.AddTemplate(api.Template{
+		Name:         "Bug report",
+		Team:         &api.Team{Key: "OPS"},
+		TemplateData: json.RawMessage(`{"title": "Bug: ", "description": "## Steps", "priority": 2}`),
+	})
+
+	var issue api.Issue
+	env.runJSON(&issue, "issue", "create", "--template", "bug report")
+	created := env.issue(issue.Identifier)
+	if created.Identifier != "OPS-2" || created.Title != "Bug: " || created.Description != "## Steps" {
+		t.Errorf("created %s %q: %q", created.Identifier, created.Title, created.Description)
+	}
+	if created.Priority != 2 {
+		t.Errorf("priority = %d, want the template's 2", created.Priority)
+	}
+
+	out := env.expectExit(exitNotFound, "issue", "create", "--template", "Feature request", "--team", "ENG")
+	assertContains(t, out, "Failed to find template 'Feature request'")
+
+	// The team setting picks between templates of the same name
+	env.fake.AddTemplate(api.Template{
+		Name:         "Bug report",
+		Team:         &api.Team{Key: "ENG"},
+		TemplateData: json.RawMessage(`{"title": "ENG bug: "}`),
+	})
+	env.run("config", "set", "team", "ENG")
+	env.runJSON(&issue, "issue", "create", "--template", "Bug report")
+	if created := env.issue(issue.Identifier); created.Team.Key != "ENG" || created.Title != "ENG bug: " {
+		t.Errorf("created %s %q, want ENG's template", created.Identifier, created.Title)
+	}
+}
+
+func TestIssueCreateErrors(t *testing.T) {
+	env := newWorkspace(t)
+
+	tests := []struct {
+		name string
+		code int
+		args []string
+		want string
+	}{
+		{"missing title", exitError, []string{"--team", "ENG"}, "Title is required"},
+		{"missing team", exitError, []string{"--title", "Orphan"}, "Team is required"},
+		{"unknown team", exitNotFound, []string{"--title", "Lost", "--team", "NOPE"}, "Failed to find team 'NOPE'"},
+		{"unknown label", exitError, []string{"--title", "Tagged", "--team", "ENG", "--labels", "wontfix"}, "label(s) not found: wontfix"},
+		{"unknown project", exitNotFound, []string{"--title", "Planned", "--team", "ENG", "--project", "nope"}, "Failed to find project 'nope'"},
+		{"unknown cycle", exitNotFound, []string{"--title", "Scheduled", "--team", "ENG", "--cycle", "current"}, "Failed to resolve cycle 'current'"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := env.expectExit(tt.code, append([]string{"issue", "create"}, tt.args...)...)
+			assertContains(t, out, tt.want)
+		})
+	}
+
+	if got := len(env.fake.Issues()); got != 4 {
+		t.Errorf("%d issues after failed creates, want 4", got)
+	}
+}
+
+func TestIssueUpdate(t *testing.T) {
+	env := newWorkspace(t)
+
+	out := env.run("issue", "update", "ENG-1",
+		"--title", "Fix login redirect loop",
+		"--description", "Loops after SSO",
+		"--state", "in progress",
+		"--assignee", "Bob Jones",
+		"--priority", "1",
+		"--due-date", "2030-06-01",
+		"--parent", "ENG-2",
+		"--plaintext",
+	)
+	assertContains(t, out, "Updated issue ENG-1")
+
+	issue := env.issue("ENG-1")
+	if issue.Title != "Fix login redirect loop" || issue.Description != "Loops after SSO" {
+		t.Errorf("updated %q: %q", issue.Title, issue.Description)
+	}
+	if issue.State == nil || issue.State.Name != "In Progress" {
+		t.Errorf("state = %+v, want In Progress", issue.State)
+	}
+	if issue.Assignee == nil || issue.Assignee.Email != "bob@example.com" {
+		t.Errorf("assignee = %+v, want bob", issue.Assignee)
+	}
+	if issue.Priority != 1 || issue.DueDate == nil || *issue.DueDate != "2030-06-01" {
+		t.Errorf("priority %d, due date %v", issue.Priority, issue.DueDate)
+	}
+	if issue.Parent == nil || issue.Parent.Identifier != "ENG-2" {
+		t.Errorf("parent = %+v, want ENG-2", issue.Parent)
+	}
+
+	env.run("issue", "update", "ENG-1", "--assignee", "unassigned", "--due-date", "", "--parent", "none", "--project", "none")
+	issue = env.issue("ENG-1")
+	if issue.Assignee != nil || issue.DueDate != nil || issue.Parent != nil || issue.Project != nil {
+		t.Errorf("cleared fields = assignee %+v, due %v, parent %+v, project %+v", issue.Assignee, issue.DueDate, issue.Parent, issue.Project)
+	}
+
+	env.run("issue", "update", "ENG-1", "--project", env.projectID("Website"))
+	if issue := env.issue("ENG-1"); issue.Project == nil || issue.Project.Name != "Website" {
+		t.Errorf("project = %+v, want Website", issue.Project)
+	}
+}
+
+func TestIssueUpdateLabels(t *testing.T) {
+	env := newWorkspace(t)
+
+	env.run("issue", "update", "ENG-1", "--labels", "feature,customer")
+	if got := labelNames(env.issue("ENG-1")); !reflect.DeepEqual(got, []string{"customer", "feature"}) {
+		t.Errorf("labels = %v, want [customer feature]", got)
+	}
+
+	env.run("issue", "update", "ENG-1", "--add-label", "bug", "--remove-label", "customer")
+	if got := labelNames(env.issue("ENG-1")); !reflect.DeepEqual(got, []string{"bug", "feature"}) {
+		t.Errorf("labels = %v, want [bug feature]", got)
+	}
+	input := env.lastVariables("UpdateIssue")["input"].(map[string]interface{})
+	if _, ok := input["labelIds"]; ok {
+		t.Errorf("--add-label sent labelIds, replacing the other labels: %v", input)
+	}
+
+	env.run("issue", "update", "ENG-1", "--labels", "none")
+	if got := labelNames(env.issue("ENG-1")); len(got) != 0 {
+		t.Errorf("labels = %v, want none", got)
+	}
+
+	out := env.expectExit(exitError, "issue", "update", "ENG-1", "--labels", "bug", "--add-label", "feature")
+	assertContains(t, out, "Failed to update issue ENG-1: use either --labels or --add-label/--remove-label, not both")
+}
+
+func TestIssueUpdateCycleAndMilestone(t *testing.T) {
+	env := newWorkspace(t)
+	env.fake.AddCycle("ENG", api.Cycle{Name: "Sprint 1", IsActive: true})
+	env.fake.AddCycle("ENG", api.Cycle{Name: "Sprint 2", IsNext: true})
+	env.fake.AddMilestone("Website", api.Milestone{Name: "Beta"})
+
+	env.run("issue", "update", "ENG-1", "--cycle", "next", "--milestone", "beta")
+	issue := env.issue("ENG-1")
+	if issue.Cycle == nil || issue.Cycle.Name != "Sprint 2" {
+		t.Errorf("cycle = %+v, want Sprint 2", issue.Cycle)
+	}
+	if issue.ProjectMilestone == nil || issue.ProjectMilestone.Name != "Beta" {
+		t.Errorf("milestone = %+v, want Beta", issue.ProjectMilestone)
+	}
+
+	env.run("issue", "update", "ENG-1", "--cycle", "none", "--milestone", "none")
+	issue = env.issue("ENG-1")
+	if issue.Cycle != nil || issue.ProjectMilestone != nil {
+		t.Errorf("cycle %+v, milestone %+v, want both cleared", issue.Cycle, issue.ProjectMilestone)
+	}
+
+	out := env.expectExit(exitNotFound, "issue", "update", "ENG-2", "--milestone", "Beta")
+	assertContains(t, out, "Failed to update issue ENG-2: failed to resolve milestone 'Beta'")
+}
+
+func TestIssueUpdateErrors(t *testing.T) {
+	env := newWorkspace(t)
+
+	tests := []struct {
+		name string
+		code int
+		args []string
+		want string
+	}{
+		{"nothing to update", exitError, []string{"ENG-1"}, "No updates specified"},
+		{"unknown state", exitError, []string{"ENG-1", "--state", "Blocked"}, "Failed to update issue ENG-1: state 'Blocked' not found. Available states: Backlog, Todo, In Progress, Done, Canceled"},
+		{"unknown assignee", exitNotFound, []string{"ENG-1", "--assignee", "carol@example.com"}, "user not found: carol@example.com"},
+		{"unknown issue", exitNotFound, []string{"ENG-404", "--title", "Gone"}, "Failed to update issue ENG-404"},
+		{"unknown parent", exitNotFound, []string{"ENG-1", "--parent", "ENG-404"}, "Failed to update issue ENG-1: failed to resolve parent issue 'ENG-404'"},
+		{"description and editor", exitError, []string{"ENG-1", "--description", "x", "--editor"}, "Use either --description or --editor, not both"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := env.expectExit(tt.code, append([]string{"issue", "update"}, tt.args...)...)
+			assertContains(t, out, tt.want)
+		})
+	}
+
+	if issue := env.issue("ENG-1"); issue.Title != "Fix login redirect" || issue.Description != "" {
+		t.Errorf("ENG-1 changed by failed updates: %q: %q", issue.Title, issue.Description)
+	}
+}
+
+func TestIssueUpdateEditor(t *testing.T) {
+	env := newWorkspace(t)
+	env.run("issue", "update", "ENG-1", "--description", "Old text")
+
+	t.Setenv("VISUAL", "")
+	t.Setenv("EDITOR", writeScript(t, "editor", `printf 'New text\n' > "$1"`))
+	env.run("issue", "update", "ENG-1", "--editor")
+	if got := env.issue("ENG-1").Description; got != "New text" {
+		t.Errorf("description = %q, want the edited text", got)
+	}
+
+	t.Setenv("EDITOR", "true")
+	out := env.run("issue", "update", "ENG-1", "--editor", "--plaintext")
+	assertContains(t, out, "Description unchanged, nothing to update")
+
+	// An emptied buffer aborts, even alongside other changes
+	t.Setenv("EDITOR", writeScript(t, "editor", `printf '\n' > "$1"`))
+	out = env.expectExit(exitError, "issue", "update", "ENG-1", "--editor", "--title", "Renamed")
+	assertContains(t, out, "Empty description, nothing updated")
+	if issue := env.issue("ENG-1"); issue.Description != "New text" || issue.Title != "Fix login redirect" {
+		t.Errorf("ENG-1 changed by an empty editor buffer: %q: %q", issue.Title, issue.Description)
+	}
+
+	env.run("issue", "update", "ENG-1", "--description", "")
+	if got := env.issue("ENG-1").Description; got != "" {
+		t.Errorf("description = %q, want it cleared by --description \"\"", got)
+	}
+}
+
+func TestIssueArchiveAndUnarchive(t *testing.T) {
+	env := newWorkspace(t)
+
+	var results []batchResult
+	env.runJSON(&results, "issue", "archive", "ENG-1", "ENG-2")
+	want := []batchResult{{Identifier: "ENG-1", Success: true}, {Identifier: "ENG-2", Success: true}}
+	if !reflect.DeepEqual(results, want) {
+		t.Errorf("issue archive = %+v, want %+v", results, want)
+	}
+	if env.issue("ENG-1").ArchivedAt == nil {
+		t.Error("ENG-1 was not archived")
+	}
+
+	out := env.run("issue", "list", "--team", "ENG", "--plaintext")
+	assertContains(t, out, "No issues found")
+
+	out = env.run("issue", "unarchive", "ENG-1", "--plaintext")
+	assertContains(t, out, "Restored ENG-1")
+	if env.issue("ENG-1").ArchivedAt != nil {
+		t.Error("ENG-1 is still archived")
+	}
+
+	stdout, _, code := env.runExit("issue", "archive", "ENG-2", "ENG-404", "--json")
+	if code != exitNotFound {
+		t.Errorf("exit code = %d, want %d", code, exitNotFound)
+	}
+	decodeJSON(t, stdout, &results)
+	if len(results) != 2 || !results[0].Success || results[1].Success || !strings.Contains(results[1].Error, "not found") {
+		t.Errorf("issue archive with a missing issue = %+v", results)
+	}
+	assertContains(t, stdout, `"identifier": "ENG-2"`)
+}
+
+func TestIssueDelete(t *testing.T) {
+	env := newWorkspace(t)
+
+	out := env.expectExit(exitError, "issue", "delete", "ENG-1")
+	assertContains(t, out, "pass --yes")
+	if env.issue("ENG-1").ArchivedAt != nil {
+		t.Error("ENG-1 was deleted without confirmation")
+	}
+
+	out = env.run("issue", "rm", "ENG-1", "--yes", "--plaintext")
+	assertContains(t, out, "Trashed ENG-1")
+	if env.issue("ENG-1").ArchivedAt == nil {
+		t.Error("ENG-1 was not moved to the trash")
+	}
+	env.run("issue", "unarchive", "ENG-1")
+
+	out = env.run("issue", "delete", "ENG-1", "--permanent", "--yes", "--plaintext")
+	assertContains(t, out, "Deleted ENG-1")
+	if _, ok := env.fake.Issue("ENG-1"); ok {
+		t.Error("ENG-1 still exists after a permanent delete")
+	}
+	if got := env.lastVariables("DeleteIssue")["permanentlyDelete"]; got != true {
+		t.Errorf("permanentlyDelete = %v, want true", got)
+	}
+}
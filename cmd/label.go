@@ -0,0 +1,639 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/yjiky/linctl/pkg/api"
+	"github.com/yjiky/linctl/pkg/auth"
+	"github.com/yjiky/linctl/pkg/output"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// labelDisplayName returns "Group/Name" for labels inside a group
+func labelDisplayName(label api.Label) string {
+	if label.Parent != nil && label.Parent.Name != "" {
+		return label.Parent.Name + "/" + label.Name
+	}
+	return label.Name
+}
+
+// labelScope returns the team key a label belongs to, or "workspace"
+func labelScope(label api.Label) string {
+	if label.Team != nil && label.Team.Key != "" {
+		return label.Team.Key
+	}
+	return "workspace"
+}
+
+// resolveLabel finds a label by ID, name, or "Group/Name". With a team key,
+// only that team's labels and workspace labels are considered.
+func resolveLabel(ctx context.Context, client *api.Client, ref, teamKey string) (*api.Label, error) {
+	labels, err := api.NewPaginator(client.LabelPages(nil), 0).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ref = strings.TrimSpace(ref)
+	matches := []api.Label{}
+	for _, label := range labels {
+		if label.ID == ref {
+			return &label, nil
+		}
+		if teamKey != "" && label.Team != nil && !strings.EqualFold(label.Team.Key, teamKey) {
+			continue
+		}
+		if strings.EqualFold(label.Name, ref) || strings.EqualFold(labelDisplayName(label), ref) {
+			matches = append(matches, label)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, &api.Error{Code: api.CodeEntityNotFound, Message: fmt.Sprintf("label not found: %s", ref)}
+	case 1:
+		return &matches[0], nil
+	}
+
+	scopes := make([]string, 0, len(matches))
+	for _, label := range matches {
+		scopes = append(scopes, fmt.Sprintf("%s (%s)", labelDisplayName(label), labelScope(label)))
+	}
+	return nil, fmt.Errorf("label '%s' is ambiguous: %s. Use --team or the label ID", ref, strings.Join(scopes, ", "))
+}
+
+// labelTree groups labels under their parent group, sorted by name
+func labelTree(labels []api.Label) ([]api.Label, map[string][]api.Label) {
+	present := make(map[string]bool, len(labels))
+	for _, label := range labels {
+		present[label.ID] = true
+	}
+
+	roots := []api.Label{}
+	children := make(map[string][]api.Label)
+	for _, label := range labels {
+		if label.Parent != nil && present[label.Parent.ID] {
+			children[label.Parent.ID] = append(children[label.Parent.ID], label)
+		} else {
+			roots = append(roots, label)
+		}
+	}
+
+	byName := func(list []api.Label) {
+		sort.Slice(list, func(i, j int) bool {
+			return strings.ToLower(list[i].Name) < strings.ToLower(list[j].Name)
+		})
+	}
+	byName(roots)
+	for id := range children {
+		byName(children[id])
+	}
+
+	return roots, children
+}
+
+// printLabelTree prints label groups with their labels nested underneath
+func printLabelTree(labels []api.Label, plaintext bool) {
+	roots, children := labelTree(labels)
+
+	for _, root := range roots {
+		if plaintext {
+			fmt.Printf("- %s (%s)\n", root.Name, labelScope(root))
+			for _, child := range children[root.ID] {
+				fmt.Printf("  - %s\n", child.Name)
+			}
+			continue
+		}
+
+		name := color.New(color.FgCyan).Sprint(root.Name)
+		if root.IsGroup {
+			name = color.New(color.FgCyan, color.Bold).Sprint(root.Name)
+		}
+		fmt.Printf("%s %s\n",
+			name,
+			color.New(color.FgWhite, color.Faint).Sprintf("(%s)", labelScope(root)))
+
+		group := children[root.ID]
+		for i, child := range group {
+			branch := "├── "
+			if i == len(group)-1 {
+				branch = "└── "
+			}
+			fmt.Printf("%s%s\n", branch, color.New(color.FgCyan).Sprint(child.Name))
+		}
+	}
+}
+
+// buildLabelInput collects the label fields set on the command line. On
+// update, 'none' removes the label from its group.
+func buildLabelInput(ctx context.Context, cmd *cobra.Command, client *api.Client, teamKey string) (map[string]interface{}, error) {
+	input := make(map[string]interface{})
+	flags := cmd.Flags()
+
+	if flags.Changed("name") {
+		name, _ := flags.GetString("name")
+		input["name"] = name
+	}
+
+	if flags.Changed("description") {
+		description, _ := flags.GetString("description")
+		input["description"] = description
+	}
+
+	if flags.Changed("color") {
+		value, _ := flags.GetString("color")
+		value = strings.TrimSpace(value)
+		if !strings.HasPrefix(value, "#") {
+			value = "#" + value
+		}
+		input["color"] = value
+	}
+
+	if flags.Changed("parent") {
+		parentRef, _ := flags.GetString("parent")
+		trimmed := strings.TrimSpace(parentRef)
+		if trimmed == "" || strings.EqualFold(trimmed, "none") {
+			input["parentId"] = nil
+		} else {
+			parent, err := resolveLabel(ctx, client, trimmed, teamKey)
+			if err != nil {
+				return nil, fmt.Errorf("failed to resolve parent group: %w", err)
+			}
+			if !parent.IsGroup {
+				return nil, fmt.Errorf("'%s' is not a label group", labelDisplayName(*parent))
+			}
+			input["parentId"] = parent.ID
+		}
+	}
+
+	return input, nil
+}
+
+// labelCmd represents the label command
+var labelCmd = &cobra.Command{
+	Use:     "label",
+	Aliases: []string{"labels"},
+	Short:   "Manage issue labels",
+	Long: `Manage issue labels and label groups.
+
+Labels can be referenced by ID, name, or "Group/Name" for labels inside a
+group. Use --team when the same name exists in several teams.
+
+Examples:
+  linctl label list --team ENG --tree
+  linctl label create "Regression" --team ENG --parent Type --color "#EB5757"
+  linctl label merge "bug" "Bug" --team ENG`,
+}
+
+var labelListCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "List labels",
+	Long: `List issue labels. By default both workspace and team labels are shown;
+with the team setting, only that team's labels and workspace labels are.
+Pass --team "" to list every team's labels.
+
+Examples:
+  linctl label list
+  linctl label list --team ENG --tree
+  linctl label list --workspace`,
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		teamKey, _ := cmd.Flags().GetString("team")
+		workspace, _ := cmd.Flags().GetBool("workspace")
+		tree, _ := cmd.Flags().GetBool("tree")
+
+		if teamKey != "" && workspace {
+			output.Error("Use either --team or --workspace, not both", plaintext, jsonOut)
+			os.Exit(1)
+		}
+
+		client, err := auth.NewClient()
+		if err != nil {
+			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		filter := map[string]interface{}{}
+		switch defaultTeam := teamKeyOrDefault(cmd); {
+		case teamKey != "":
+			filter["team"] = map[string]interface{}{
+				"key": map[string]interface{}{"eq": teamKey},
+			}
+		case workspace:
+			filter["team"] = map[string]interface{}{"null": true}
+		case defaultTeam != "":
+			// The default team's labels, plus the workspace labels its
+			// issues can use too
+			filter["or"] = []interface{}{
+				map[string]interface{}{"team": map[string]interface{}{"key": map[string]interface{}{"eq": defaultTeam}}},
+				map[string]interface{}{"team": map[string]interface{}{"null": true}},
+			}
+		}
+
+		labels, err := api.NewPaginator(client.LabelPages(filter), 0).All(context.Background())
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to list labels: %v", err), plaintext, jsonOut)
+			os.Exit(exitCodeFor(err))
+		}
+
+		if jsonOut {
+			output.JSON(labels)
+			return
+		}
+
+		if len(labels) == 0 {
+			output.Info("No labels found", plaintext, jsonOut)
+			return
+		}
+
+		if tree {
+			printLabelTree(labels, plaintext)
+			return
+		}
+
+		sort.Slice(labels, func(i, j int) bool {
+			return strings.ToLower(labelDisplayName(labels[i])) < strings.ToLower(labelDisplayName(labels[j]))
+		})
+
+		if plaintext {
+			fmt.Println("Name\tGroup\tTeam\tColor\tID")
+			for _, label := range labels {
+				group := ""
+				if label.Parent != nil {
+					group = label.Parent.Name
+				}
+				fmt.Printf("%s\t%s\t%s\t%s\t%s\n", label.Name, group, labelScope(label), label.Color, label.ID)
+			}
+			return
+		}
+
+		headers := []string{"Label", "Team", "Color", "ID"}
+		rows := [][]string{}
+		for _, label := range labels {
+			name := labelDisplayName(label)
+			if label.IsGroup {
+				name += " " + color.New(color.FgWhite, color.Faint).Sprint("(group)")
+			}
+			rows = append(rows, []string{
+				color.New(color.FgCyan).Sprint(name),
+				labelScope(label),
+				label.Color,
+				color.New(color.FgWhite, color.Faint).Sprint(label.ID),
+			})
+		}
+
+		output.Table(output.TableData{
+			Headers: headers,
+			Rows:    rows,
+		}, false, false)
+
+		fmt.Printf("\n%s %d labels\n",
+			color.New(color.FgGreen).Sprint("✓"),
+			len(labels))
+	},
+}
+
+var labelCreateCmd = &cobra.Command{
+	Use:     "create NAME",
+	Aliases: []string{"new"},
+	Short:   "Create a label or label group",
+	Long: `Create an issue label. Without --team the label is created for the team
+setting's team, or for the whole workspace when that is not set; pass
+--team "" for a workspace label. Use --group to create a label group, and --parent to put a new
+label inside an existing group.
+
+Examples:
+  linctl label create "Regression" --team ENG --parent Type --color "#EB5757"
+  linctl label create "Area" --group
+  linctl label create "Security" --description "Security-sensitive work"`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		teamKey := teamKeyOrDefault(cmd)
+		isGroup, _ := cmd.Flags().GetBool("group")
+
+		client, err := auth.NewClient()
+		if err != nil {
+			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		ctx := context.Background()
+
+		input, err := buildLabelInput(ctx, cmd, client, teamKey)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitCodeFor(err))
+		}
+		input["name"] = args[0]
+		if isGroup {
+			input["isGroup"] = true
+		}
+
+		if teamKey != "" {
+			team, err := client.GetTeam(ctx, teamKey)
+			if err != nil {
+				output.Error(fmt.Sprintf("Failed to find team '%s': %v", teamKey, err), plaintext, jsonOut)
+				os.Exit(exitCodeFor(err))
+			}
+			input["teamId"] = team.ID
+		}
+
+		label, err := client.CreateLabel(ctx, input)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to create label: %v", err), plaintext, jsonOut)
+			os.Exit(exitCodeFor(err))
+		}
+
+		printLabelResult("Created", label, plaintext, jsonOut)
+	},
+}
+
+var labelUpdateCmd = &cobra.Command{
+	Use:     "update LABEL",
+	Aliases: []string{"edit"},
+	Short:   "Update a label",
+	Long: `Update a label's name, color, description, or group.
+
+Examples:
+  linctl label update "bug" --team ENG --name "Bug" --color "#EB5757"
+  linctl label update "Type/Regression" --parent none`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		teamKey := teamKeyOrDefault(cmd)
+
+		client, err := auth.NewClient()
+		if err != nil {
+			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		ctx := context.Background()
+
+		input, err := buildLabelInput(ctx, cmd, client, teamKey)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitCodeFor(err))
+		}
+		if len(input) == 0 {
+			output.Error("No updates specified. Use flags to specify what to update.", plaintext, jsonOut)
+			os.Exit(1)
+		}
+
+		existing, err := resolveLabel(ctx, client, args[0], teamKey)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to find label: %v", err), plaintext, jsonOut)
+			os.Exit(exitCodeFor(err))
+		}
+
+		label, err := client.UpdateLabel(ctx, existing.ID, input)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to update label: %v", err), plaintext, jsonOut)
+			os.Exit(exitCodeFor(err))
+		}
+
+		printLabelResult("Updated", label, plaintext, jsonOut)
+	},
+}
+
+var labelArchiveCmd = &cobra.Command{
+	Use:     "archive LABEL...",
+	Aliases: []string{"delete", "rm"},
+	Short:   "Archive (delete) one or more labels",
+	Long: `Archive labels. Linear's API has no archive for labels, so this deletes
+them: Linear removes a deleted label from every issue carrying it. Use
+'linctl label merge' to move those issues to another label first.
+
+Examples:
+  linctl label archive "old-label"
+  linctl label archive "wontfix" "invalid" --team ENG`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		teamKey := teamKeyOrDefault(cmd)
+
+		client, err := auth.NewClient()
+		if err != nil {
+			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		runBatchAction(args, "Deleted", func(ctx context.Context, ref string) error {
+			label, err := resolveLabel(ctx, client, ref, teamKey)
+			if err != nil {
+				return err
+			}
+			return client.DeleteLabel(ctx, label.ID)
+		}, plaintext, jsonOut)
+	},
+}
+
+var labelMergeCmd = &cobra.Command{
+	Use:   "merge SOURCE TARGET",
+	Short: "Move all issues from one label to another",
+	Long: `Relabel every issue carrying SOURCE with TARGET, then delete SOURCE.
+Archived issues are relabeled too.
+
+You are asked to confirm unless --yes is given. SOURCE is kept when any issue
+fails to relabel, or when --keep-source is given.
+
+Examples:
+  linctl label merge "bug" "Bug" --team ENG
+  linctl label merge "Type/Defect" "Type/Bug" --yes --keep-source`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		teamKey := teamKeyOrDefault(cmd)
+		yes, _ := cmd.Flags().GetBool("yes")
+		keepSource, _ := cmd.Flags().GetBool("keep-source")
+
+		client, err := auth.NewClient()
+		if err != nil {
+			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		ctx := context.Background()
+
+		source, err := resolveLabel(ctx, client, args[0], teamKey)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to find source label: %v", err), plaintext, jsonOut)
+			os.Exit(exitCodeFor(err))
+		}
+		target, err := resolveLabel(ctx, client, args[1], teamKey)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to find target label: %v", err), plaintext, jsonOut)
+			os.Exit(exitCodeFor(err))
+		}
+
+		if source.ID == target.ID {
+			output.Error("Source and target are the same label", plaintext, jsonOut)
+			os.Exit(1)
+		}
+		if source.IsGroup || target.IsGroup {
+			output.Error("Label groups cannot be merged; merge the labels inside them instead", plaintext, jsonOut)
+			os.Exit(1)
+		}
+
+		filter := map[string]interface{}{
+			"labels": map[string]interface{}{
+				"id": map[string]interface{}{"eq": source.ID},
+			},
+		}
+		issues, err := api.NewPaginator(client.IssuePages(filter, "", true), 0).All(ctx)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to list issues: %v", err), plaintext, jsonOut)
+			os.Exit(exitCodeFor(err))
+		}
+
+		if !yes {
+			prompt := fmt.Sprintf("Move %d issue(s) from %s to %s", len(issues), labelDisplayName(*source), labelDisplayName(*target))
+			if keepSource {
+				prompt += "?"
+			} else {
+				prompt += fmt.Sprintf(" and delete %s?", labelDisplayName(*source))
+			}
+			confirmed, err := confirmAction(prompt)
+			if err != nil {
+				output.Error(err.Error(), plaintext, jsonOut)
+				os.Exit(1)
+			}
+			if !confirmed {
+				output.Info("Aborted", plaintext, jsonOut)
+				return
+			}
+		}
+
+		results := make([]batchResult, 0, len(issues))
+		var lastErr error
+		for _, issue := range issues {
+			result := batchResult{Identifier: issue.Identifier, Success: true}
+			_, err := client.UpdateIssue(ctx, issue.ID, map[string]interface{}{
+				"addedLabelIds":   []string{target.ID},
+				"removedLabelIds": []string{source.ID},
+			})
+			if err != nil {
+				result.Success = false
+				result.Error = err.Error()
+				lastErr = err
+			}
+			results = append(results, result)
+		}
+
+		deleted := false
+		if lastErr == nil && !keepSource {
+			if err := client.DeleteLabel(ctx, source.ID); err != nil {
+				lastErr = fmt.Errorf("relabeled issues but failed to delete %s: %w", labelDisplayName(*source), err)
+			} else {
+				deleted = true
+			}
+		}
+
+		if jsonOut {
+			output.JSON(map[string]interface{}{
+				"source":  source,
+				"target":  target,
+				"issues":  results,
+				"deleted": deleted,
+			})
+		} else {
+			moved := 0
+			for _, result := range results {
+				if result.Success {
+					moved++
+				} else {
+					output.Error(fmt.Sprintf("%s: %s", result.Identifier, result.Error), plaintext, jsonOut)
+				}
+			}
+
+			if plaintext {
+				fmt.Printf("Moved %d issue(s) from %s to %s\n", moved, labelDisplayName(*source), labelDisplayName(*target))
+				if deleted {
+					fmt.Printf("Deleted %s\n", labelDisplayName(*source))
+				}
+			} else {
+				fmt.Printf("%s Moved %d issue(s) from %s to %s\n",
+					color.New(color.FgGreen).Sprint("✓"),
+					moved,
+					color.New(color.FgCyan, color.Bold).Sprint(labelDisplayName(*source)),
+					color.New(color.FgCyan, color.Bold).Sprint(labelDisplayName(*target)))
+				if deleted {
+					fmt.Printf("%s Deleted %s\n",
+						color.New(color.FgGreen).Sprint("✓"),
+						color.New(color.FgCyan, color.Bold).Sprint(labelDisplayName(*source)))
+				}
+			}
+		}
+
+		if lastErr != nil {
+			if !jsonOut {
+				output.Error(lastErr.Error(), plaintext, jsonOut)
+			}
+			os.Exit(exitCodeFor(lastErr))
+		}
+	},
+}
+
+// printLabelResult reports a created or updated label
+func printLabelResult(verb string, label *api.Label, plaintext, jsonOut bool) {
+	if jsonOut {
+		output.JSON(label)
+	} else if plaintext {
+		fmt.Printf("%s label %s\n", verb, labelDisplayName(*label))
+		fmt.Printf("ID: %s\n", label.ID)
+		fmt.Printf("Team: %s\n", labelScope(*label))
+	} else {
+		fmt.Printf("%s %s label %s\n",
+			color.New(color.FgGreen).Sprint("✓"),
+			verb,
+			color.New(color.FgCyan, color.Bold).Sprint(labelDisplayName(*label)))
+		fmt.Printf("  ID: %s\n", color.New(color.FgWhite, color.Faint).Sprint(label.ID))
+		fmt.Printf("  Team: %s\n", labelScope(*label))
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(labelCmd)
+	labelCmd.AddCommand(labelListCmd)
+	labelCmd.AddCommand(labelCreateCmd)
+	labelCmd.AddCommand(labelUpdateCmd)
+	labelCmd.AddCommand(labelArchiveCmd)
+	labelCmd.AddCommand(labelMergeCmd)
+
+	labelListCmd.Flags().StringP("team", "t", "", "Only show labels of this team (default: the team setting, with workspace labels)")
+	labelListCmd.Flags().BoolP("workspace", "w", false, "Only show workspace labels")
+	labelListCmd.Flags().Bool("tree", false, "Show labels nested under their groups")
+
+	for _, c := range []*cobra.Command{labelCreateCmd, labelUpdateCmd} {
+		c.Flags().String("parent", "", "Label group to put the label in")
+		c.Flags().StringP("color", "c", "", "Label color (hex, e.g. #EB5757)")
+		c.Flags().StringP("description", "d", "", "Label description")
+	}
+	labelCreateCmd.Flags().StringP("team", "t", "", "Team key (default: the team setting; --team \"\" for a workspace label)")
+	labelCreateCmd.Flags().Bool("group", false, "Create a label group")
+	labelUpdateCmd.Flags().String("name", "", "New label name")
+
+	for _, c := range []*cobra.Command{labelUpdateCmd, labelArchiveCmd, labelMergeCmd} {
+		c.Flags().StringP("team", "t", "", "Team key used to disambiguate label names (default: the team setting)")
+	}
+
+	labelMergeCmd.Flags().BoolP("yes", "y", false, "Skip the confirmation prompt")
+	labelMergeCmd.Flags().Bool("keep-source", false, "Keep the source label instead of deleting it")
+}
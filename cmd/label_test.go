@@ -0,0 +1,198 @@
+package cmd
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/yjiky/linctl/pkg/api"
+)
+
+// labelNamesOf returns the display names of labels, sorted
+func labelNamesOf(labels []api.Label) []string {
+	names := make([]string, 0, len(labels))
+	for _, label := range labels {
+		names = append(names, labelDisplayName(label))
+	}
+	sort.Strings(names)
+	return names
+}
+
+func TestLabelList(t *testing.T) {
+	env := newWorkspace(t)
+	env.fake.AddLabel("ENG", api.Label{Name: "Type", IsGroup: true})
+	env.fake.AddLabel("ENG", api.Label{Name: "Regression", Parent: &api.Label{Name: "Type"}})
+	env.fake.AddLabel("OPS", api.Label{Name: "bug"})
+
+	tests := []struct {
+		name string
+		args []string
+		want []string
+	}{
+		{"all", nil, []string{"Type", "Type/Regression", "bug", "bug", "customer", "feature"}},
+		{"team", []string{"--team", "OPS"}, []string{"bug"}},
+		{"workspace", []string{"--workspace"}, []string{"customer"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var labels []api.Label
+			env.runJSON(&labels, append([]string{"label", "list"}, tt.args...)...)
+			if got := labelNamesOf(labels); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("label list %v = %v, want %v", tt.args, got, tt.want)
+			}
+		})
+	}
+
+	out := env.run("label", "list", "--team", "ENG", "--plaintext")
+	assertContains(t, out, "Name\tGroup\tTeam\tColor\tID", "Regression\tType\tENG\t", "bug\t\tENG\t#eb5757\t")
+
+	out = env.run("label", "ls", "--team", "ENG", "--tree", "--plaintext")
+	assertContains(t, out, "- Type (ENG)\n  - Regression\n", "- bug (ENG)\n")
+
+	out = env.expectExit(exitError, "label", "list", "--team", "ENG", "--workspace")
+	assertContains(t, out, "Use either --team or --workspace, not both")
+
+	// The team setting shows that team's labels and workspace labels
+	env.run("config", "set", "team", "OPS")
+	var labels []api.Label
+	env.runJSON(&labels, "label", "list")
+	if got, want := labelNamesOf(labels), []string{"bug", "customer"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("label list with the team setting = %v, want %v", got, want)
+	}
+	env.runJSON(&labels, "label", "list", "--team", "")
+	if len(labels) != 6 {
+		t.Errorf("label list --team \"\" returned %d labels, want all 6", len(labels))
+	}
+}
+
+func TestLabelCreate(t *testing.T) {
+	env := newWorkspace(t)
+	env.fake.AddLabel("ENG", api.Label{Name: "Type", IsGroup: true})
+
+	var label api.Label
+	env.runJSON(&label, "label", "create", "Regression", "--team", "ENG", "--parent", "Type", "--color", "EB5757", "-d", "Worked before")
+	if labelDisplayName(label) != "Type/Regression" || label.Color != "#EB5757" || labelScope(label) != "ENG" {
+		t.Errorf("created label %s (%s) with color %s", labelDisplayName(label), labelScope(label), label.Color)
+	}
+
+	out := env.run("label", "new", "Area", "--group", "--plaintext")
+	assertContains(t, out, "Created label Area", "Team: workspace")
+
+	tests := []struct {
+		name string
+		code int
+		args []string
+		want string
+	}{
+		{"parent is not a group", exitError, []string{"Crash", "--team", "ENG", "--parent", "bug"}, "'bug' is not a label group"},
+		{"unknown parent", exitNotFound, []string{"Crash", "--parent", "Kind"}, "label not found: Kind"},
+		{"unknown team", exitNotFound, []string{"Crash", "--team", "NOPE"}, "Failed to find team 'NOPE'"},
+		{"duplicate", exitError, []string{"bug", "--team", "ENG"}, "Failed to create label"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := env.expectExit(tt.code, append([]string{"label", "create"}, tt.args...)...)
+			assertContains(t, out, tt.want)
+		})
+	}
+
+	env.run("config", "set", "team", "ENG")
+	env.runJSON(&label, "label", "create", "Crash")
+	if labelScope(label) != "ENG" {
+		t.Errorf("label created with the team setting belongs to %s, want ENG", labelScope(label))
+	}
+	var workspaceLabel api.Label
+	env.runJSON(&workspaceLabel, "label", "create", "Partner", "--team", "")
+	if labelScope(workspaceLabel) != "workspace" {
+		t.Errorf("label created with --team \"\" belongs to %s, want the workspace", labelScope(workspaceLabel))
+	}
+}
+
+func TestLabelUpdate(t *testing.T) {
+	env := newWorkspace(t)
+	group := env.fake.AddLabel("ENG", api.Label{Name: "Type", IsGroup: true})
+	env.fake.AddLabel("OPS", api.Label{Name: "bug"})
+
+	out := env.run("label", "update", "bug", "--team", "ENG", "--name", "Bug", "--parent", "Type", "--plaintext")
+	assertContains(t, out, "Updated label Type/Bug", "Team: ENG")
+	if got := labelNames(env.issue("ENG-1")); !reflect.DeepEqual(got, []string{"Bug"}) {
+		t.Errorf("ENG-1 labels = %v, want the renamed label", got)
+	}
+
+	var label api.Label
+	env.runJSON(&label, "label", "edit", "Type/Bug", "--parent", "none")
+	if label.Parent != nil {
+		t.Errorf("label is still in group %+v", label.Parent)
+	}
+	if got := env.lastVariables("UpdateIssueLabel")["input"].(map[string]interface{}); got["parentId"] != nil {
+		t.Errorf("update input = %v, want a null parentId", got)
+	}
+
+	out = env.expectExit(exitError, "label", "update", "bug", "--name", "Defect")
+	assertContains(t, out, "label 'bug' is ambiguous: Bug (ENG), bug (OPS)")
+
+	out = env.expectExit(exitError, "label", "update", group.ID)
+	assertContains(t, out, "No updates specified")
+	env.expectExit(exitNotFound, "label", "update", "missing", "--name", "X")
+}
+
+func TestLabelArchive(t *testing.T) {
+	env := newWorkspace(t)
+
+	out := env.run("label", "archive", "customer", "feature", "--plaintext")
+	assertContains(t, out, "Deleted customer", "Deleted feature")
+
+	var labels []api.Label
+	env.runJSON(&labels, "label", "list")
+	if got := labelNamesOf(labels); !reflect.DeepEqual(got, []string{"bug"}) {
+		t.Errorf("labels after delete = %v, want [bug]", got)
+	}
+	if got := labelNames(env.issue("ENG-2")); len(got) != 0 {
+		t.Errorf("ENG-2 labels = %v, want the deleted label removed", got)
+	}
+
+	env.expectExit(exitNotFound, "label", "delete", "feature")
+}
+
+func TestLabelMerge(t *testing.T) {
+	env := newWorkspace(t)
+	env.fake.AddLabel("ENG", api.Label{Name: "defect"})
+	env.fake.AddLabel("OPS", api.Label{Name: "bug"})
+	env.run("issue", "update", "ENG-1", "--add-label", "defect")
+	env.run("issue", "update", "ENG-2", "--add-label", "defect")
+	env.run("issue", "archive", "ENG-2")
+
+	out := env.expectExit(exitError, "label", "merge", "defect", "bug")
+	assertContains(t, out, "label 'bug' is ambiguous: bug (ENG), bug (OPS)")
+
+	out = env.expectExit(exitError, "label", "merge", "defect", "bug", "--team", "ENG")
+	assertContains(t, out, "pass --yes")
+
+	out = env.run("label", "merge", "feature", "customer", "--yes", "--keep-source", "--plaintext")
+	assertContains(t, out, "Moved 1 issue(s) from feature to customer")
+	if out := env.run("label", "list", "--team", "ENG", "--plaintext"); !strings.Contains(out, "feature\t") {
+		t.Error("--keep-source deleted the source label")
+	}
+
+	var result struct {
+		Issues  []batchResult `json:"issues"`
+		Deleted bool          `json:"deleted"`
+	}
+	env.runJSON(&result, "label", "merge", "defect", "bug", "--team", "ENG", "--yes")
+	if len(result.Issues) != 2 || !result.Deleted {
+		t.Errorf("merge result = %+v, want two issues moved and defect deleted", result)
+	}
+	if got := env.lastVariables("Issues")["includeArchived"]; got != true {
+		t.Errorf("merge listed issues with includeArchived = %v, want archived ENG-2 included", got)
+	}
+	want := map[string][]string{"ENG-1": {"bug"}, "ENG-2": {"bug", "customer"}}
+	for ref, labels := range want {
+		if got := labelNames(env.issue(ref)); !reflect.DeepEqual(got, labels) {
+			t.Errorf("%s labels = %v, want %v", ref, got, labels)
+		}
+	}
+
+	out = env.expectExit(exitError, "label", "merge", "customer", "customer", "--yes")
+	assertContains(t, out, "Source and target are the same label")
+}
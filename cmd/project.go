@@ -37,14 +37,16 @@ func constructProjectURL(projectID string, originalURL string) string {
 var projectCmd = &cobra.Command{
 	Use:   "project",
 	Short: "Manage Linear projects",
-	Long: `Manage Linear projects including listing, viewing, and creating projects.
+	Long: `Manage Linear projects including listing, viewing, creating, and updating projects.
 
 Examples:
   linctl project list                      # List active projects
   linctl project list --include-completed  # List all projects including completed
   linctl project list --newer-than 1_month_ago  # List projects from last month
   linctl project get PROJECT-ID            # Get project details
-  linctl project create                    # Create a new project`,
+  linctl project create --name "Q3" --team ENG  # Create a new project
+  linctl project update PROJECT-ID --state started
+  linctl project add-issue PROJECT-ID LIN-123   # Move an issue into a project`,
 }
 
 var projectListCmd = &cobra.Command{
@@ -56,20 +58,17 @@ var projectListCmd = &cobra.Command{
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
 
-		// Get auth header
-		authHeader, err := auth.GetAuthHeader()
+		// Create API client
+		client, err := auth.NewClient()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitAuth)
 		}
 
-		// Create API client
-		client := api.NewClient(authHeader)
-
 		// Get filters
-		teamKey, _ := cmd.Flags().GetString("team")
+		teamKey := teamKeyOrDefault(cmd)
 		state, _ := cmd.Flags().GetString("state")
-		limit, _ := cmd.Flags().GetInt("limit")
+		limit := resolveListLimit(cmd)
 		includeCompleted, _ := cmd.Flags().GetBool("include-completed")
 
 		// Build filter
@@ -79,9 +78,11 @@ var projectListCmd = &cobra.Command{
 			team, err := client.GetTeam(context.Background(), teamKey)
 			if err != nil {
 				output.Error(fmt.Sprintf("Failed to find team '%s': %v", teamKey, err), plaintext, jsonOut)
-				os.Exit(1)
+				os.Exit(exitCodeFor(err))
+			}
+			filter["accessibleTeams"] = map[string]interface{}{
+				"some": map[string]interface{}{"id": map[string]interface{}{"eq": team.ID}},
 			}
-			filter["team"] = map[string]interface{}{"id": team.ID}
 		}
 		if state != "" {
 			filter["state"] = map[string]interface{}{"eq": state}
@@ -97,7 +98,7 @@ var projectListCmd = &cobra.Command{
 		createdAt, err := utils.ParseTimeExpression(newerThan)
 		if err != nil {
 			output.Error(fmt.Sprintf("Invalid newer-than value: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCodeFor(err))
 		}
 		if createdAt != "" {
 			filter["createdAt"] = map[string]interface{}{"gte": createdAt}
@@ -122,11 +123,13 @@ var projectListCmd = &cobra.Command{
 		}
 
 		// Get projects
-		projects, err := client.GetProjects(context.Background(), filter, limit, "", orderBy)
+		paginator := api.NewPaginator(client.ProjectPages(filter, orderBy), limit)
+		nodes, err := paginator.All(context.Background())
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to list projects: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCodeFor(err))
 		}
+		projects := &api.Projects{Nodes: nodes, PageInfo: paginator.PageInfo()}
 
 		// Handle output
 		if jsonOut {
@@ -241,21 +244,18 @@ var projectGetCmd = &cobra.Command{
 		jsonOut := viper.GetBool("json")
 		projectID := args[0]
 
-		// Get auth header
-		authHeader, err := auth.GetAuthHeader()
+		// Create API client
+		client, err := auth.NewClient()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitAuth)
 		}
 
-		// Create API client
-		client := api.NewClient(authHeader)
-
 		// Get project details
 		project, err := client.GetProject(context.Background(), projectID)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to get project: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCodeFor(err))
 		}
 
 		// Handle output
@@ -582,16 +582,402 @@ var projectGetCmd = &cobra.Command{
 	},
 }
 
+// resolveProjectID resolves a project ID or slug to the project's ID
+func resolveProjectID(ctx context.Context, client *api.Client, ref string) (string, error) {
+	project, err := client.GetProject(ctx, strings.TrimSpace(ref))
+	if err != nil {
+		return "", err
+	}
+	if project.ID == "" {
+		return "", &api.Error{Code: api.CodeEntityNotFound, Message: fmt.Sprintf("project not found: %s", ref)}
+	}
+	return project.ID, nil
+}
+
+// resolveTeamIDs resolves a comma-separated list of team keys to team IDs
+func resolveTeamIDs(ctx context.Context, client *api.Client, value string) ([]string, error) {
+	ids := []string{}
+	for _, raw := range strings.Split(value, ",") {
+		key := strings.TrimSpace(raw)
+		if key == "" {
+			continue
+		}
+		team, err := client.GetTeam(ctx, key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find team '%s': %w", key, err)
+		}
+		if team.ID == "" {
+			return nil, &api.Error{Code: api.CodeEntityNotFound, Message: fmt.Sprintf("team not found: %s", key)}
+		}
+		ids = append(ids, team.ID)
+	}
+	return ids, nil
+}
+
+// buildProjectInput collects the project fields set on the command line into a
+// ProjectCreateInput/ProjectUpdateInput. On update, 'none' clears optional fields.
+func buildProjectInput(ctx context.Context, cmd *cobra.Command, client *api.Client) (map[string]interface{}, error) {
+	input := make(map[string]interface{})
+	flags := cmd.Flags()
+
+	clearable := func(value string) bool {
+		trimmed := strings.TrimSpace(value)
+		return trimmed == "" || strings.EqualFold(trimmed, "none")
+	}
+
+	if flags.Changed("name") {
+		name, _ := flags.GetString("name")
+		input["name"] = name
+	}
+
+	if flags.Changed("description") {
+		description, _ := flags.GetString("description")
+		input["description"] = description
+	}
+
+	if flags.Changed("description-file") {
+		path, _ := flags.GetString("description-file")
+		content, err := readTextFile(path)
+		if err != nil {
+			return nil, err
+		}
+		input["content"] = content
+	}
+
+	if flags.Changed("team") {
+		teams, _ := flags.GetString("team")
+		teamIDs, err := resolveTeamIDs(ctx, client, teams)
+		if err != nil {
+			return nil, err
+		}
+		if len(teamIDs) == 0 {
+			return nil, fmt.Errorf("at least one team is required")
+		}
+		input["teamIds"] = teamIDs
+	}
+
+	if flags.Changed("lead") {
+		lead, _ := flags.GetString("lead")
+		if clearable(lead) {
+			input["leadId"] = nil
+		} else {
+			leadID, err := resolveUserID(ctx, client, lead)
+			if err != nil {
+				return nil, fmt.Errorf("failed to resolve lead: %w", err)
+			}
+			input["leadId"] = leadID
+		}
+	}
+
+	if flags.Changed("members") {
+		members, _ := flags.GetString("members")
+		if clearable(members) {
+			input["memberIds"] = []string{}
+		} else {
+			memberIDs, err := resolveUserIDs(ctx, client, members)
+			if err != nil {
+				return nil, fmt.Errorf("failed to resolve members: %w", err)
+			}
+			input["memberIds"] = memberIDs
+		}
+	}
+
+	for flag, field := range map[string]string{
+		"start-date":  "startDate",
+		"target-date": "targetDate",
+		"icon":        "icon",
+		"color":       "color",
+	} {
+		if !flags.Changed(flag) {
+			continue
+		}
+		value, _ := flags.GetString(flag)
+		if clearable(value) {
+			input[field] = nil
+		} else {
+			input[field] = strings.TrimSpace(value)
+		}
+	}
+
+	if flags.Changed("state") {
+		state, _ := flags.GetString("state")
+		switch strings.ToLower(strings.TrimSpace(state)) {
+		case "backlog", "planned", "started", "paused", "completed", "canceled":
+			input["state"] = strings.ToLower(strings.TrimSpace(state))
+		default:
+			return nil, fmt.Errorf("invalid state: %s. Valid states are: backlog, planned, started, paused, completed, canceled", state)
+		}
+	}
+
+	return input, nil
+}
+
+var projectCreateCmd = &cobra.Command{
+	Use:     "create",
+	Aliases: []string{"new"},
+	Short:   "Create a new project",
+	Long: `Create a new project in Linear.
+
+Examples:
+  linctl project create --name "Q3 Billing" --team ENG
+  linctl project create --name "Launch" --team ENG,DES --lead me --target-date 2024-09-30
+  linctl project create --name "Search v2" --team ENG --description-file spec.md`,
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		client, err := auth.NewClient()
+		if err != nil {
+			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		name, _ := cmd.Flags().GetString("name")
+		if strings.TrimSpace(name) == "" {
+			output.Error("Name is required (--name)", plaintext, jsonOut)
+			os.Exit(1)
+		}
+
+		teamKey := teamKeyOrDefault(cmd)
+		if teamKey == "" {
+			output.Error("Team is required (--team, or set a default with 'linctl config set team KEY')", plaintext, jsonOut)
+			os.Exit(1)
+		}
+		_ = cmd.Flags().Set("team", teamKey)
+
+		input, err := buildProjectInput(context.Background(), cmd, client)
+		if err != nil {
+			output.Error(fmt.Sprintf("Invalid project: %v", err), plaintext, jsonOut)
+			os.Exit(exitCodeFor(err))
+		}
+
+		// Creating with nulls is meaningless, so drop cleared fields
+		for key, value := range input {
+			if value == nil {
+				delete(input, key)
+			}
+		}
+
+		project, err := client.CreateProject(context.Background(), input)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to create project: %v", err), plaintext, jsonOut)
+			os.Exit(exitCodeFor(err))
+		}
+
+		if jsonOut {
+			output.JSON(project)
+		} else if plaintext {
+			fmt.Printf("Created project %s (%s)\n", project.Name, project.ID)
+			fmt.Printf("URL: %s\n", constructProjectURL(project.ID, project.URL))
+		} else {
+			fmt.Printf("%s Created project %s\n",
+				color.New(color.FgGreen).Sprint("✓"),
+				color.New(color.FgCyan, color.Bold).Sprint(project.Name))
+			fmt.Printf("  ID:  %s\n", project.ID)
+			fmt.Printf("  URL: %s\n", color.New(color.FgBlue, color.Underline).Sprint(constructProjectURL(project.ID, project.URL)))
+		}
+	},
+}
+
+var projectUpdateCmd = &cobra.Command{
+	Use:     "update PROJECT-ID",
+	Aliases: []string{"edit"},
+	Short:   "Update a project",
+	Long: `Update the fields of a project.
+
+Status updates (health posts) are managed with 'linctl project update list'
+and 'linctl project update post'.
+
+Examples:
+  linctl project update PROJECT-ID --state started
+  linctl project update PROJECT-ID --lead jane@company.com --members me,john@company.com
+  linctl project update PROJECT-ID --target-date 2024-12-31
+  linctl project update PROJECT-ID --target-date none
+  linctl project update PROJECT-ID --description-file spec.md`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		client, err := auth.NewClient()
+		if err != nil {
+			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		input, err := buildProjectInput(context.Background(), cmd, client)
+		if err != nil {
+			output.Error(fmt.Sprintf("Invalid project update: %v", err), plaintext, jsonOut)
+			os.Exit(exitCodeFor(err))
+		}
+
+		if len(input) == 0 {
+			output.Error("No updates specified. Use flags to specify what to update.", plaintext, jsonOut)
+			os.Exit(1)
+		}
+
+		project, err := client.UpdateProject(context.Background(), args[0], input)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to update project: %v", err), plaintext, jsonOut)
+			os.Exit(exitCodeFor(err))
+		}
+
+		if jsonOut {
+			output.JSON(project)
+		} else {
+			output.Success(fmt.Sprintf("Updated project %s", project.Name), plaintext, jsonOut)
+		}
+	},
+}
+
+var projectArchiveCmd = &cobra.Command{
+	Use:   "archive PROJECT-ID...",
+	Short: "Archive one or more projects",
+	Long: `Archive one or more projects. Archived projects can be restored with 'linctl project unarchive'.
+
+Examples:
+  linctl project archive PROJECT-ID`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		client, err := auth.NewClient()
+		if err != nil {
+			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		runBatchAction(args, "Archived", client.ArchiveProject, plaintext, jsonOut)
+	},
+}
+
+var projectUnarchiveCmd = &cobra.Command{
+	Use:   "unarchive PROJECT-ID...",
+	Short: "Restore archived projects",
+	Long: `Restore one or more archived projects.
+
+Examples:
+  linctl project unarchive PROJECT-ID`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		client, err := auth.NewClient()
+		if err != nil {
+			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		runBatchAction(args, "Unarchived", client.UnarchiveProject, plaintext, jsonOut)
+	},
+}
+
+var projectAddIssueCmd = &cobra.Command{
+	Use:   "add-issue PROJECT-ID ISSUE-ID...",
+	Short: "Add issues to a project",
+	Long: `Add one or more issues to a project, moving them out of any project they were in.
+
+Examples:
+  linctl project add-issue PROJECT-ID LIN-123
+  linctl project add-issue PROJECT-ID LIN-123 LIN-124`,
+	Args: cobra.MinimumNArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		client, err := auth.NewClient()
+		if err != nil {
+			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		project, err := client.GetProject(context.Background(), args[0])
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to get project: %v", err), plaintext, jsonOut)
+			os.Exit(exitCodeFor(err))
+		}
+
+		runBatchAction(args[1:], fmt.Sprintf("Added to %s:", project.Name), func(ctx context.Context, id string) error {
+			_, err := client.UpdateIssue(ctx, id, map[string]interface{}{"projectId": project.ID})
+			return err
+		}, plaintext, jsonOut)
+	},
+}
+
+var projectRemoveIssueCmd = &cobra.Command{
+	Use:   "remove-issue PROJECT-ID ISSUE-ID...",
+	Short: "Remove issues from a project",
+	Long: `Remove one or more issues from a project. Issues that are not in the project are reported as errors and left untouched.
+
+Examples:
+  linctl project remove-issue PROJECT-ID LIN-123
+  linctl project remove-issue PROJECT-ID LIN-123 LIN-124`,
+	Args: cobra.MinimumNArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		client, err := auth.NewClient()
+		if err != nil {
+			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		project, err := client.GetProject(context.Background(), args[0])
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to get project: %v", err), plaintext, jsonOut)
+			os.Exit(exitCodeFor(err))
+		}
+
+		runBatchAction(args[1:], fmt.Sprintf("Removed from %s:", project.Name), func(ctx context.Context, id string) error {
+			issue, err := client.GetIssue(ctx, id)
+			if err != nil {
+				return err
+			}
+			if issue.Project == nil || issue.Project.ID != project.ID {
+				return fmt.Errorf("issue is not in project %s", project.Name)
+			}
+			_, err = client.UpdateIssue(ctx, issue.ID, map[string]interface{}{"projectId": nil})
+			return err
+		}, plaintext, jsonOut)
+	},
+}
+
 func init() {
 	rootCmd.AddCommand(projectCmd)
 	projectCmd.AddCommand(projectListCmd)
 	projectCmd.AddCommand(projectGetCmd)
+	projectCmd.AddCommand(projectCreateCmd)
+	projectCmd.AddCommand(projectUpdateCmd)
+	projectCmd.AddCommand(projectArchiveCmd)
+	projectCmd.AddCommand(projectUnarchiveCmd)
+	projectCmd.AddCommand(projectAddIssueCmd)
+	projectCmd.AddCommand(projectRemoveIssueCmd)
 
 	// List command flags
-	projectListCmd.Flags().StringP("team", "t", "", "Filter by team key")
+	projectListCmd.Flags().StringP("team", "t", "", "Filter by team key (default: the team setting)")
 	projectListCmd.Flags().StringP("state", "s", "", "Filter by state (planned, started, paused, completed, canceled)")
-	projectListCmd.Flags().IntP("limit", "l", 50, "Maximum number of projects to return")
+	projectListCmd.Flags().IntP("limit", "l", 50, "Maximum number of projects to return (pages automatically)")
+	projectListCmd.Flags().Bool("all", false, "Fetch every matching project, ignoring --limit")
 	projectListCmd.Flags().BoolP("include-completed", "c", false, "Include completed and canceled projects")
 	projectListCmd.Flags().StringP("sort", "o", "linear", "Sort order: linear (default), created, updated")
 	projectListCmd.Flags().StringP("newer-than", "n", "", "Show projects created after this time (default: 6_months_ago, use 'all_time' for no filter)")
+
+	// Create and update flags
+	for _, c := range []*cobra.Command{projectCreateCmd, projectUpdateCmd} {
+		c.Flags().String("name", "", "Project name")
+		c.Flags().StringP("description", "d", "", "Short project summary")
+		c.Flags().String("description-file", "", "Read the project's full description (markdown) from a file, or - for stdin")
+		c.Flags().StringP("team", "t", "", "Comma-separated team keys")
+		c.Flags().String("lead", "", "Project lead (email, name, or 'me')")
+		c.Flags().String("members", "", "Comma-separated project members (email, name, or 'me')")
+		c.Flags().String("start-date", "", "Start date (YYYY-MM-DD)")
+		c.Flags().String("target-date", "", "Target date (YYYY-MM-DD)")
+		c.Flags().StringP("state", "s", "", "State (backlog, planned, started, paused, completed, canceled)")
+		c.Flags().String("icon", "", "Project icon (emoji or icon name)")
+		c.Flags().String("color", "", "Project color (hex, e.g. #5E6AD2)")
+	}
+	_ = projectCreateCmd.MarkFlagRequired("name")
 }
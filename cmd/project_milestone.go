@@ -0,0 +1,419 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/yjiky/linctl/pkg/api"
+	"github.com/yjiky/linctl/pkg/auth"
+	"github.com/yjiky/linctl/pkg/output"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// milestoneProgress is a milestone with its issue completion counts
+type milestoneProgress struct {
+	api.Milestone
+	Completed int     `json:"completedIssues"`
+	Canceled  int     `json:"canceledIssues"`
+	Total     int     `json:"totalIssues"`
+	Progress  float64 `json:"progress"`
+}
+
+// summarizeMilestone counts a milestone's completed issues. Canceled issues
+// are left out of the progress calculation.
+func summarizeMilestone(milestone api.Milestone) milestoneProgress {
+	summary := milestoneProgress{Milestone: milestone}
+	if milestone.Issues != nil {
+		for _, issue := range milestone.Issues.Nodes {
+			summary.Total++
+			if issue.State == nil {
+				continue
+			}
+			switch issue.State.Type {
+			case "completed":
+				summary.Completed++
+			case "canceled":
+				summary.Canceled++
+			}
+		}
+	}
+	if open := summary.Total - summary.Canceled; open > 0 {
+		summary.Progress = float64(summary.Completed) / float64(open)
+	}
+	return summary
+}
+
+// resolveMilestone finds a milestone of the given project by ID or
+// case-insensitive name. Without a project, ref must be a milestone ID.
+func resolveMilestone(ctx context.Context, client *api.Client, projectID, ref string) (*api.Milestone, error) {
+	ref = strings.TrimSpace(ref)
+	if projectID == "" {
+		return client.GetProjectMilestone(ctx, ref)
+	}
+
+	milestones, err := api.NewPaginator(client.ProjectMilestonePages(projectID), 0).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range milestones {
+		if milestones[i].ID == ref || strings.EqualFold(milestones[i].Name, ref) {
+			return &milestones[i], nil
+		}
+	}
+
+	return nil, &api.Error{Code: api.CodeEntityNotFound, Message: fmt.Sprintf("milestone not found in project: %s", ref)}
+}
+
+// buildMilestoneInput collects the milestone fields set on the command line.
+// 'none' clears the target date.
+func buildMilestoneInput(cmd *cobra.Command) map[string]interface{} {
+	input := make(map[string]interface{})
+	flags := cmd.Flags()
+
+	if flags.Changed("name") {
+		name, _ := flags.GetString("name")
+		input["name"] = name
+	}
+
+	if flags.Changed("description") {
+		description, _ := flags.GetString("description")
+		input["description"] = description
+	}
+
+	if flags.Changed("target-date") {
+		targetDate, _ := flags.GetString("target-date")
+		trimmed := strings.TrimSpace(targetDate)
+		if trimmed == "" || strings.EqualFold(trimmed, "none") {
+			input["targetDate"] = nil
+		} else {
+			input["targetDate"] = trimmed
+		}
+	}
+
+	return input
+}
+
+var projectMilestoneCmd = &cobra.Command{
+	Use:     "milestone",
+	Aliases: []string{"milestones"},
+	Short:   "Manage project milestones",
+	Long: `List, create, update, and delete the milestones of a project.
+
+Milestones are matched by ID or by name within the project.`,
+}
+
+var projectMilestoneListCmd = &cobra.Command{
+	Use:     "list PROJECT-ID",
+	Aliases: []string{"ls"},
+	Short:   "List a project's milestones with completion",
+	Long: `List a project's milestones with target dates and how many of their
+issues are done. Canceled issues do not count towards completion.
+
+Examples:
+  linctl project milestone list PROJECT-ID
+  linctl project milestone list PROJECT-ID --json`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		client, err := auth.NewClient()
+		if err != nil {
+			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		ctx := context.Background()
+
+		projectID, err := resolveProjectID(ctx, client, args[0])
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to find project: %v", err), plaintext, jsonOut)
+			os.Exit(exitCodeFor(err))
+		}
+
+		milestones, err := api.NewPaginator(client.ProjectMilestonePages(projectID), 0).All(ctx)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to list milestones: %v", err), plaintext, jsonOut)
+			os.Exit(exitCodeFor(err))
+		}
+
+		summaries := make([]milestoneProgress, 0, len(milestones))
+		for _, milestone := range milestones {
+			issues, err := api.NewPaginator(client.MilestoneIssuePages(milestone.ID), 0).All(ctx)
+			if err != nil {
+				output.Error(fmt.Sprintf("Failed to get issues of milestone %s: %v", milestone.Name, err), plaintext, jsonOut)
+				os.Exit(exitCodeFor(err))
+			}
+			milestone.Issues = &api.Issues{Nodes: issues}
+			summaries = append(summaries, summarizeMilestone(milestone))
+		}
+
+		if jsonOut {
+			output.JSON(summaries)
+			return
+		}
+
+		if len(summaries) == 0 {
+			output.Info("No milestones found", plaintext, jsonOut)
+			return
+		}
+
+		if plaintext {
+			fmt.Println("Name\tTarget\tCompleted\tTotal\tProgress\tID")
+			for _, summary := range summaries {
+				target := ""
+				if summary.TargetDate != nil {
+					target = *summary.TargetDate
+				}
+				fmt.Printf("%s\t%s\t%d\t%d\t%.0f%%\t%s\n",
+					summary.Name, target, summary.Completed, summary.Total, summary.Progress*100, summary.ID)
+			}
+			return
+		}
+
+		headers := []string{"Milestone", "Target", "Issues", "Progress", "ID"}
+		rows := [][]string{}
+		for _, summary := range summaries {
+			target := color.New(color.FgWhite, color.Faint).Sprint("—")
+			if summary.TargetDate != nil {
+				target = *summary.TargetDate
+			}
+			rows = append(rows, []string{
+				color.New(color.FgCyan).Sprint(truncateString(summary.Name, 35)),
+				target,
+				fmt.Sprintf("%d/%d", summary.Completed, summary.Total-summary.Canceled),
+				progressColor(summary.Progress).Sprintf("%.0f%%", summary.Progress*100),
+				color.New(color.FgWhite, color.Faint).Sprint(summary.ID),
+			})
+		}
+
+		output.Table(output.TableData{
+			Headers: headers,
+			Rows:    rows,
+		}, false, false)
+
+		fmt.Printf("\n%s %d milestones\n",
+			color.New(color.FgGreen).Sprint("✓"),
+			len(summaries))
+	},
+}
+
+var projectMilestoneCreateCmd = &cobra.Command{
+	Use:     "create PROJECT-ID",
+	Aliases: []string{"new"},
+	Short:   "Create a project milestone",
+	Long: `Create a milestone in a project.
+
+Examples:
+  linctl project milestone create PROJECT-ID --name "Beta" --target-date 2025-03-31
+  linctl project milestone create PROJECT-ID --name "GA" --description "Public launch"`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		input := buildMilestoneInput(cmd)
+		if name, _ := input["name"].(string); strings.TrimSpace(name) == "" {
+			output.Error("Milestone name is required (--name)", plaintext, jsonOut)
+			os.Exit(1)
+		}
+
+		client, err := auth.NewClient()
+		if err != nil {
+			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		ctx := context.Background()
+
+		projectID, err := resolveProjectID(ctx, client, args[0])
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to find project: %v", err), plaintext, jsonOut)
+			os.Exit(exitCodeFor(err))
+		}
+		input["projectId"] = projectID
+
+		milestone, err := client.CreateProjectMilestone(ctx, input)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to create milestone: %v", err), plaintext, jsonOut)
+			os.Exit(exitCodeFor(err))
+		}
+
+		printMilestoneResult("Created", milestone, plaintext, jsonOut)
+	},
+}
+
+var projectMilestoneUpdateCmd = &cobra.Command{
+	Use:     "update PROJECT-ID MILESTONE",
+	Aliases: []string{"edit"},
+	Short:   "Update a project milestone",
+	Long: `Update a milestone's name, description, or target date.
+MILESTONE is the milestone's ID or name.
+
+Examples:
+  linctl project milestone update PROJECT-ID Beta --target-date 2025-04-15
+  linctl project milestone update PROJECT-ID Beta --name "Public beta"
+  linctl project milestone update PROJECT-ID Beta --target-date none`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		input := buildMilestoneInput(cmd)
+		if len(input) == 0 {
+			output.Error("No updates specified. Use flags to specify what to update.", plaintext, jsonOut)
+			os.Exit(1)
+		}
+
+		client, err := auth.NewClient()
+		if err != nil {
+			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		ctx := context.Background()
+
+		projectID, err := resolveProjectID(ctx, client, args[0])
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to find project: %v", err), plaintext, jsonOut)
+			os.Exit(exitCodeFor(err))
+		}
+
+		existing, err := resolveMilestone(ctx, client, projectID, args[1])
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to find milestone '%s': %v", args[1], err), plaintext, jsonOut)
+			os.Exit(exitCodeFor(err))
+		}
+
+		milestone, err := client.UpdateProjectMilestone(ctx, existing.ID, input)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to update milestone: %v", err), plaintext, jsonOut)
+			os.Exit(exitCodeFor(err))
+		}
+
+		printMilestoneResult("Updated", milestone, plaintext, jsonOut)
+	},
+}
+
+var projectMilestoneDeleteCmd = &cobra.Command{
+	Use:     "delete PROJECT-ID MILESTONE",
+	Aliases: []string{"rm"},
+	Short:   "Delete a project milestone",
+	Long: `Delete a milestone. Its issues stay in the project without a milestone.
+
+You are asked to confirm unless --yes is given.
+
+Examples:
+  linctl project milestone delete PROJECT-ID Beta
+  linctl project milestone delete PROJECT-ID Beta --yes`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+		yes, _ := cmd.Flags().GetBool("yes")
+
+		client, err := auth.NewClient()
+		if err != nil {
+			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		ctx := context.Background()
+
+		projectID, err := resolveProjectID(ctx, client, args[0])
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to find project: %v", err), plaintext, jsonOut)
+			os.Exit(exitCodeFor(err))
+		}
+
+		milestone, err := resolveMilestone(ctx, client, projectID, args[1])
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to find milestone '%s': %v", args[1], err), plaintext, jsonOut)
+			os.Exit(exitCodeFor(err))
+		}
+
+		if !yes {
+			confirmed, err := confirmAction(fmt.Sprintf("Delete milestone %q?", milestone.Name))
+			if err != nil {
+				output.Error(err.Error(), plaintext, jsonOut)
+				os.Exit(1)
+			}
+			if !confirmed {
+				output.Info("Aborted", plaintext, jsonOut)
+				return
+			}
+		}
+
+		if err := client.DeleteProjectMilestone(ctx, milestone.ID); err != nil {
+			output.Error(fmt.Sprintf("Failed to delete milestone: %v", err), plaintext, jsonOut)
+			os.Exit(exitCodeFor(err))
+		}
+
+		if jsonOut {
+			output.JSON(map[string]interface{}{
+				"deleted": true,
+				"id":      milestone.ID,
+				"name":    milestone.Name,
+			})
+		} else if plaintext {
+			fmt.Printf("Deleted milestone %s\n", milestone.Name)
+		} else {
+			fmt.Printf("%s Deleted milestone %s\n",
+				color.New(color.FgGreen).Sprint("✓"),
+				color.New(color.FgCyan, color.Bold).Sprint(milestone.Name))
+		}
+	},
+}
+
+// printMilestoneResult reports a created or updated milestone
+func printMilestoneResult(verb string, milestone *api.Milestone, plaintext, jsonOut bool) {
+	if jsonOut {
+		output.JSON(milestone)
+		return
+	}
+
+	target := ""
+	if milestone.TargetDate != nil {
+		target = *milestone.TargetDate
+	}
+
+	if plaintext {
+		fmt.Printf("%s milestone %s\n", verb, milestone.Name)
+		fmt.Printf("ID: %s\n", milestone.ID)
+		if target != "" {
+			fmt.Printf("Target: %s\n", target)
+		}
+		return
+	}
+
+	fmt.Printf("%s %s milestone %s\n",
+		color.New(color.FgGreen).Sprint("✓"),
+		verb,
+		color.New(color.FgCyan, color.Bold).Sprint(milestone.Name))
+	fmt.Printf("  ID: %s\n", color.New(color.FgWhite, color.Faint).Sprint(milestone.ID))
+	if target != "" {
+		fmt.Printf("  Target: %s\n", target)
+	}
+}
+
+func init() {
+	projectCmd.AddCommand(projectMilestoneCmd)
+	projectMilestoneCmd.AddCommand(projectMilestoneListCmd)
+	projectMilestoneCmd.AddCommand(projectMilestoneCreateCmd)
+	projectMilestoneCmd.AddCommand(projectMilestoneUpdateCmd)
+	projectMilestoneCmd.AddCommand(projectMilestoneDeleteCmd)
+
+	for _, c := range []*cobra.Command{projectMilestoneCreateCmd, projectMilestoneUpdateCmd} {
+		c.Flags().String("name", "", "Milestone name")
+		c.Flags().StringP("description", "d", "", "Milestone description")
+		c.Flags().String("target-date", "", "Target date (YYYY-MM-DD)")
+	}
+	_ = projectMilestoneCreateCmd.MarkFlagRequired("name")
+
+	projectMilestoneDeleteCmd.Flags().BoolP("yes", "y", false, "Skip the confirmation prompt")
+}
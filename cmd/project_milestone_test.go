@@ -0,0 +1,137 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/yjiky/linctl/pkg/api"
+)
+
+// addMilestones adds Beta and GA milestones to the Website project, with
+// ENG-1 and a done and a canceled issue in Beta
+func addMilestones(env *testEnv) (api.Milestone, api.Milestone) {
+	target := "2030-03-31"
+	beta := env.fake.AddMilestone("Website", api.Milestone{Name: "Beta", TargetDate: &target})
+	ga := env.fake.AddMilestone("Website", api.Milestone{Name: "GA"})
+
+	env.run("issue", "update", "ENG-1", "--milestone", "Beta")
+	for _, state := range []string{"Done", "Canceled"} {
+		env.fake.AddIssue(api.Issue{
+			Title:            state + " work",
+			Team:             &api.Team{Key: "ENG"},
+			State:            &api.State{Name: state},
+			Project:          &api.Project{Name: "Website"},
+			ProjectMilestone: &api.Milestone{Name: "Beta"},
+		})
+	}
+	return beta, ga
+}
+
+func TestProjectMilestoneList(t *testing.T) {
+	env := newWorkspace(t)
+	beta, _ := addMilestones(env)
+	id := env.projectID("Website")
+
+	var milestones []milestoneProgress
+	env.runJSON(&milestones, "project", "milestone", "list", id)
+	if len(milestones) != 2 {
+		t.Fatalf("milestone list returned %d milestones, want 2", len(milestones))
+	}
+	got := milestones[0]
+	if got.ID != beta.ID || got.Total != 3 || got.Completed != 1 || got.Canceled != 1 || got.Progress != 0.5 {
+		t.Errorf("Beta progress = %+v, want 1 of 2 open issues done", got)
+	}
+
+	if got := env.lastVariables("MilestoneIssues")["first"]; got != float64(250) {
+		t.Errorf("milestone issues fetched in pages of %v, want 250", got)
+	}
+
+	out := env.run("project", "milestones", "ls", id, "--plaintext")
+	assertContains(t, out, "Name\tTarget\tCompleted\tTotal\tProgress\tID", "Beta\t2030-03-31\t1\t3\t50%\t"+beta.ID, "GA\t\t0\t0\t0%")
+
+	env.run("project", "create", "--name", "Empty", "--team", "OPS")
+	out = env.run("project", "milestone", "list", env.projectID("Empty"), "--plaintext")
+	assertContains(t, out, "No milestones found")
+
+	env.expectExit(exitNotFound, "project", "milestone", "list", "no-such-project")
+}
+
+func TestProjectMilestoneCreate(t *testing.T) {
+	env := newWorkspace(t)
+	id := env.projectID("Website")
+
+	var milestone api.Milestone
+	env.runJSON(&milestone, "project", "milestone", "create", id, "--name", "Beta", "--description", "Invite-only", "--target-date", "2030-03-31")
+	if milestone.Name != "Beta" || milestone.Description != "Invite-only" || milestone.TargetDate == nil || *milestone.TargetDate != "2030-03-31" {
+		t.Errorf("created milestone = %+v", milestone)
+	}
+
+	out := env.run("project", "milestone", "new", id, "--name", "GA", "--plaintext")
+	assertContains(t, out, "Created milestone GA", "ID: ")
+
+	env.run("issue", "update", "ENG-1", "--milestone", "GA")
+	if issue := env.issue("ENG-1"); issue.ProjectMilestone == nil || issue.ProjectMilestone.Name != "GA" {
+		t.Errorf("ENG-1 milestone = %+v, want GA", issue.ProjectMilestone)
+	}
+	if req, ok := env.fake.LastRequest("ProjectMilestones"); !ok || strings.Contains(req.Query, "issues") {
+		t.Error("resolving a milestone name fetched the milestones' issues")
+	}
+
+	out = env.expectExit(exitError, "project", "milestone", "create", id, "--name", " ")
+	assertContains(t, out, "Milestone name is required")
+	env.expectExit(exitNotFound, "project", "milestone", "create", "no-such-project", "--name", "Beta")
+}
+
+func TestProjectMilestonesPaged(t *testing.T) {
+	env := newWorkspace(t)
+	for n := 0; n < 260; n++ {
+		env.fake.AddMilestone("Website", api.Milestone{Name: fmt.Sprintf("Phase %d", n)})
+	}
+
+	env.run("issue", "update", "ENG-1", "--milestone", "Phase 259")
+	if issue := env.issue("ENG-1"); issue.ProjectMilestone == nil || issue.ProjectMilestone.Name != "Phase 259" {
+		t.Errorf("ENG-1 milestone = %+v, want Phase 259", issue.ProjectMilestone)
+	}
+	if after, _ := env.lastVariables("ProjectMilestones")["after"].(string); after == "" {
+		t.Error("ProjectMilestones was not requested after the first page")
+	}
+}
+
+func TestProjectMilestoneUpdate(t *testing.T) {
+	env := newWorkspace(t)
+	beta, _ := addMilestones(env)
+	id := env.projectID("Website")
+
+	out := env.run("project", "milestone", "update", id, "beta", "--name", "Public beta", "--target-date", "none", "--plaintext")
+	assertContains(t, out, "Updated milestone Public beta")
+	if got := env.lastVariables("UpdateProjectMilestone")["id"]; got != beta.ID {
+		t.Errorf("updated milestone %v, want %s", got, beta.ID)
+	}
+	if issue := env.issue("ENG-1"); issue.ProjectMilestone == nil || issue.ProjectMilestone.Name != "Public beta" || issue.ProjectMilestone.TargetDate != nil {
+		t.Errorf("ENG-1 milestone = %+v", issue.ProjectMilestone)
+	}
+
+	out = env.expectExit(exitError, "project", "milestone", "edit", id, "GA")
+	assertContains(t, out, "No updates specified")
+
+	out = env.expectExit(exitNotFound, "project", "milestone", "update", id, "Launch", "--name", "X")
+	assertContains(t, out, "Failed to find milestone 'Launch'")
+}
+
+func TestProjectMilestoneDelete(t *testing.T) {
+	env := newWorkspace(t)
+	addMilestones(env)
+	id := env.projectID("Website")
+
+	out := env.expectExit(exitError, "project", "milestone", "delete", id, "Beta")
+	assertContains(t, out, "pass --yes")
+
+	out = env.run("project", "milestone", "rm", id, "Beta", "--yes", "--plaintext")
+	assertContains(t, out, "Deleted milestone Beta")
+	if issue := env.issue("ENG-1"); issue.ProjectMilestone != nil || issue.Project == nil {
+		t.Errorf("ENG-1 after deleting its milestone: milestone %+v, project %+v", issue.ProjectMilestone, issue.Project)
+	}
+
+	env.expectExit(exitNotFound, "project", "milestone", "delete", id, "Beta", "--yes")
+}
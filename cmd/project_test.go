@@ -0,0 +1,308 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/yjiky/linctl/pkg/api"
+)
+
+// projectNames returns the names of projects, sorted
+func projectNames(projects []api.Project) []string {
+	names := make([]string, 0, len(projects))
+	for _, project := range projects {
+		names = append(names, project.Name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func TestProjectList(t *testing.T) {
+	env := newWorkspace(t)
+	env.fake.AddProject(api.Project{Name: "On-call rotation", Teams: &api.Teams{Nodes: []api.Team{{Key: "OPS"}}}})
+	env.fake.AddProject(api.Project{Name: "Legacy cleanup", State: "completed", Teams: &api.Teams{Nodes: []api.Team{{Key: "ENG"}}}})
+
+	tests := []struct {
+		name string
+		args []string
+		want []string
+	}{
+		{"open projects", nil, []string{"On-call rotation", "Website"}},
+		{"include completed", []string{"--include-completed"}, []string{"Legacy cleanup", "On-call rotation", "Website"}},
+		{"state", []string{"--state", "completed"}, []string{"Legacy cleanup"}},
+		{"team", []string{"--team", "OPS"}, []string{"On-call rotation"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var projects []api.Project
+			env.runJSON(&projects, append([]string{"project", "list"}, tt.args...)...)
+			if got := projectNames(projects); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("project list %v = %v, want %v", tt.args, got, tt.want)
+			}
+		})
+	}
+
+	out := env.run("project", "list", "--team", "ENG", "--plaintext")
+	assertContains(t, out, "# Projects", "## Website", "- **Lead**: Alice Smith", "- **Teams**: ENG", "Total: 1 projects")
+
+	env.expectExit(exitNotFound, "project", "list", "--team", "NOPE")
+	env.expectExit(exitError, "project", "list", "--sort", "name")
+}
+
+func TestProjectGet(t *testing.T) {
+	env := newWorkspace(t)
+	website, _ := env.fake.Project("Website")
+
+	var project api.Project
+	env.runJSON(&project, "project", "get", website.ID)
+	if project.Name != "Website" || project.Issues == nil || len(project.Issues.Nodes) != 1 {
+		t.Errorf("project get = %q with issues %+v", project.Name, project.Issues)
+	}
+
+	out := env.run("project", "show", website.SlugId, "--plaintext")
+	assertContains(t, out, "# Website", "- **ID**: "+website.ID, "- **State**: started", "- **Lead**: Alice Smith (alice@example.com)", "ENG-1")
+
+	env.expectExit(exitNotFound, "project", "get", "no-such-project")
+}
+
+func TestProjectCreate(t *testing.T) {
+	env := newWorkspace(t)
+
+	spec := filepath.Join(t.TempDir(), "spec.md")
+	if err := os.WriteFile(spec, []byte("# Search v2\n\nFaster search.\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	var project api.Project
+	env.runJSON(&project, "project", "create",
+		"--name", "Search",
+		"--team", "ENG,OPS",
+		"--description", "Rebuild search",
+		"--description-file", spec,
+		"--lead", "me",
+		"--members", "bob@example.com",
+		"--state", "Started",
+		"--start-date", "2030-01-01",
+		"--target-date", "2030-03-31",
+		"--icon", "none",
+	)
+	created, ok := env.fake.Project(project.ID)
+	if !ok {
+		t.Fatalf("project %s was not created", project.ID)
+	}
+	if created.Name != "Search" || created.Description != "Rebuild search" || created.Content != "# Search v2\n\nFaster search.\n" {
+		t.Errorf("created %q: %q, content %q", created.Name, created.Description, created.Content)
+	}
+	if created.State != "started" || created.Lead == nil || created.Lead.Email != "alice@example.com" {
+		t.Errorf("created state %q, lead %+v", created.State, created.Lead)
+	}
+	if created.Members == nil || len(created.Members.Nodes) != 1 || created.Members.Nodes[0].Email != "bob@example.com" {
+		t.Errorf("members = %+v, want bob", created.Members)
+	}
+	if created.Teams == nil || len(created.Teams.Nodes) != 2 {
+		t.Errorf("teams = %+v, want ENG and OPS", created.Teams)
+	}
+	if created.TargetDate == nil || *created.TargetDate != "2030-03-31" {
+		t.Errorf("target date = %v", created.TargetDate)
+	}
+	if _, ok := env.lastVariables("CreateProject")["input"].(map[string]interface{})["icon"]; ok {
+		t.Error("create sent a cleared icon")
+	}
+
+	env.run("config", "set", "team", "OPS")
+	out := env.run("project", "new", "--name", "Status page", "--plaintext")
+	assertContains(t, out, "Created project Status page")
+}
+
+func TestProjectCreateErrors(t *testing.T) {
+	env := newWorkspace(t)
+
+	tests := []struct {
+		name string
+		code int
+		args []string
+		want string
+	}{
+		{"missing name", exitError, []string{"--team", "ENG"}, `required flag(s) "name" not set`},
+		{"missing team", exitError, []string{"--name", "Nowhere"}, "Team is required"},
+		{"unknown team", exitNotFound, []string{"--name", "Lost", "--team", "NOPE"}, "failed to find team 'NOPE'"},
+		{"invalid state", exitError, []string{"--name", "Odd", "--team", "ENG", "--state", "done"}, "invalid state: done"},
+		{"unknown lead", exitNotFound, []string{"--name", "Led", "--team", "ENG", "--lead", "carol@example.com"}, "failed to resolve lead"},
+		{"invalid date", exitError, []string{"--name", "Late", "--team", "ENG", "--target-date", "soon"}, "targetDate must be a date"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := env.expectExit(tt.code, append([]string{"project", "create"}, tt.args...)...)
+			assertContains(t, out, tt.want)
+		})
+	}
+}
+
+func TestProjectUpdate(t *testing.T) {
+	env := newWorkspace(t)
+	id := env.projectID("Website")
+
+	out := env.run("project", "update", id, "--state", "paused", "--target-date", "2030-12-31", "--name", "Website v2", "--plaintext")
+	assertContains(t, out, "Updated project Website v2")
+	project, _ := env.fake.Project(id)
+	if project.State != "paused" || project.TargetDate == nil || *project.TargetDate != "2030-12-31" {
+		t.Errorf("updated state %q, target %v", project.State, project.TargetDate)
+	}
+
+	env.run("project", "edit", id, "--target-date", "none", "--lead", "none")
+	project, _ = env.fake.Project(id)
+	if project.TargetDate != nil || project.Lead != nil {
+		t.Errorf("cleared target %v, lead %+v", project.TargetDate, project.Lead)
+	}
+
+	out = env.expectExit(exitError, "project", "update", id)
+	assertContains(t, out, "No updates specified")
+	env.expectExit(exitNotFound, "project", "update", "no-such-project", "--name", "X")
+}
+
+func TestProjectArchiveAndUnarchive(t *testing.T) {
+	env := newWorkspace(t)
+	id := env.projectID("Website")
+
+	out := env.run("project", "archive", id, "--plaintext")
+	assertContains(t, out, "Archived "+id)
+	out = env.run("project", "list", "--plaintext")
+	assertContains(t, out, "Total: 0 projects")
+
+	out = env.run("project", "unarchive", id, "--plaintext")
+	assertContains(t, out, "Unarchived "+id)
+	if project, _ := env.fake.Project(id); project.ArchivedAt != nil {
+		t.Error("project is still archived")
+	}
+
+	env.expectExit(exitNotFound, "project", "archive", "no-such-project")
+}
+
+func TestProjectAddAndRemoveIssue(t *testing.T) {
+	env := newWorkspace(t)
+	id := env.projectID("Website")
+
+	out := env.run("project", "add-issue", id, "ENG-2", "OPS-1", "--plaintext")
+	assertContains(t, out, "Added to Website: ENG-2", "Added to Website: OPS-1")
+	for _, ref := range []string{"ENG-2", "OPS-1"} {
+		if issue := env.issue(ref); issue.Project == nil || issue.Project.ID != id {
+			t.Errorf("%s project = %+v, want Website", ref, issue.Project)
+		}
+	}
+
+	out = env.run("project", "remove-issue", id, "ENG-1", "--plaintext")
+	assertContains(t, out, "Removed from Website: ENG-1")
+	if issue := env.issue("ENG-1"); issue.Project != nil {
+		t.Errorf("ENG-1 project = %+v, want none", issue.Project)
+	}
+
+	var results []batchResult
+	stdout, _, code := env.runExit("project", "remove-issue", id, "ENG-3", "ENG-2", "--json")
+	if code != exitError {
+		t.Errorf("exit code = %d, want %d", code, exitError)
+	}
+	decodeJSON(t, stdout, &results)
+	want := []batchResult{
+		{Identifier: "ENG-3", Error: "issue is not in project Website"},
+		{Identifier: "ENG-2", Success: true},
+	}
+	if !reflect.DeepEqual(results, want) {
+		t.Errorf("remove-issue results = %+v, want %+v", results, want)
+	}
+
+	env.expectExit(exitNotFound, "project", "add-issue", "no-such-project", "ENG-1")
+}
+
+func TestProjectUpdateList(t *testing.T) {
+	env := newWorkspace(t)
+	id := env.projectID("Website")
+	created := time.Date(2030, 1, 2, 15, 4, 5, 0, time.UTC)
+	env.fake.Respond("ProjectUpdates", map[string]interface{}{
+		"project": map[string]interface{}{
+			"id": id,
+			"projectUpdates": api.ProjectUpdates{Nodes: []api.ProjectUpdate{
+				{ID: "update-2", Body: "Beta shipped", Health: api.HealthOnTrack, CreatedAt: created, User: &api.User{Name: "Alice Smith"}},
+				{ID: "update-1", Body: "Slipping", Health: api.HealthAtRisk, CreatedAt: created.Add(-24 * time.Hour)},
+			}},
+		},
+	})
+
+	var updates []api.ProjectUpdate
+	env.runJSON(&updates, "project", "update", "list", id)
+	if len(updates) != 2 || updates[0].ID != "update-2" {
+		t.Errorf("project update list = %+v", updates)
+	}
+	if got := env.lastVariables("ProjectUpdates")["id"]; got != id {
+		t.Errorf("project id = %v, want %s", got, id)
+	}
+
+	out := env.run("project", "update", "ls", id, "--plaintext")
+	assertContains(t, out, "Author: Alice Smith\nDate: 2030-01-02 15:04:05\nHealth: onTrack\nUpdate:\nBeta shipped", "---", "Health: atRisk")
+
+	env.fake.Respond("ProjectUpdates", `{"project": null}`)
+	env.expectExit(exitNotFound, "project", "update", "list", "no-such-project")
+}
+
+func TestProjectUpdatePost(t *testing.T) {
+	env := newWorkspace(t)
+	id := env.projectID("Website")
+	env.fake.Handle("CreateProjectUpdate", func(variables map[string]interface{}) (interface{}, error) {
+		input := variables["input"].(map[string]interface{})
+		health, _ := input["health"].(string)
+		return map[string]interface{}{
+			"projectUpdateCreate": map[string]interface{}{
+				"success": true,
+				"projectUpdate": api.ProjectUpdate{
+					ID:     "update-1",
+					Body:   input["body"].(string),
+					Health: health,
+					URL:    "https://linear.app/linearfake/project/website/updates#update-1",
+				},
+			},
+		}, nil
+	})
+
+	var update api.ProjectUpdate
+	env.runJSON(&update, "project", "update", "post", id, "--health", "at-risk", "--body", "  Slipping a week  ")
+	if update.Body != "Slipping a week" || update.Health != api.HealthAtRisk {
+		t.Errorf("posted %q with health %q", update.Body, update.Health)
+	}
+	if got := env.lastVariables("CreateProjectUpdate")["input"].(map[string]interface{})["projectId"]; got != id {
+		t.Errorf("projectId = %v, want %s", got, id)
+	}
+
+	body := filepath.Join(t.TempDir(), "update.md")
+	if err := os.WriteFile(body, []byte("From a file\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	out := env.run("project", "update", "post", id, "--body-file", body, "--plaintext")
+	assertContains(t, out, "Posted project update update-1", "URL: https://linear.app/linearfake/project/website/updates#update-1")
+	if _, ok := env.lastVariables("CreateProjectUpdate")["input"].(map[string]interface{})["health"]; ok {
+		t.Error("health was sent without --health")
+	}
+
+	env.runWithInput("Piped status\n", "project", "update", "new", id)
+	if got := env.lastVariables("CreateProjectUpdate")["input"].(map[string]interface{})["body"]; got != "Piped status" {
+		t.Errorf("body = %v, want the piped status", got)
+	}
+
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{"body and file", []string{"--body", "x", "--body-file", body}, "Use either --body or --body-file, not both"},
+		{"no body", nil, "Update body is required"},
+		{"invalid health", []string{"--body", "x", "--health", "great"}, "invalid health: great"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := env.expectExit(exitError, append([]string{"project", "update", "post", id}, tt.args...)...)
+			assertContains(t, out, tt.want)
+		})
+	}
+}
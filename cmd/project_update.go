@@ -0,0 +1,231 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/yjiky/linctl/pkg/api"
+	"github.com/yjiky/linctl/pkg/auth"
+	"github.com/yjiky/linctl/pkg/output"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// parseProjectHealth normalises a --health value to Linear's enum
+func parseProjectHealth(value string) (string, error) {
+	normalized := strings.ToLower(strings.NewReplacer("-", "", "_", "", " ", "").Replace(value))
+	switch normalized {
+	case "ontrack":
+		return api.HealthOnTrack, nil
+	case "atrisk":
+		return api.HealthAtRisk, nil
+	case "offtrack":
+		return api.HealthOffTrack, nil
+	default:
+		return "", fmt.Errorf("invalid health: %s. Valid values are: onTrack, atRisk, offTrack", value)
+	}
+}
+
+// healthColor picks a display color for a project health value
+func healthColor(health string) *color.Color {
+	switch health {
+	case api.HealthOnTrack:
+		return color.New(color.FgGreen)
+	case api.HealthAtRisk:
+		return color.New(color.FgYellow)
+	case api.HealthOffTrack:
+		return color.New(color.FgRed)
+	default:
+		return color.New(color.FgWhite)
+	}
+}
+
+var projectUpdateListCmd = &cobra.Command{
+	Use:     "list PROJECT-ID",
+	Aliases: []string{"ls"},
+	Short:   "List a project's status updates",
+	Long: `List a project's status updates, newest first.
+
+Examples:
+  linctl project update list PROJECT-ID
+  linctl project update list PROJECT-ID --all --json`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+		projectID := args[0]
+
+		client, err := auth.NewClient()
+		if err != nil {
+			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		limit := resolveListLimit(cmd)
+
+		paginator := api.NewPaginator(client.ProjectUpdatePages(projectID), limit)
+		updates, err := paginator.All(context.Background())
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to list project updates: %v", err), plaintext, jsonOut)
+			os.Exit(exitCodeFor(err))
+		}
+
+		if jsonOut {
+			output.JSON(updates)
+			return
+		}
+
+		if len(updates) == 0 {
+			output.Info("No project updates found", plaintext, jsonOut)
+			return
+		}
+
+		if plaintext {
+			for i, update := range updates {
+				if i > 0 {
+					fmt.Println("---")
+				}
+				author := ""
+				if update.User != nil {
+					author = update.User.Name
+				}
+				fmt.Printf("Author: %s\n", author)
+				fmt.Printf("Date: %s\n", update.CreatedAt.Format("2006-01-02 15:04:05"))
+				fmt.Printf("Health: %s\n", update.Health)
+				fmt.Printf("Update:\n%s\n", update.Body)
+			}
+			return
+		}
+
+		fmt.Printf("\n%s Project updates (%d)\n\n",
+			color.New(color.FgCyan, color.Bold).Sprint("📣"),
+			len(updates))
+
+		for i, update := range updates {
+			if i > 0 {
+				fmt.Println(strings.Repeat("─", 50))
+			}
+
+			author := "Unknown"
+			if update.User != nil {
+				author = update.User.Name
+			}
+			fmt.Printf("%s %s %s %s %s\n",
+				healthColor(update.Health).Sprint("●"),
+				color.New(color.FgCyan, color.Bold).Sprint(author),
+				color.New(color.FgWhite, color.Faint).Sprint("•"),
+				color.New(color.FgWhite, color.Faint).Sprint(formatTimeAgo(update.CreatedAt)),
+				healthColor(update.Health).Sprint(update.Health))
+
+			fmt.Printf("\n%s\n\n", update.Body)
+		}
+
+		if paginator.PageInfo().HasNextPage {
+			fmt.Printf("%s Use --limit or --all to see more results\n",
+				color.New(color.FgYellow).Sprint("ℹ️"))
+		}
+	},
+}
+
+var projectUpdatePostCmd = &cobra.Command{
+	Use:     "post PROJECT-ID",
+	Aliases: []string{"create", "new"},
+	Short:   "Post a project status update",
+	Long: `Post a status update to a project.
+
+The body comes from --body, --body-file (use - for stdin), or stdin when piped.
+
+Examples:
+  linctl project update post PROJECT-ID --health onTrack --body "Shipped the beta"
+  linctl project update post PROJECT-ID --health atRisk --body-file update.md
+  render-status-template | linctl project update post PROJECT-ID --health offTrack`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+		projectID := args[0]
+
+		body, _ := cmd.Flags().GetString("body")
+		bodyFile, _ := cmd.Flags().GetString("body-file")
+
+		switch {
+		case body != "" && bodyFile != "":
+			output.Error("Use either --body or --body-file, not both", plaintext, jsonOut)
+			os.Exit(1)
+		case bodyFile != "":
+			content, err := readTextFile(bodyFile)
+			if err != nil {
+				output.Error(err.Error(), plaintext, jsonOut)
+				os.Exit(1)
+			}
+			body = content
+		case body == "" && !isTerminal(os.Stdin):
+			content, err := readTextFile("-")
+			if err != nil {
+				output.Error(err.Error(), plaintext, jsonOut)
+				os.Exit(1)
+			}
+			body = content
+		}
+
+		body = strings.TrimSpace(body)
+		if body == "" {
+			output.Error("Update body is required (--body, --body-file, or stdin)", plaintext, jsonOut)
+			os.Exit(1)
+		}
+
+		health := ""
+		if cmd.Flags().Changed("health") {
+			value, _ := cmd.Flags().GetString("health")
+			parsed, err := parseProjectHealth(value)
+			if err != nil {
+				output.Error(err.Error(), plaintext, jsonOut)
+				os.Exit(1)
+			}
+			health = parsed
+		}
+
+		client, err := auth.NewClient()
+		if err != nil {
+			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		update, err := client.CreateProjectUpdate(context.Background(), projectID, body, health)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to post project update: %v", err), plaintext, jsonOut)
+			os.Exit(exitCodeFor(err))
+		}
+
+		if jsonOut {
+			output.JSON(update)
+		} else if plaintext {
+			fmt.Printf("Posted project update %s\n", update.ID)
+			if update.URL != "" {
+				fmt.Printf("URL: %s\n", update.URL)
+			}
+		} else {
+			fmt.Printf("%s Posted project update %s\n",
+				color.New(color.FgGreen).Sprint("✓"),
+				healthColor(update.Health).Sprint(update.Health))
+			if update.URL != "" {
+				fmt.Printf("  URL: %s\n", color.New(color.FgBlue, color.Underline).Sprint(update.URL))
+			}
+		}
+	},
+}
+
+func init() {
+	projectUpdateCmd.AddCommand(projectUpdateListCmd)
+	projectUpdateCmd.AddCommand(projectUpdatePostCmd)
+
+	projectUpdateListCmd.Flags().IntP("limit", "l", 50, "Maximum number of updates to fetch (pages automatically)")
+	projectUpdateListCmd.Flags().Bool("all", false, "Fetch every update, ignoring --limit")
+
+	projectUpdatePostCmd.Flags().String("health", "", "Project health: onTrack, atRisk, or offTrack")
+	projectUpdatePostCmd.Flags().StringP("body", "b", "", "Update body (markdown)")
+	projectUpdatePostCmd.Flags().String("body-file", "", "Read the update body from a file, or - for stdin")
+}
@@ -0,0 +1,500 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/yjiky/linctl/pkg/api"
+	"github.com/yjiky/linctl/pkg/auth"
+	"github.com/yjiky/linctl/pkg/output"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// issueRelationEntry is one relation as seen from a single issue
+type issueRelationEntry struct {
+	ID       string     `json:"id"`
+	Relation string     `json:"relation"`
+	Issue    *api.Issue `json:"issue"`
+}
+
+// relateResult records the outcome of relating an issue to one target. The
+// created relation's fields are inlined, so successful entries read as the
+// relation itself.
+type relateResult struct {
+	*api.IssueRelation
+	Relation string `json:"relation"`
+	Target   string `json:"target"`
+	Success  bool   `json:"success"`
+	Error    string `json:"error,omitempty"`
+}
+
+// relationTreeNode is one issue in a transitive blocking chain
+type relationTreeNode struct {
+	Identifier string              `json:"identifier"`
+	Title      string              `json:"title"`
+	State      string              `json:"state,omitempty"`
+	Repeated   bool                `json:"repeated,omitempty"`
+	Children   []*relationTreeNode `json:"children,omitempty"`
+}
+
+// collectIssueRelations flattens outgoing and inverse relations into one list,
+// naming each relation from the point of view of the given issue
+func collectIssueRelations(issue *api.Issue) []issueRelationEntry {
+	entries := []issueRelationEntry{}
+
+	if issue.Relations != nil {
+		for _, r := range issue.Relations.Nodes {
+			if r.RelatedIssue == nil {
+				continue
+			}
+			relation := r.Type
+			switch r.Type {
+			case api.RelationDuplicate:
+				relation = "duplicate-of"
+			}
+			entries = append(entries, issueRelationEntry{ID: r.ID, Relation: relation, Issue: r.RelatedIssue})
+		}
+	}
+
+	if issue.InverseRelations != nil {
+		for _, r := range issue.InverseRelations.Nodes {
+			if r.Issue == nil {
+				continue
+			}
+			relation := r.Type
+			switch r.Type {
+			case api.RelationBlocks:
+				relation = "blocked-by"
+			case api.RelationDuplicate:
+				relation = "duplicated-by"
+			}
+			entries = append(entries, issueRelationEntry{ID: r.ID, Relation: relation, Issue: r.Issue})
+		}
+	}
+
+	return entries
+}
+
+// relationLabel turns a relation name into a display label
+func relationLabel(relation string) string {
+	switch relation {
+	case "blocks":
+		return "Blocks"
+	case "blocked-by":
+		return "Blocked by"
+	case "related":
+		return "Related to"
+	case "similar":
+		return "Similar to"
+	case "duplicate-of":
+		return "Duplicate of"
+	case "duplicated-by":
+		return "Duplicated by"
+	default:
+		return relation
+	}
+}
+
+// buildBlockingTree walks blocking relations transitively from the given issue.
+// With upstream set it follows blocked-by, otherwise blocks. Issues already
+// expanded elsewhere in the tree are marked as repeated instead of expanded again.
+func buildBlockingTree(ctx context.Context, client *api.Client, root *api.Issue, upstream bool, cache map[string]*api.Issue, expanded map[string]bool) ([]*relationTreeNode, error) {
+	wanted := "blocks"
+	if upstream {
+		wanted = "blocked-by"
+	}
+
+	nodes := []*relationTreeNode{}
+	for _, entry := range collectIssueRelations(root) {
+		if entry.Relation != wanted {
+			continue
+		}
+
+		node := &relationTreeNode{
+			Identifier: entry.Issue.Identifier,
+			Title:      entry.Issue.Title,
+		}
+		if entry.Issue.State != nil {
+			node.State = entry.Issue.State.Name
+		}
+		nodes = append(nodes, node)
+
+		if expanded[entry.Issue.Identifier] {
+			node.Repeated = true
+			continue
+		}
+		expanded[entry.Issue.Identifier] = true
+
+		next, ok := cache[entry.Issue.Identifier]
+		if !ok {
+			var err error
+			next, err = client.GetIssueRelations(ctx, entry.Issue.Identifier)
+			if err != nil {
+				return nil, err
+			}
+			cache[entry.Issue.Identifier] = next
+		}
+
+		children, err := buildBlockingTree(ctx, client, next, upstream, cache, expanded)
+		if err != nil {
+			return nil, err
+		}
+		node.Children = children
+	}
+
+	return nodes, nil
+}
+
+// printRelationTree prints a blocking tree with box-drawing branches
+func printRelationTree(nodes []*relationTreeNode, prefix string, plaintext bool) {
+	for i, node := range nodes {
+		last := i == len(nodes)-1
+
+		if plaintext {
+			fmt.Printf("%s- %s: %s", prefix, node.Identifier, node.Title)
+			if node.State != "" {
+				fmt.Printf(" [%s]", node.State)
+			}
+			if node.Repeated {
+				fmt.Print(" (see above)")
+			}
+			fmt.Println()
+			printRelationTree(node.Children, prefix+"  ", plaintext)
+			continue
+		}
+
+		branch, childPrefix := "├── ", "│   "
+		if last {
+			branch, childPrefix = "└── ", "    "
+		}
+
+		line := fmt.Sprintf("%s %s", color.New(color.FgCyan).Sprint(node.Identifier), node.Title)
+		if node.State != "" {
+			line += " " + color.New(color.FgWhite, color.Faint).Sprintf("[%s]", node.State)
+		}
+		if node.Repeated {
+			line += " " + color.New(color.FgYellow).Sprint("(see above)")
+		}
+		fmt.Printf("%s%s%s\n", prefix, branch, line)
+		printRelationTree(node.Children, prefix+childPrefix, plaintext)
+	}
+}
+
+var issueRelateCmd = &cobra.Command{
+	Use:   "relate ISSUE-ID",
+	Short: "Create relations between issues",
+	Long: `Create blocking, related, or duplicate relations from an issue to other issues.
+Every relation is attempted; the command exits non-zero if any of them failed.
+
+Examples:
+  linctl issue relate LIN-1 --blocks LIN-2
+  linctl issue relate LIN-1 --blocked-by LIN-3 --blocked-by LIN-4
+  linctl issue relate LIN-1 --related LIN-5
+  linctl issue relate LIN-1 --duplicate-of LIN-6`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		blocks, _ := cmd.Flags().GetStringSlice("blocks")
+		blockedBy, _ := cmd.Flags().GetStringSlice("blocked-by")
+		related, _ := cmd.Flags().GetStringSlice("related")
+		duplicateOf, _ := cmd.Flags().GetStringSlice("duplicate-of")
+
+		if len(blocks)+len(blockedBy)+len(related)+len(duplicateOf) == 0 {
+			output.Error("No relations specified. Use --blocks, --blocked-by, --related, or --duplicate-of.", plaintext, jsonOut)
+			os.Exit(1)
+		}
+
+		client, err := auth.NewClient()
+		if err != nil {
+			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		ctx := context.Background()
+
+		source, err := client.GetIssue(ctx, args[0])
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to get issue: %v", err), plaintext, jsonOut)
+			os.Exit(exitCodeFor(err))
+		}
+
+		type pendingRelation struct {
+			relation string
+			target   string
+		}
+		pending := []pendingRelation{}
+		for _, ref := range blocks {
+			pending = append(pending, pendingRelation{"blocks", ref})
+		}
+		for _, ref := range blockedBy {
+			pending = append(pending, pendingRelation{"blocked-by", ref})
+		}
+		for _, ref := range related {
+			pending = append(pending, pendingRelation{"related", ref})
+		}
+		for _, ref := range duplicateOf {
+			pending = append(pending, pendingRelation{"duplicate-of", ref})
+		}
+
+		// Every target is tried, like the batch commands do, so one bad
+		// reference does not leave the rest unrelated
+		results := make([]relateResult, 0, len(pending))
+		var lastErr error
+		for _, p := range pending {
+			result := relateResult{Relation: p.relation, Target: strings.TrimSpace(p.target)}
+
+			target, err := client.GetIssue(ctx, result.Target)
+			if err != nil {
+				result.Error = err.Error()
+				lastErr = err
+				results = append(results, result)
+				continue
+			}
+			result.Target = target.Identifier
+
+			issueID, relatedID, relationType := source.ID, target.ID, p.relation
+			switch p.relation {
+			case "blocked-by":
+				issueID, relatedID, relationType = target.ID, source.ID, api.RelationBlocks
+			case "duplicate-of":
+				relationType = api.RelationDuplicate
+			}
+
+			relation, err := client.CreateIssueRelation(ctx, issueID, relatedID, relationType)
+			if err != nil {
+				result.Error = err.Error()
+				lastErr = err
+				results = append(results, result)
+				continue
+			}
+			result.IssueRelation = relation
+			result.Success = true
+			results = append(results, result)
+		}
+
+		if jsonOut {
+			output.JSON(results)
+		} else {
+			for _, result := range results {
+				if !result.Success {
+					output.Error(fmt.Sprintf("%s: %s", result.Target, result.Error), plaintext, jsonOut)
+					continue
+				}
+				msg := fmt.Sprintf("%s %s %s", source.Identifier, strings.ToLower(relationLabel(result.Relation)), result.Target)
+				if plaintext {
+					fmt.Println(msg)
+				} else {
+					fmt.Printf("%s %s\n", color.New(color.FgGreen).Sprint("✓"), msg)
+				}
+			}
+		}
+
+		if lastErr != nil {
+			os.Exit(exitCodeFor(lastErr))
+		}
+	},
+}
+
+var issueUnrelateCmd = &cobra.Command{
+	Use:   "unrelate ISSUE-ID OTHER-ID...",
+	Short: "Remove relations between issues",
+	Long: `Remove every relation between an issue and the given issues, in either direction.
+
+Examples:
+  linctl issue unrelate LIN-1 LIN-2
+  linctl issue unrelate LIN-1 LIN-2 LIN-3`,
+	Args: cobra.MinimumNArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		client, err := auth.NewClient()
+		if err != nil {
+			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		ctx := context.Background()
+
+		source, err := client.GetIssueRelations(ctx, args[0])
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to get issue: %v", err), plaintext, jsonOut)
+			os.Exit(exitCodeFor(err))
+		}
+
+		removed := []issueRelationEntry{}
+		for _, ref := range args[1:] {
+			matched := false
+			for _, entry := range collectIssueRelations(source) {
+				if !strings.EqualFold(entry.Issue.Identifier, ref) && entry.Issue.ID != ref {
+					continue
+				}
+				matched = true
+
+				if err := client.DeleteIssueRelation(ctx, entry.ID); err != nil {
+					output.Error(fmt.Sprintf("Failed to remove relation to %s: %v", entry.Issue.Identifier, err), plaintext, jsonOut)
+					os.Exit(exitCodeFor(err))
+				}
+				removed = append(removed, entry)
+
+				if !jsonOut {
+					msg := fmt.Sprintf("Removed: %s %s %s", source.Identifier, strings.ToLower(relationLabel(entry.Relation)), entry.Issue.Identifier)
+					if plaintext {
+						fmt.Println(msg)
+					} else {
+						fmt.Printf("%s %s\n", color.New(color.FgGreen).Sprint("✓"), msg)
+					}
+				}
+			}
+
+			if !matched && !jsonOut {
+				output.Info(fmt.Sprintf("No relations between %s and %s", source.Identifier, ref), plaintext, jsonOut)
+			}
+		}
+
+		if jsonOut {
+			output.JSON(removed)
+		}
+	},
+}
+
+var issueRelationsCmd = &cobra.Command{
+	Use:   "relations ISSUE-ID",
+	Short: "List an issue's relations",
+	Long: `List the issues an issue blocks, is blocked by, relates to, or duplicates.
+
+Use --tree to follow blocking relations transitively in both directions, which
+shows the full chain of work an issue is waiting on and holding up.
+
+Examples:
+  linctl issue relations LIN-1
+  linctl issue relations LIN-1 --tree
+  linctl issue relations LIN-1 --tree --json`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		client, err := auth.NewClient()
+		if err != nil {
+			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		ctx := context.Background()
+
+		issue, err := client.GetIssueRelations(ctx, args[0])
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to get issue relations: %v", err), plaintext, jsonOut)
+			os.Exit(exitCodeFor(err))
+		}
+
+		tree, _ := cmd.Flags().GetBool("tree")
+		if tree {
+			cache := map[string]*api.Issue{issue.Identifier: issue}
+
+			blocks, err := buildBlockingTree(ctx, client, issue, false, cache, map[string]bool{issue.Identifier: true})
+			if err != nil {
+				output.Error(fmt.Sprintf("Failed to walk blocking chain: %v", err), plaintext, jsonOut)
+				os.Exit(exitCodeFor(err))
+			}
+			blockedBy, err := buildBlockingTree(ctx, client, issue, true, cache, map[string]bool{issue.Identifier: true})
+			if err != nil {
+				output.Error(fmt.Sprintf("Failed to walk blocking chain: %v", err), plaintext, jsonOut)
+				os.Exit(exitCodeFor(err))
+			}
+
+			if jsonOut {
+				output.JSON(map[string]interface{}{
+					"identifier": issue.Identifier,
+					"title":      issue.Title,
+					"blocks":     blocks,
+					"blockedBy":  blockedBy,
+				})
+				return
+			}
+
+			if plaintext {
+				fmt.Printf("# %s - %s\n", issue.Identifier, issue.Title)
+				fmt.Printf("\n## Blocks\n")
+				printRelationTree(blocks, "", true)
+				fmt.Printf("\n## Blocked by\n")
+				printRelationTree(blockedBy, "", true)
+				return
+			}
+
+			fmt.Printf("%s %s\n",
+				color.New(color.FgCyan, color.Bold).Sprint(issue.Identifier),
+				color.New(color.FgWhite, color.Bold).Sprint(issue.Title))
+			fmt.Printf("\n%s\n", color.New(color.FgYellow).Sprint("Blocks:"))
+			if len(blocks) == 0 {
+				fmt.Println("  (nothing)")
+			}
+			printRelationTree(blocks, "", false)
+			fmt.Printf("\n%s\n", color.New(color.FgYellow).Sprint("Blocked by:"))
+			if len(blockedBy) == 0 {
+				fmt.Println("  (nothing)")
+			}
+			printRelationTree(blockedBy, "", false)
+			return
+		}
+
+		entries := collectIssueRelations(issue)
+
+		if jsonOut {
+			output.JSON(entries)
+			return
+		}
+
+		if len(entries) == 0 {
+			output.Info(fmt.Sprintf("%s has no relations", issue.Identifier), plaintext, jsonOut)
+			return
+		}
+
+		rows := make([][]string, 0, len(entries))
+		for _, entry := range entries {
+			state := ""
+			if entry.Issue.State != nil {
+				state = entry.Issue.State.Name
+			}
+			identifier := entry.Issue.Identifier
+			title := entry.Issue.Title
+			if !plaintext {
+				identifier = color.New(color.FgCyan).Sprint(identifier)
+				title = truncateString(title, 50)
+			}
+			rows = append(rows, []string{relationLabel(entry.Relation), identifier, title, state})
+		}
+
+		if plaintext {
+			fmt.Printf("# Relations for %s\n", issue.Identifier)
+		}
+		output.Table(output.TableData{
+			Headers: []string{"Relation", "Issue", "Title", "State"},
+			Rows:    rows,
+		}, plaintext, jsonOut)
+
+		if !plaintext {
+			fmt.Printf("\n%s %d relations\n", color.New(color.FgGreen).Sprint("✓"), len(entries))
+		}
+	},
+}
+
+func init() {
+	issueCmd.AddCommand(issueRelateCmd)
+	issueCmd.AddCommand(issueUnrelateCmd)
+	issueCmd.AddCommand(issueRelationsCmd)
+
+	issueRelateCmd.Flags().StringSlice("blocks", nil, "Issue(s) this issue blocks")
+	issueRelateCmd.Flags().StringSlice("blocked-by", nil, "Issue(s) blocking this issue")
+	issueRelateCmd.Flags().StringSlice("related", nil, "Issue(s) related to this issue")
+	issueRelateCmd.Flags().StringSlice("duplicate-of", nil, "Issue this issue duplicates")
+
+	issueRelationsCmd.Flags().Bool("tree", false, "Walk blocking chains transitively in both directions")
+}
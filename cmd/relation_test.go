@@ -0,0 +1,164 @@
+package cmd
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/yjiky/linctl/pkg/api"
+)
+
+// relationsOf returns the relations listed for an issue as "relation issue"
+// pairs
+func relationsOf(env *testEnv, ref string) []string {
+	var entries []issueRelationEntry
+	env.runJSON(&entries, "issue", "relations", ref)
+	pairs := []string{}
+	for _, entry := range entries {
+		pairs = append(pairs, entry.Relation+" "+entry.Issue.Identifier)
+	}
+	return pairs
+}
+
+func TestIssueRelate(t *testing.T) {
+	env := newWorkspace(t)
+
+	out := env.run("issue", "relate", "ENG-1", "--blocks", "ENG-2", "--blocked-by", "OPS-1", "--plaintext")
+	assertContains(t, out, "ENG-1 blocks ENG-2", "ENG-1 blocked by OPS-1")
+	if _, ok := env.fake.LastRequest("IssueRelations"); ok {
+		t.Error("relate fetched relations just to look up issues")
+	}
+
+	variables := env.lastVariables("CreateIssueRelation")["input"].(map[string]interface{})
+	if variables["issueId"] != env.issue("OPS-1").ID || variables["relatedIssueId"] != env.issue("ENG-1").ID || variables["type"] != api.RelationBlocks {
+		t.Errorf("--blocked-by created relation %v, want OPS-1 blocks ENG-1", variables)
+	}
+
+	var created []api.IssueRelation
+	env.runJSON(&created, "issue", "relate", "ENG-3", "--duplicate-of", "ENG-1", "--related", "ENG-2")
+	if len(created) != 2 || created[0].Type != api.RelationRelated || created[1].Type != api.RelationDuplicate {
+		t.Errorf("created relations = %+v", created)
+	}
+
+	want := []string{"blocks ENG-2", "blocked-by OPS-1", "duplicated-by ENG-3"}
+	if got := relationsOf(env, "ENG-1"); !reflect.DeepEqual(got, want) {
+		t.Errorf("ENG-1 relations = %v, want %v", got, want)
+	}
+
+	out = env.expectExit(exitError, "issue", "relate", "ENG-1")
+	assertContains(t, out, "No relations specified")
+
+	// A bad target is reported without stopping the others
+	stdout, stderr, code := env.runExit("issue", "relate", "ENG-2", "--blocks", "ENG-404", "--related", "OPS-1", "--json")
+	if code != exitNotFound {
+		t.Errorf("exit code = %d, want %d", code, exitNotFound)
+	}
+	var results []relateResult
+	decodeJSON(t, stdout, &results)
+	if len(results) != 2 || results[0].Success || results[0].Target != "ENG-404" || !results[1].Success || results[1].IssueRelation == nil || results[1].Type != api.RelationRelated {
+		t.Errorf("relate results = %+v\n%s", results, stderr)
+	}
+	if got := relationsOf(env, "OPS-1"); !reflect.DeepEqual(got, []string{"blocks ENG-1", "related ENG-2"}) {
+		t.Errorf("OPS-1 relations = %v, want the relation to ENG-2 created", got)
+	}
+
+	out = env.expectExit(exitNotFound, "issue", "relate", "ENG-1", "--blocks", "ENG-404", "--plaintext")
+	assertContains(t, out, "ENG-404: Entity not found: Issue")
+}
+
+func TestIssueRelations(t *testing.T) {
+	env := newWorkspace(t)
+	env.fake.AddRelation("ENG-1", "ENG-2", api.RelationBlocks)
+	env.fake.AddRelation("ENG-2", "ENG-3", api.RelationBlocks)
+	env.fake.AddRelation("OPS-1", "ENG-1", api.RelationBlocks)
+	env.fake.AddRelation("ENG-3", "ENG-1", api.RelationRelated)
+
+	out := env.run("issue", "relations", "ENG-1", "--plaintext")
+	assertContains(t, out,
+		"# Relations for ENG-1",
+		"Blocks\tENG-2\tAdd dark mode\tIn Progress",
+		"Blocked by\tOPS-1\tRotate credentials",
+		"Related to\tENG-3\tUpdate dependencies\tDone",
+	)
+
+	var tree struct {
+		Blocks    []*relationTreeNode `json:"blocks"`
+		BlockedBy []*relationTreeNode `json:"blockedBy"`
+	}
+	env.runJSON(&tree, "issue", "relations", "ENG-1", "--tree")
+	if len(tree.Blocks) != 1 || tree.Blocks[0].Identifier != "ENG-2" || len(tree.Blocks[0].Children) != 1 || tree.Blocks[0].Children[0].Identifier != "ENG-3" {
+		t.Errorf("blocks tree = %+v, want ENG-2 then ENG-3", tree.Blocks)
+	}
+	if len(tree.BlockedBy) != 1 || tree.BlockedBy[0].Identifier != "OPS-1" {
+		t.Errorf("blocked-by tree = %+v, want OPS-1", tree.BlockedBy)
+	}
+
+	out = env.run("issue", "relations", "ENG-3", "--tree", "--plaintext")
+	assertContains(t, out, "# ENG-3 - Update dependencies", "## Blocked by\n- ENG-2: Add dark mode [In Progress]\n  - ENG-1: Fix login redirect [Todo]\n    - OPS-1: Rotate credentials")
+
+	out = env.run("issue", "relations", "OPS-1", "--tree")
+	assertContains(t, out, "└── ", "ENG-3")
+
+	env.expectExit(exitNotFound, "issue", "relations", "ENG-404")
+}
+
+func TestIssueRelationsRepeated(t *testing.T) {
+	env := newWorkspace(t)
+	env.fake.AddRelation("ENG-1", "ENG-2", api.RelationBlocks)
+	env.fake.AddRelation("ENG-1", "ENG-3", api.RelationBlocks)
+	env.fake.AddRelation("ENG-2", "ENG-3", api.RelationBlocks)
+
+	out := env.run("issue", "relations", "ENG-1", "--tree", "--plaintext")
+	assertContains(t, out, "- ENG-2: Add dark mode [In Progress]\n  - ENG-3: Update dependencies [Done]\n- ENG-3: Update dependencies [Done] (see above)")
+}
+
+func TestIssueRelationsPaged(t *testing.T) {
+	env := newWorkspace(t)
+	const count = 105
+	var last string
+	for n := 0; n < count; n++ {
+		issue := env.fake.AddIssue(api.Issue{Title: fmt.Sprintf("Task %d", n), Team: &api.Team{Key: "OPS"}})
+		env.fake.AddRelation("ENG-1", issue.Identifier, api.RelationBlocks)
+		env.fake.AddRelation(issue.Identifier, "ENG-1", api.RelationBlocks)
+		last = issue.Identifier
+	}
+
+	if got := relationsOf(env, "ENG-1"); len(got) != 2*count {
+		t.Errorf("ENG-1 has %d relations, want %d", len(got), 2*count)
+	}
+	for _, op := range []string{"RelationPage", "InverseRelationPage"} {
+		if after, _ := env.lastVariables(op)["after"].(string); after == "" {
+			t.Errorf("%s was not requested after the first page", op)
+		}
+	}
+
+	// The relations to the last issue are past the first page in both directions
+	var removed []issueRelationEntry
+	env.runJSON(&removed, "issue", "unrelate", "ENG-1", last)
+	if len(removed) != 2 {
+		t.Errorf("unrelate removed %d relations with %s, want both directions", len(removed), last)
+	}
+}
+
+func TestIssueUnrelate(t *testing.T) {
+	env := newWorkspace(t)
+	env.fake.AddRelation("ENG-1", "ENG-2", api.RelationBlocks)
+	env.fake.AddRelation("ENG-2", "ENG-1", api.RelationRelated)
+	env.fake.AddRelation("ENG-1", "ENG-3", api.RelationRelated)
+
+	var removed []issueRelationEntry
+	env.runJSON(&removed, "issue", "unrelate", "ENG-1", "eng-2")
+	if len(removed) != 2 {
+		t.Errorf("unrelate removed %d relations, want both directions", len(removed))
+	}
+	if got := relationsOf(env, "ENG-1"); !reflect.DeepEqual(got, []string{"related ENG-3"}) {
+		t.Errorf("ENG-1 relations after unrelate = %v", got)
+	}
+
+	out := env.run("issue", "unrelate", "ENG-1", "ENG-3", "OPS-1", "--plaintext")
+	assertContains(t, out, "Removed: ENG-1 related to ENG-3", "No relations between ENG-1 and OPS-1")
+	out = env.run("issue", "relations", "ENG-2", "--plaintext")
+	assertContains(t, out, "ENG-2 has no relations")
+
+	env.expectExit(exitNotFound, "issue", "unrelate", "ENG-404", "ENG-1")
+}
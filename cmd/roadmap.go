@@ -0,0 +1,269 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/yjiky/linctl/pkg/api"
+	"github.com/yjiky/linctl/pkg/auth"
+	"github.com/yjiky/linctl/pkg/output"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// roadmapCmd represents the roadmap command
+var roadmapCmd = &cobra.Command{
+	Use:     "roadmap",
+	Aliases: []string{"roadmaps"},
+	Short:   "Manage Linear roadmaps",
+	Long: `Manage Linear roadmaps and roll up the progress of their projects.
+
+Roadmaps can be referenced by ID, slug, or name.
+
+Examples:
+  linctl roadmap list                         # List roadmaps with rollups
+  linctl roadmap get "2024 Platform"          # Show a roadmap's projects
+  linctl roadmap link "2024 Platform" PROJECT-ID
+  linctl roadmap unlink "2024 Platform" PROJECT-ID`,
+}
+
+// resolveRoadmap finds a roadmap by ID or slug, falling back to a
+// case-insensitive name match over the roadmaps' names
+func resolveRoadmap(ctx context.Context, client *api.Client, ref string) (*api.Roadmap, error) {
+	ref = strings.TrimSpace(ref)
+	roadmap, err := client.GetRoadmap(ctx, ref)
+	if err == nil || !api.IsNotFound(err) {
+		return roadmap, err
+	}
+
+	roadmaps, err := api.NewPaginator(client.RoadmapNamePages(), 0).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, roadmap := range roadmaps {
+		if strings.EqualFold(roadmap.Name, ref) {
+			return client.GetRoadmap(ctx, roadmap.ID)
+		}
+	}
+
+	return nil, &api.Error{Code: api.CodeEntityNotFound, Message: fmt.Sprintf("roadmap not found: %s", ref)}
+}
+
+var roadmapListCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "List roadmaps",
+	Long:    `List roadmaps with their owner and average project progress.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		client, err := auth.NewClient()
+		if err != nil {
+			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		limit := resolveListLimit(cmd)
+
+		paginator := api.NewPaginator(client.RoadmapPages(), limit)
+		roadmaps, err := paginator.All(context.Background())
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to list roadmaps: %v", err), plaintext, jsonOut)
+			os.Exit(exitCodeFor(err))
+		}
+
+		if jsonOut {
+			output.JSON(roadmaps)
+			return
+		}
+
+		if len(roadmaps) == 0 {
+			output.Info("No roadmaps found", plaintext, jsonOut)
+			return
+		}
+
+		if plaintext {
+			fmt.Println("Name\tOwner\tProjects\tProgress\tID")
+			for _, roadmap := range roadmaps {
+				owner := ""
+				if roadmap.Owner != nil {
+					owner = roadmap.Owner.Name
+				}
+				fmt.Printf("%s\t%s\t%d\t%.0f%%\t%s\n",
+					roadmap.Name,
+					owner,
+					projectCount(roadmap.Projects),
+					averageProgress(roadmap.Projects)*100,
+					roadmap.ID,
+				)
+			}
+			return
+		}
+
+		headers := []string{"Name", "Owner", "Projects", "Progress", "Updated"}
+		rows := [][]string{}
+		for _, roadmap := range roadmaps {
+			owner := color.New(color.FgYellow).Sprint("Unassigned")
+			if roadmap.Owner != nil {
+				owner = roadmap.Owner.Name
+			}
+			progress := averageProgress(roadmap.Projects)
+			rows = append(rows, []string{
+				color.New(color.FgCyan, color.Bold).Sprint(truncateString(roadmap.Name, 35)),
+				owner,
+				fmt.Sprintf("%d", projectCount(roadmap.Projects)),
+				progressColor(progress).Sprintf("%.0f%%", progress*100),
+				roadmap.UpdatedAt.Format("2006-01-02"),
+			})
+		}
+
+		output.Table(output.TableData{
+			Headers: headers,
+			Rows:    rows,
+		}, plaintext, jsonOut)
+
+		fmt.Printf("\n%s %d roadmaps\n",
+			color.New(color.FgGreen).Sprint("✓"),
+			len(roadmaps))
+
+		if paginator.PageInfo().HasNextPage {
+			fmt.Printf("%s Use --limit or --all to see more results\n",
+				color.New(color.FgYellow).Sprint("ℹ️"))
+		}
+	},
+}
+
+var roadmapGetCmd = &cobra.Command{
+	Use:     "get ROADMAP",
+	Aliases: []string{"show"},
+	Short:   "Show a roadmap and its projects",
+	Long:    `Show a roadmap with each of its projects' progress, health and target date.`,
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		client, err := auth.NewClient()
+		if err != nil {
+			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		ctx := context.Background()
+
+		roadmap, err := resolveRoadmap(ctx, client, args[0])
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to find roadmap: %v", err), plaintext, jsonOut)
+			os.Exit(exitCodeFor(err))
+		}
+
+		if jsonOut {
+			output.JSON(roadmap)
+			return
+		}
+
+		progress := averageProgress(roadmap.Projects)
+
+		if plaintext {
+			fmt.Printf("# %s\n\n", roadmap.Name)
+			if roadmap.Description != "" {
+				fmt.Printf("%s\n\n", roadmap.Description)
+			}
+			fmt.Printf("- **ID**: %s\n", roadmap.ID)
+			if roadmap.Owner != nil {
+				fmt.Printf("- **Owner**: %s\n", roadmap.Owner.Name)
+			}
+			fmt.Printf("- **Progress**: %.0f%% across %d projects\n", progress*100, projectCount(roadmap.Projects))
+			if roadmap.URL != "" {
+				fmt.Printf("- **URL**: %s\n", roadmap.URL)
+			}
+			fmt.Printf("\n## Projects\n")
+			printRollupProjects(roadmap.Projects, true)
+			return
+		}
+
+		fmt.Println()
+		fmt.Printf("%s %s\n", color.New(color.FgMagenta, color.Bold).Sprint("🗺️  Roadmap:"), roadmap.Name)
+		fmt.Println(strings.Repeat("─", 50))
+		if roadmap.Description != "" {
+			fmt.Printf("%s\n\n", roadmap.Description)
+		}
+		if roadmap.Owner != nil {
+			fmt.Printf("%s %s\n", color.New(color.Bold).Sprint("Owner:"), roadmap.Owner.Name)
+		}
+		fmt.Printf("%s %s across %d projects\n",
+			color.New(color.Bold).Sprint("Progress:"),
+			progressColor(progress).Sprintf("%.0f%%", progress*100),
+			projectCount(roadmap.Projects))
+		fmt.Println()
+		printRollupProjects(roadmap.Projects, false)
+	},
+}
+
+var roadmapLinkCmd = &cobra.Command{
+	Use:   "link ROADMAP PROJECT-ID...",
+	Short: "Add projects to a roadmap",
+	Args:  cobra.MinimumNArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		client, err := auth.NewClient()
+		if err != nil {
+			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		roadmap, err := resolveRoadmap(context.Background(), client, args[0])
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to find roadmap: %v", err), plaintext, jsonOut)
+			os.Exit(exitCodeFor(err))
+		}
+
+		runProjectLinks(client, args[1:], "Linked", func(ctx context.Context, projectID string) error {
+			return client.LinkRoadmapProject(ctx, roadmap.ID, projectID)
+		}, plaintext, jsonOut)
+	},
+}
+
+var roadmapUnlinkCmd = &cobra.Command{
+	Use:   "unlink ROADMAP PROJECT-ID...",
+	Short: "Remove projects from a roadmap",
+	Args:  cobra.MinimumNArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		client, err := auth.NewClient()
+		if err != nil {
+			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		roadmap, err := resolveRoadmap(context.Background(), client, args[0])
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to find roadmap: %v", err), plaintext, jsonOut)
+			os.Exit(exitCodeFor(err))
+		}
+
+		runProjectLinks(client, args[1:], "Unlinked", func(ctx context.Context, projectID string) error {
+			return client.UnlinkRoadmapProject(ctx, roadmap.ID, projectID)
+		}, plaintext, jsonOut)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(roadmapCmd)
+	roadmapCmd.AddCommand(roadmapListCmd)
+	roadmapCmd.AddCommand(roadmapGetCmd)
+	roadmapCmd.AddCommand(roadmapLinkCmd)
+	roadmapCmd.AddCommand(roadmapUnlinkCmd)
+
+	roadmapListCmd.Flags().IntP("limit", "l", 50, "Maximum number of roadmaps to fetch (pages automatically)")
+	roadmapListCmd.Flags().Bool("all", false, "Fetch every roadmap, ignoring --limit")
+}
@@ -0,0 +1,95 @@
+package cmd
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/yjiky/linctl/pkg/api"
+)
+
+// newRoadmaps returns a workspace with a second project and a roadmap
+// containing Website
+func newRoadmaps(t *testing.T) (*testEnv, *rollups) {
+	env := newWorkspace(t)
+	env.fake.AddProject(api.Project{Name: "Mobile app", State: "planned", Teams: &api.Teams{Nodes: []api.Team{{Key: "ENG"}}}})
+	roadmaps := serveRollups(env, "Roadmap",
+		map[string]interface{}{"id": "road-2030", "slugId": "r2030", "name": "2030 Platform", "description": "Platform work for 2030", "owner": map[string]interface{}{"name": "Bob Jones"}},
+	)
+	roadmaps.links = append(roadmaps.links, [2]string{"road-2030", env.projectID("Website")})
+	return env, roadmaps
+}
+
+func TestRoadmapList(t *testing.T) {
+	env, _ := newRoadmaps(t)
+
+	var roadmaps []api.Roadmap
+	env.runJSON(&roadmaps, "roadmap", "list")
+	if len(roadmaps) != 1 || roadmaps[0].Name != "2030 Platform" || projectCount(roadmaps[0].Projects) != 1 {
+		t.Errorf("roadmap list = %+v", roadmaps)
+	}
+
+	out := env.run("roadmaps", "ls", "--plaintext")
+	assertContains(t, out, "Name\tOwner\tProjects\tProgress\tID\n2030 Platform\tBob Jones\t1\t0%\troad-2030\n")
+}
+
+func TestRoadmapGet(t *testing.T) {
+	env, _ := newRoadmaps(t)
+
+	out := env.run("roadmap", "get", "r2030", "--plaintext")
+	assertContains(t, out,
+		"# 2030 Platform\n\nPlatform work for 2030\n",
+		"- **Owner**: Bob Jones",
+		"- **Progress**: 0% across 1 projects",
+		"Website\tstarted",
+	)
+	if _, ok := env.fake.LastRequest("RoadmapNames"); ok {
+		t.Error("roadmap get by slug listed roadmaps")
+	}
+
+	var roadmap api.Roadmap
+	env.runJSON(&roadmap, "roadmap", "show", "2030 platform")
+	if roadmap.ID != "road-2030" {
+		t.Errorf("roadmap get by name = %+v", roadmap)
+	}
+	if _, ok := env.fake.LastRequest("Roadmaps"); ok {
+		t.Error("roadmap get by name fetched roadmaps with their projects")
+	}
+
+	out = env.expectExit(exitNotFound, "roadmap", "get", "2031 Platform")
+	assertContains(t, out, "roadmap not found: 2031 Platform")
+}
+
+func TestRoadmapGetPaged(t *testing.T) {
+	env, roadmaps := newRoadmaps(t)
+	for n := 0; n < 260; n++ {
+		project := env.fake.AddProject(api.Project{Name: fmt.Sprintf("Project %d", n), State: "planned"})
+		roadmaps.links = append(roadmaps.links, [2]string{"road-2030", project.ID})
+	}
+
+	out := env.run("roadmap", "get", "road-2030", "--plaintext")
+	assertContains(t, out, "across 261 projects", "Project 259\tplanned")
+	if after, _ := env.lastVariables("RoadmapProjects")["after"].(string); after == "" {
+		t.Error("RoadmapProjects was not requested after the first page")
+	}
+}
+
+func TestRoadmapLinkAndUnlink(t *testing.T) {
+	env, roadmaps := newRoadmaps(t)
+	website, mobile := env.projectID("Website"), env.projectID("Mobile app")
+
+	out := env.run("roadmap", "link", "2030 Platform", mobile, "--plaintext")
+	assertContains(t, out, "Linked "+mobile)
+	if got := env.lastVariables("LinkRoadmapProject")["input"]; !reflect.DeepEqual(got, map[string]interface{}{"roadmapId": "road-2030", "projectId": mobile}) {
+		t.Errorf("link input = %v", got)
+	}
+
+	out = env.run("roadmap", "unlink", "road-2030", website, "--plaintext")
+	assertContains(t, out, "Unlinked "+website)
+	if got := roadmaps.projects("road-2030"); !reflect.DeepEqual(got, []string{"Mobile app"}) {
+		t.Errorf("roadmap projects = %v, want only Mobile app", got)
+	}
+
+	out = env.expectExit(exitNotFound, "roadmap", "unlink", "road-2030", website)
+	assertContains(t, out, "roadmap project link not found")
+}
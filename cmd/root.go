@@ -1,10 +1,16 @@
 package cmd
 
 import (
+	"bufio"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"strings"
 
+	"github.com/yjiky/linctl/pkg/api"
+	"github.com/yjiky/linctl/pkg/auth"
+	"github.com/yjiky/linctl/pkg/config"
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -14,6 +20,17 @@ var (
     cfgFile   string
     plaintext bool
     jsonOut   bool
+
+    // settings are the preferences read from the config file
+    settings = config.Defaults()
+)
+
+// Process exit codes, so scripts can tell failure classes apart
+const (
+	exitError     = 1
+	exitAuth      = 3
+	exitNotFound  = 4
+	exitTransient = 5
 )
 
 // version is set at build time via -ldflags
@@ -80,6 +97,75 @@ func Execute() {
 	}
 }
 
+// exitCodeFor maps an error to the exit code the process should return
+func exitCodeFor(err error) int {
+	switch {
+	case err == nil:
+		return 0
+	case errors.Is(err, auth.ErrNotAuthenticated), api.IsAuthError(err):
+		return exitAuth
+	case api.IsNotFound(err):
+		return exitNotFound
+	case api.IsTransient(err):
+		return exitTransient
+	default:
+		return exitError
+	}
+}
+
+// isTerminal reports whether f is an interactive terminal rather than a pipe or file
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
+// confirmAction asks for a yes/no answer on stdin before a destructive action.
+// It refuses to prompt when stdin is not a terminal, so scripts must pass --yes.
+func confirmAction(prompt string) (bool, error) {
+	if !isTerminal(os.Stdin) {
+		return false, fmt.Errorf("confirmation required but stdin is not a terminal; pass --yes to proceed")
+	}
+
+	fmt.Fprintf(os.Stderr, "%s [y/N]: ", prompt)
+	answer, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && answer == "" {
+		return false, err
+	}
+
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	return answer == "y" || answer == "yes", nil
+}
+
+// teamKeyOrDefault returns the --team flag, or the team setting when the flag
+// is not given. An explicit --team "" opts out of the default.
+func teamKeyOrDefault(cmd *cobra.Command) string {
+	if cmd.Flags().Changed("team") {
+		teamKey, _ := cmd.Flags().GetString("team")
+		return teamKey
+	}
+	return settings.Team
+}
+
+// readTextFile reads a file's contents, or stdin when path is "-"
+func readTextFile(path string) (string, error) {
+	if path == "-" {
+		data, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return "", fmt.Errorf("failed to read stdin: %v", err)
+		}
+		return string(data), nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s: %v", path, err)
+	}
+	return string(data), nil
+}
+
 // GetRootCmd returns the root command for testing
 func GetRootCmd() *cobra.Command {
 	return rootCmd
@@ -92,10 +178,21 @@ func init() {
 	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is $HOME/.linctl.yaml)")
 	rootCmd.PersistentFlags().BoolVarP(&plaintext, "plaintext", "p", false, "plaintext output (non-interactive)")
 	rootCmd.PersistentFlags().BoolVarP(&jsonOut, "json", "j", false, "JSON output")
+	rootCmd.PersistentFlags().String("profile", "", "auth profile to use (default is the current profile, env LINCTL_PROFILE)")
+	rootCmd.PersistentFlags().String("api-url", "", "GraphQL endpoint to use instead of Linear's (env LINCTL_API_URL)")
+
+	bindFlags()
+}
 
-	// Bind flags to viper
+// bindFlags binds the global flags and environment variables to viper keys
+func bindFlags() {
 	_ = viper.BindPFlag("plaintext", rootCmd.PersistentFlags().Lookup("plaintext"))
 	_ = viper.BindPFlag("json", rootCmd.PersistentFlags().Lookup("json"))
+	_ = viper.BindPFlag("profile", rootCmd.PersistentFlags().Lookup("profile"))
+	_ = viper.BindEnv("profile", "LINCTL_PROFILE")
+	_ = viper.BindPFlag("api.url", rootCmd.PersistentFlags().Lookup("api-url"))
+	_ = viper.BindEnv("api.url", "LINCTL_API_URL")
+	_ = viper.BindEnv("auth.store", "LINCTL_CREDENTIAL_STORE")
 }
 
 // initConfig reads in config file and ENV variables if set.
@@ -117,9 +214,49 @@ func initConfig() {
 	viper.AutomaticEnv() // read in environment variables that match
 
 	// If a config file is found, read it in.
-	if err := viper.ReadInConfig(); err == nil {
-		if !plaintext && !jsonOut {
+	configErr := viper.ReadInConfig()
+
+	// Fall back to the defaults on a bad value, so 'linctl config' can fix it
+	if loaded, err := config.Load(viper.GetViper()); err != nil {
+		fmt.Fprintln(os.Stderr, color.New(color.FgYellow).Sprintf("⚠️  Ignoring settings in %s: %v", viper.ConfigFileUsed(), err))
+	} else {
+		settings = loaded
+	}
+
+	// The output setting applies unless --plaintext or --json was given
+	flags := rootCmd.PersistentFlags()
+	if !flags.Changed("plaintext") && !flags.Changed("json") {
+		switch settings.Output {
+		case config.OutputPlaintext:
+			viper.Set("plaintext", true)
+		case config.OutputJSON:
+			viper.Set("json", true)
+		}
+	}
+
+	if configErr == nil {
+		if !viper.GetBool("plaintext") && !viper.GetBool("json") {
 			fmt.Fprintln(os.Stderr, color.New(color.FgGreen).Sprintf("✅ Using config file: %s", viper.ConfigFileUsed()))
 		}
 	}
+
+	// Unusable api settings, such as a missing CA bundle, only fail commands
+	// that call the API, so 'linctl config' can still fix them
+	err := auth.SetClientOptions(api.ClientOptions{
+		BaseURL:    settings.API.URL,
+		Timeout:    settings.API.Timeout,
+		MaxRetries: settings.API.Retries,
+		ProxyURL:   settings.API.Proxy,
+		CAFile:     settings.API.CAFile,
+		Headers:    settings.API.Headers,
+	})
+	if err != nil {
+		fmt.Fprintln(os.Stderr, color.New(color.FgYellow).Sprintf("⚠️  Invalid api settings: %v", err))
+	}
+
+	auth.SetProfile(viper.GetString("profile"))
+	if err := auth.SetStore(settings.Auth.Store); err != nil {
+		fmt.Fprintln(os.Stderr, color.New(color.FgRed).Sprintf("❌ %v", err))
+		os.Exit(exitError)
+	}
 }
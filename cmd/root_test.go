@@ -0,0 +1,400 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/yjiky/linctl/pkg/api"
+	"github.com/yjiky/linctl/pkg/api/linearfake"
+	"github.com/yjiky/linctl/pkg/auth"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+// testArgsEnv makes the test binary run linctl with the JSON-encoded
+// arguments instead of the tests, so commands that exit can be checked
+const testArgsEnv = "LINCTL_TEST_ARGS"
+
+func TestMain(m *testing.M) {
+	if encoded := os.Getenv(testArgsEnv); encoded != "" {
+		var args []string
+		if err := json.Unmarshal([]byte(encoded), &args); err != nil {
+			fmt.Fprintf(os.Stderr, "invalid %s: %v\n", testArgsEnv, err)
+			os.Exit(2)
+		}
+		GetRootCmd().SetArgs(args)
+		Execute()
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
+// testEnv runs linctl against a fake Linear API with a scratch home directory
+type testEnv struct {
+	t    *testing.T
+	fake *linearfake.Server
+	home string
+}
+
+// newTestEnv starts an empty fake workspace and points linctl at it,
+// authenticated through LINEAR_API_KEY
+func newTestEnv(t *testing.T) *testEnv {
+	t.Helper()
+
+	fake := linearfake.New()
+	t.Cleanup(fake.Close)
+
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("LINEAR_API_KEY", "lin_api_test")
+	t.Setenv("LINCTL_API_URL", fake.URL)
+	t.Setenv("LINCTL_PROFILE", "")
+	t.Setenv("LINCTL_CREDENTIAL_STORE", "")
+	t.Setenv("LINCTL_OAUTH_CLIENT_ID", "")
+
+	return &testEnv{t: t, fake: fake, home: home}
+}
+
+// newWorkspace starts a fake workspace seeded with two users, two teams,
+// labels, a project and a handful of issues:
+//
+//	ENG-1 Fix login redirect   Todo         alice  bug      priority 2  Website
+//	ENG-2 Add dark mode        In Progress  bob    feature  priority 3
+//	ENG-3 Update dependencies  Done
+//	OPS-1 Rotate credentials   Todo         bob
+func newWorkspace(t *testing.T) *testEnv {
+	t.Helper()
+	env := newTestEnv(t)
+	f := env.fake
+
+	f.AddUser(api.User{Name: "Alice Smith", Email: "alice@example.com", Admin: true})
+	f.AddUser(api.User{Name: "Bob Jones", Email: "bob@example.com"})
+
+	f.AddTeam(api.Team{Key: "ENG", Name: "Engineering", CyclesEnabled: true})
+	f.AddTeam(api.Team{Key: "OPS", Name: "Operations"})
+	f.AddTeamMember("ENG", "alice@example.com")
+	f.AddTeamMember("ENG", "bob@example.com")
+	f.AddTeamMember("OPS", "bob@example.com")
+
+	f.AddLabel("ENG", api.Label{Name: "bug", Color: "#eb5757"})
+	f.AddLabel("ENG", api.Label{Name: "feature", Color: "#4ea7fc"})
+	f.AddLabel("", api.Label{Name: "customer", Color: "#f2994a"})
+
+	f.AddProject(api.Project{
+		Name:  "Website",
+		State: "started",
+		Lead:  &api.User{Email: "alice@example.com"},
+		Teams: &api.Teams{Nodes: []api.Team{{Key: "ENG"}}},
+	})
+
+	f.AddIssue(api.Issue{
+		Title:    "Fix login redirect",
+		Team:     &api.Team{Key: "ENG"},
+		State:    &api.State{Name: "Todo"},
+		Assignee: &api.User{Email: "alice@example.com"},
+		Labels:   &api.Labels{Nodes: []api.Label{{Name: "bug"}}},
+		Priority: 2,
+		Project:  &api.Project{Name: "Website"},
+	})
+	f.AddIssue(api.Issue{
+		Title:    "Add dark mode",
+		Team:     &api.Team{Key: "ENG"},
+		State:    &api.State{Name: "In Progress"},
+		Assignee: &api.User{Email: "bob@example.com"},
+		Labels:   &api.Labels{Nodes: []api.Label{{Name: "feature"}}},
+		Priority: 3,
+	})
+	f.AddIssue(api.Issue{
+		Title: "Update dependencies",
+		Team:  &api.Team{Key: "ENG"},
+		State: &api.State{Name: "Done"},
+	})
+	f.AddIssue(api.Issue{
+		Title:    "Rotate credentials",
+		Team:     &api.Team{Key: "OPS"},
+		Assignee: &api.User{Email: "bob@example.com"},
+	})
+
+	return env
+}
+
+// run executes linctl in-process and returns what it wrote to stdout. Use it
+// for commands expected to succeed; a command that exits takes the test
+// binary with it, so failures are checked with runExit.
+func (e *testEnv) run(args ...string) string {
+	e.t.Helper()
+	return e.runWithInput("", args...)
+}
+
+// runWithInput is run with stdin reading from input
+func (e *testEnv) runWithInput(input string, args ...string) string {
+	e.t.Helper()
+
+	resetCommands(GetRootCmd())
+	viper.Reset()
+	bindFlags()
+
+	stdin := os.Stdin
+	stdinFile, err := os.CreateTemp(e.t.TempDir(), "stdin")
+	if err != nil {
+		e.t.Fatal(err)
+	}
+	if _, err := stdinFile.WriteString(input); err != nil {
+		e.t.Fatal(err)
+	}
+	if _, err := stdinFile.Seek(0, io.SeekStart); err != nil {
+		e.t.Fatal(err)
+	}
+	os.Stdin = stdinFile
+	defer func() {
+		os.Stdin = stdin
+		_ = stdinFile.Close()
+	}()
+
+	stdout := os.Stdout
+	r, w, err := os.Pipe()
+	if err != nil {
+		e.t.Fatal(err)
+	}
+	os.Stdout = w
+	captured := make(chan string)
+	go func() {
+		var buf bytes.Buffer
+		_, _ = io.Copy(&buf, r)
+		captured <- buf.String()
+	}()
+
+	GetRootCmd().SetArgs(args)
+	execErr := GetRootCmd().Execute()
+
+	os.Stdout = stdout
+	_ = w.Close()
+	out := <-captured
+	_ = r.Close()
+
+	if execErr != nil {
+		e.t.Fatalf("linctl %s: %v\n%s", strings.Join(args, " "), execErr, out)
+	}
+	return out
+}
+
+// runExit executes linctl in a child process, for commands that exit with
+// an error, and returns its stdout, stderr and exit code
+func (e *testEnv) runExit(args ...string) (string, string, int) {
+	e.t.Helper()
+	return e.runExitWithInput("", args...)
+}
+
+// runExitWithInput is runExit with stdin reading from input
+func (e *testEnv) runExitWithInput(input string, args ...string) (string, string, int) {
+	e.t.Helper()
+
+	encoded, err := json.Marshal(args)
+	if err != nil {
+		e.t.Fatal(err)
+	}
+	child := exec.Command(os.Args[0])
+	child.Env = append(os.Environ(), testArgsEnv+"="+string(encoded))
+	child.Stdin = strings.NewReader(input)
+	var stdout, stderr bytes.Buffer
+	child.Stdout = &stdout
+	child.Stderr = &stderr
+
+	err = child.Run()
+	code := 0
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		code = exitErr.ExitCode()
+	} else if err != nil {
+		e.t.Fatalf("linctl %s: %v", strings.Join(args, " "), err)
+	}
+	return stdout.String(), stderr.String(), code
+}
+
+// expectExit runs a failing command and checks its exit code, returning its
+// combined output
+func (e *testEnv) expectExit(code int, args ...string) string {
+	e.t.Helper()
+	stdout, stderr, got := e.runExit(args...)
+	if got != code {
+		e.t.Fatalf("linctl %s exited with %d, want %d\nstdout: %s\nstderr: %s", strings.Join(args, " "), got, code, stdout, stderr)
+	}
+	return stdout + stderr
+}
+
+// runJSON runs a command with --json and decodes its output into v
+func (e *testEnv) runJSON(v interface{}, args ...string) {
+	e.t.Helper()
+	out := e.run(append(args, "--json")...)
+	if err := json.Unmarshal([]byte(out), v); err != nil {
+		e.t.Fatalf("linctl %s: invalid JSON output: %v\n%s", strings.Join(args, " "), err, out)
+	}
+}
+
+// decodeJSON decodes command output into v
+func decodeJSON(t *testing.T, out string, v interface{}) {
+	t.Helper()
+	if err := json.Unmarshal([]byte(out), v); err != nil {
+		t.Fatalf("invalid JSON output: %v\n%s", err, out)
+	}
+}
+
+// issue returns an issue from the fake workspace
+func (e *testEnv) issue(ref string) api.Issue {
+	e.t.Helper()
+	issue, ok := e.fake.Issue(ref)
+	if !ok {
+		e.t.Fatalf("issue %s not found", ref)
+	}
+	return issue
+}
+
+// lastVariables returns the variables of the most recent request for an
+// operation
+func (e *testEnv) lastVariables(operation string) map[string]interface{} {
+	e.t.Helper()
+	req, ok := e.fake.LastRequest(operation)
+	if !ok {
+		e.t.Fatalf("no %s request was sent", operation)
+	}
+	return req.Variables
+}
+
+// projectID returns the ID of a project in the fake workspace
+func (e *testEnv) projectID(ref string) string {
+	e.t.Helper()
+	project, ok := e.fake.Project(ref)
+	if !ok {
+		e.t.Fatalf("project %s not found", ref)
+	}
+	return project.ID
+}
+
+// writeScript writes an executable shell script, for use as $EDITOR and the
+// like, and returns its path
+func writeScript(t *testing.T, name, body string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte("#!/bin/sh\n"+body+"\n"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+// resetCommands restores every flag of the command tree to its default, so
+// in-process runs do not see the flags of earlier ones
+func resetCommands(cmd *cobra.Command) {
+	reset := func(flag *pflag.Flag) {
+		if slice, ok := flag.Value.(pflag.SliceValue); ok {
+			values := []string{}
+			if trimmed := strings.Trim(flag.DefValue, "[]"); trimmed != "" {
+				values = strings.Split(trimmed, ",")
+			}
+			_ = slice.Replace(values)
+		} else {
+			_ = flag.Value.Set(flag.DefValue)
+		}
+		flag.Changed = false
+	}
+	cmd.Flags().VisitAll(reset)
+	cmd.PersistentFlags().VisitAll(reset)
+	for _, child := range cmd.Commands() {
+		resetCommands(child)
+	}
+}
+
+// assertContains fails the test unless out contains every substring
+func assertContains(t *testing.T, out string, substrings ...string) {
+	t.Helper()
+	for _, substring := range substrings {
+		if !strings.Contains(out, substring) {
+			t.Errorf("output does not contain %q:\n%s", substring, out)
+		}
+	}
+}
+
+func TestExitCodeFor(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{"nil", nil, 0},
+		{"not authenticated", auth.ErrNotAuthenticated, exitAuth},
+		{"auth error", &api.Error{Code: api.CodeAuthentication}, exitAuth},
+		{"forbidden", &api.Error{StatusCode: 403}, exitAuth},
+		{"not found", &api.Error{Message: "Entity not found: Issue"}, exitNotFound},
+		{"rate limited", &api.Error{Code: api.CodeRateLimited}, exitTransient},
+		{"network", &api.NetworkError{Err: io.EOF}, exitTransient},
+		{"other", errors.New("boom"), exitError},
+		{"wrapped", fmt.Errorf("failed: %w", &api.Error{Code: api.CodeEntityNotFound}), exitNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := exitCodeFor(tt.err); got != tt.want {
+				t.Errorf("exitCodeFor() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAPIURLFlag(t *testing.T) {
+	env := newWorkspace(t)
+	t.Setenv("LINCTL_API_URL", "")
+
+	out := env.run("whoami", "--api-url", env.fake.URL, "--json")
+	assertContains(t, out, "alice@example.com")
+
+	req, _ := env.fake.LastRequest("Me")
+	if got := req.Header.Get("Authorization"); got != "lin_api_test" {
+		t.Errorf("Authorization = %q, want the LINEAR_API_KEY value", got)
+	}
+}
+
+func TestAPIHeadersSetting(t *testing.T) {
+	env := newWorkspace(t)
+	env.run("config", "set", "api.headers.X-Request-Source", "ci")
+
+	env.run("whoami", "--json")
+
+	req, _ := env.fake.LastRequest("Me")
+	if got := req.Header.Get("X-Request-Source"); got != "ci" {
+		t.Errorf("X-Request-Source = %q, want ci", got)
+	}
+}
+
+func TestRejectedAPIKeyExitsWithAuthCode(t *testing.T) {
+	env := newWorkspace(t)
+	env.fake.SetAPIKey("lin_api_other")
+
+	out := env.expectExit(exitAuth, "issue", "list", "--json")
+	assertContains(t, out, "Authentication required")
+}
+
+func TestMissingCredentialsExitsWithAuthCode(t *testing.T) {
+	env := newWorkspace(t)
+	t.Setenv("LINEAR_API_KEY", "")
+
+	out := env.expectExit(exitAuth, "team", "list", "--plaintext")
+	assertContains(t, out, "not authenticated")
+}
+
+func TestDocsPrintsReadme(t *testing.T) {
+	env := newTestEnv(t)
+	SetReadmeContents("# linctl\n\nReadme body\n")
+	defer SetReadmeContents("")
+
+	out := env.run("docs")
+	if out != "# linctl\n\nReadme body\n" {
+		t.Errorf("docs printed %q", out)
+	}
+}
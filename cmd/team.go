@@ -35,18 +35,15 @@ var teamListCmd = &cobra.Command{
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
 
-		// Get auth header
-		authHeader, err := auth.GetAuthHeader()
+		// Create API client
+		client, err := auth.NewClient()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitAuth)
 		}
 
-		// Create API client
-		client := api.NewClient(authHeader)
-
 		// Get limit
-		limit, _ := cmd.Flags().GetInt("limit")
+		limit := resolveListLimit(cmd)
 
 		// Get sort option
 		sortBy, _ := cmd.Flags().GetString("sort")
@@ -67,11 +64,13 @@ var teamListCmd = &cobra.Command{
 		}
 
 		// Get teams
-		teams, err := client.GetTeams(context.Background(), limit, "", orderBy)
+		paginator := api.NewPaginator(client.TeamPages(orderBy), limit)
+		nodes, err := paginator.All(context.Background())
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to list teams: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCodeFor(err))
 		}
+		teams := &api.Teams{Nodes: nodes, PageInfo: paginator.PageInfo()}
 
 		// Handle output
 		if jsonOut {
@@ -143,21 +142,18 @@ var teamGetCmd = &cobra.Command{
 		jsonOut := viper.GetBool("json")
 		teamKey := args[0]
 
-		// Get auth header
-		authHeader, err := auth.GetAuthHeader()
+		// Create API client
+		client, err := auth.NewClient()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitAuth)
 		}
 
-		// Create API client
-		client := api.NewClient(authHeader)
-
 		// Get team details
 		team, err := client.GetTeam(context.Background(), teamKey)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to get team: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCodeFor(err))
 		}
 
 		// Handle output
@@ -207,21 +203,18 @@ var teamMembersCmd = &cobra.Command{
 		jsonOut := viper.GetBool("json")
 		teamKey := args[0]
 
-		// Get auth header
-		authHeader, err := auth.GetAuthHeader()
+		// Create API client
+		client, err := auth.NewClient()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitAuth)
 		}
 
-		// Create API client
-		client := api.NewClient(authHeader)
-
 		// Get team members
 		members, err := client.GetTeamMembers(context.Background(), teamKey)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to get team members: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCodeFor(err))
 		}
 
 		// Handle output
@@ -293,6 +286,7 @@ func init() {
 	teamCmd.AddCommand(teamMembersCmd)
 
 	// List command flags
-	teamListCmd.Flags().IntP("limit", "l", 50, "Maximum number of teams to return")
+	teamListCmd.Flags().IntP("limit", "l", 50, "Maximum number of teams to return (pages automatically)")
+	teamListCmd.Flags().Bool("all", false, "Fetch every team, ignoring --limit")
 	teamListCmd.Flags().StringP("sort", "o", "linear", "Sort order: linear (default), created, updated")
 }
@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/yjiky/linctl/pkg/api"
+)
+
+func TestTeamList(t *testing.T) {
+	env := newWorkspace(t)
+
+	var teams []api.Team
+	env.runJSON(&teams, "team", "list")
+	if len(teams) != 2 || teams[0].Key != "ENG" || teams[0].IssueCount != 3 {
+		t.Errorf("team list = %+v", teams)
+	}
+
+	out := env.run("team", "ls", "--plaintext")
+	assertContains(t, out, "Key\tName\tDescription\tPrivate\tIssues", "ENG\tEngineering\t\tfalse\t3", "OPS\tOperations\t\tfalse\t1")
+
+	env.runJSON(&teams, "team", "list", "--limit", "1", "--sort", "created")
+	if len(teams) != 1 {
+		t.Errorf("team list --limit 1 returned %d teams", len(teams))
+	}
+
+	out = env.expectExit(exitError, "team", "list", "--sort", "name")
+	assertContains(t, out, "Invalid sort option: name")
+}
+
+func TestTeamGet(t *testing.T) {
+	env := newWorkspace(t)
+
+	var team api.Team
+	env.runJSON(&team, "team", "get", "ENG")
+	if team.Name != "Engineering" || !team.CyclesEnabled {
+		t.Errorf("team get = %+v", team)
+	}
+
+	out := env.run("team", "show", "ops", "--plaintext")
+	assertContains(t, out, "Key: OPS", "Name: Operations", "Issue Count: 1")
+
+	env.expectExit(exitNotFound, "team", "get", "NOPE")
+}
+
+func TestTeamMembers(t *testing.T) {
+	env := newWorkspace(t)
+
+	var members []api.User
+	env.runJSON(&members, "team", "members", "ENG")
+	if len(members) != 2 || !members[0].IsMe {
+		t.Errorf("team members = %+v, want alice (the viewer) and bob", members)
+	}
+
+	out := env.run("team", "members", "OPS", "--plaintext")
+	assertContains(t, out, "Name\tEmail\tRole\tActive", "Bob Jones\tbob@example.com\tMember\ttrue")
+
+	out = env.run("team", "members", "ENG")
+	assertContains(t, out, "Admin (You)", "2 members in team ENG")
+
+	env.expectExit(exitNotFound, "team", "members", "NOPE")
+}
@@ -35,18 +35,15 @@ var userListCmd = &cobra.Command{
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
 
-		// Get auth header
-		authHeader, err := auth.GetAuthHeader()
+		// Create API client
+		client, err := auth.NewClient()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitAuth)
 		}
 
-		// Create API client
-		client := api.NewClient(authHeader)
-
 		// Get filters
-		limit, _ := cmd.Flags().GetInt("limit")
+		limit := resolveListLimit(cmd)
 		activeOnly, _ := cmd.Flags().GetBool("active")
 
 		// Get sort option
@@ -68,11 +65,13 @@ var userListCmd = &cobra.Command{
 		}
 
 		// Get users
-		users, err := client.GetUsers(context.Background(), limit, "", orderBy)
+		paginator := api.NewPaginator(client.UserPages(orderBy), limit)
+		nodes, err := paginator.All(context.Background())
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to list users: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCodeFor(err))
 		}
+		users := &api.Users{Nodes: nodes, PageInfo: paginator.PageInfo()}
 
 		// Filter active users if requested
 		filteredUsers := users.Nodes
@@ -158,21 +157,18 @@ var userGetCmd = &cobra.Command{
 		jsonOut := viper.GetBool("json")
 		email := args[0]
 
-		// Get auth header
-		authHeader, err := auth.GetAuthHeader()
+		// Create API client
+		client, err := auth.NewClient()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitAuth)
 		}
 
-		// Create API client
-		client := api.NewClient(authHeader)
-
 		// Get user details
 		user, err := client.GetUser(context.Background(), email)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to get user: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCodeFor(err))
 		}
 
 		// Handle output
@@ -234,21 +230,18 @@ var userMeCmd = &cobra.Command{
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
 
-		// Get auth header
-		authHeader, err := auth.GetAuthHeader()
+		// Create API client
+		client, err := auth.NewClient()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitAuth)
 		}
 
-		// Create API client
-		client := api.NewClient(authHeader)
-
 		// Get current user
 		user, err := client.GetViewer(context.Background())
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to get current user: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCodeFor(err))
 		}
 
 		// Handle output
@@ -305,7 +298,8 @@ func init() {
 	userCmd.AddCommand(userMeCmd)
 
 	// List command flags
-	userListCmd.Flags().IntP("limit", "l", 50, "Maximum number of users to return")
+	userListCmd.Flags().IntP("limit", "l", 50, "Maximum number of users to return (pages automatically)")
+	userListCmd.Flags().Bool("all", false, "Fetch every user, ignoring --limit")
 	userListCmd.Flags().BoolP("active", "a", false, "Show only active users")
 	userListCmd.Flags().StringP("sort", "o", "linear", "Sort order: linear (default), created, updated")
 }
@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/yjiky/linctl/pkg/api"
+)
+
+func TestUserList(t *testing.T) {
+	env := newWorkspace(t)
+
+	var users []api.User
+	env.runJSON(&users, "user", "list", "--active")
+	if len(users) != 2 || users[0].Email != "alice@example.com" {
+		t.Errorf("user list = %+v", users)
+	}
+
+	out := env.run("user", "ls", "--plaintext")
+	assertContains(t, out, "Name\tEmail\tRole\tActive", "Alice Smith\talice@example.com\tAdmin\ttrue", "Bob Jones\tbob@example.com\tMember\ttrue")
+
+	env.runJSON(&users, "user", "list", "--limit", "1")
+	if len(users) != 1 {
+		t.Errorf("user list --limit 1 returned %d users", len(users))
+	}
+
+	out = env.expectExit(exitError, "user", "list", "--sort", "name")
+	assertContains(t, out, "Invalid sort option: name")
+}
+
+func TestUserGet(t *testing.T) {
+	env := newWorkspace(t)
+
+	var user api.User
+	env.runJSON(&user, "user", "get", "bob@example.com")
+	if user.Name != "Bob Jones" || user.IsMe {
+		t.Errorf("user get = %+v", user)
+	}
+
+	out := env.run("user", "show", "alice@example.com", "--plaintext")
+	assertContains(t, out, "Name: Alice Smith", "Email: alice@example.com", "Admin: true")
+
+	env.expectExit(exitNotFound, "user", "get", "carol@example.com")
+}
+
+func TestUserMe(t *testing.T) {
+	env := newWorkspace(t)
+
+	var user api.User
+	env.runJSON(&user, "user", "me")
+	if user.Email != "alice@example.com" {
+		t.Errorf("user me = %+v, want alice", user)
+	}
+
+	env.fake.SetViewer("bob@example.com")
+	out := env.run("user", "me", "--plaintext")
+	assertContains(t, out, "Name: Bob Jones", "Admin: false")
+
+	env.fake.SetAPIKey("lin_api_other")
+	out = env.expectExit(exitAuth, "user", "me")
+	assertContains(t, out, "Failed to get current user")
+}
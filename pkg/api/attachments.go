@@ -152,7 +152,7 @@ func (c *Client) GetIssueAttachments(ctx context.Context, issueRef string, first
 	}
 
 	if response.Issue.ID == "" {
-		return nil, fmt.Errorf("issue not found: %s", issueRef)
+		return nil, newNotFoundError("issue", issueRef)
 	}
 
 	return &response.Issue, nil
@@ -3,26 +3,61 @@ package api
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 )
 
 const (
 	BaseURL = "https://api.linear.app/graphql"
+
+	// DefaultMaxRetries is how many times a rate-limited or failed request is retried
+	DefaultMaxRetries = 3
+
+	defaultRetryBackoff = time.Second
+	maxRetryDelay       = time.Minute
+)
+
+// Rate limit headers sent by Linear on every API response
+const (
+	headerRequestsLimit       = "X-RateLimit-Requests-Limit"
+	headerRequestsRemaining   = "X-RateLimit-Requests-Remaining"
+	headerRequestsReset       = "X-RateLimit-Requests-Reset"
+	headerComplexityLimit     = "X-RateLimit-Complexity-Limit"
+	headerComplexityRemaining = "X-RateLimit-Complexity-Remaining"
+	headerComplexityReset     = "X-RateLimit-Complexity-Reset"
 )
 
 type Client struct {
-	httpClient *http.Client
-	authHeader string
-	baseURL    string
+	httpClient   *http.Client
+	authHeader   string
+	baseURL      string
+	headers      map[string]string
+	maxRetries   int
+	retryBackoff time.Duration
+
+	// OAuth clients refresh their token; see NewOAuthClient
+	oauth     *OAuthConfig
+	token     *OAuthToken
+	onRefresh func(*OAuthToken)
+
+	mu        sync.Mutex
+	rateLimit *RateLimit
 }
 
 type GraphQLRequest struct {
-	Query     string                 `json:"query"`
-	Variables map[string]interface{} `json:"variables,omitempty"`
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName,omitempty"`
+	Variables     map[string]interface{} `json:"variables,omitempty"`
 }
 
 type GraphQLResponse struct {
@@ -31,9 +66,17 @@ type GraphQLResponse struct {
 }
 
 type GraphQLError struct {
-	Message   string                 `json:"message"`
-	Locations []GraphQLErrorLocation `json:"locations,omitempty"`
-	Path      []interface{}          `json:"path,omitempty"`
+	Message    string                 `json:"message"`
+	Locations  []GraphQLErrorLocation `json:"locations,omitempty"`
+	Path       []interface{}          `json:"path,omitempty"`
+	Extensions GraphQLErrorExtensions `json:"extensions,omitempty"`
+}
+
+// GraphQLErrorExtensions carries Linear's machine-readable error details
+type GraphQLErrorExtensions struct {
+	Code                   string `json:"code,omitempty"`
+	Type                   string `json:"type,omitempty"`
+	UserPresentableMessage string `json:"userPresentableMessage,omitempty"`
 }
 
 type GraphQLErrorLocation struct {
@@ -52,16 +95,128 @@ func NewClientWithURL(baseURL, authHeader string) *Client {
 		httpClient: &http.Client{
 			Timeout: 30 * time.Second,
 		},
-		authHeader: authHeader,
-		baseURL:    baseURL,
+		authHeader:   authHeader,
+		baseURL:      baseURL,
+		maxRetries:   DefaultMaxRetries,
+		retryBackoff: defaultRetryBackoff,
 	}
 }
 
-// Execute performs a GraphQL request
+// ClientOptions configure how a Client reaches Linear
+type ClientOptions struct {
+	// BaseURL is the GraphQL endpoint; empty means Linear's
+	BaseURL string
+	// Timeout bounds each HTTP request; zero means 30 seconds
+	Timeout time.Duration
+	// MaxRetries is how often rate-limited and failed requests are retried
+	MaxRetries int
+	// ProxyURL routes requests through an HTTP(S) proxy; empty means the
+	// HTTP_PROXY/HTTPS_PROXY/NO_PROXY environment variables
+	ProxyURL string
+	// CAFile is a PEM bundle trusted in addition to the system roots
+	CAFile string
+	// Headers are sent with every GraphQL request
+	Headers map[string]string
+}
+
+// NewHTTPClient builds the HTTP client described by the options
+func (o ClientOptions) NewHTTPClient() (*http.Client, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if o.ProxyURL != "" {
+		proxyURL, err := url.Parse(o.ProxyURL)
+		if err != nil || proxyURL.Host == "" {
+			return nil, fmt.Errorf("invalid proxy URL %q", o.ProxyURL)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	if o.CAFile != "" {
+		pem, err := os.ReadFile(o.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA bundle: %w", err)
+		}
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in CA bundle %s", o.CAFile)
+		}
+		transport.TLSClientConfig = &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+	}
+
+	timeout := o.Timeout
+	if timeout <= 0 {
+		timeout = 30 * time.Second
+	}
+
+	return &http.Client{
+		Transport: transport,
+		Timeout:   timeout,
+	}, nil
+}
+
+// NewClientWithOptions creates a new Linear API client configured by opts
+func NewClientWithOptions(authHeader string, opts ClientOptions) (*Client, error) {
+	httpClient, err := opts.NewHTTPClient()
+	if err != nil {
+		return nil, err
+	}
+
+	baseURL := opts.BaseURL
+	if baseURL == "" {
+		baseURL = BaseURL
+	}
+
+	client := NewClientWithURL(baseURL, authHeader)
+	client.httpClient = httpClient
+	client.headers = opts.Headers
+	client.SetMaxRetries(opts.MaxRetries)
+	return client, nil
+}
+
+// HTTPClient returns the HTTP client requests are sent with, for fetching
+// files from Linear's storage through the same proxy and TLS settings
+func (c *Client) HTTPClient() *http.Client {
+	return c.httpClient
+}
+
+// AuthHeader returns the Authorization header value, refreshing an expired
+// OAuth token first
+func (c *Client) AuthHeader(ctx context.Context) (string, error) {
+	return c.authorization(ctx, false)
+}
+
+// SetTimeout sets the HTTP timeout of each request
+func (c *Client) SetTimeout(timeout time.Duration) {
+	if timeout > 0 {
+		c.httpClient.Timeout = timeout
+	}
+}
+
+// SetMaxRetries sets how many times rate-limited and server errors are retried
+func (c *Client) SetMaxRetries(retries int) {
+	if retries < 0 {
+		retries = 0
+	}
+	c.maxRetries = retries
+}
+
+// Execute performs a GraphQL request, retrying rate-limited and server errors.
+// Mutations are only retried when rate limited: a server error may come after
+// the write was applied, and retrying it would apply it twice.
 func (c *Client) Execute(ctx context.Context, query string, variables map[string]interface{}, result interface{}) error {
+	return c.ExecuteOperation(ctx, query, "", variables, result)
+}
+
+// ExecuteOperation performs a GraphQL request like Execute, running the named
+// operation of a document that defines several
+func (c *Client) ExecuteOperation(ctx context.Context, query, operationName string, variables map[string]interface{}, result interface{}) error {
 	reqBody := GraphQLRequest{
-		Query:     query,
-		Variables: variables,
+		Query:         query,
+		OperationName: operationName,
+		Variables:     variables,
 	}
 
 	jsonBody, err := json.Marshal(reqBody)
@@ -69,61 +224,221 @@ func (c *Client) Execute(ctx context.Context, query string, variables map[string
 		return fmt.Errorf("failed to marshal request: %w", err)
 	}
 
-	req, err := http.NewRequestWithContext(ctx, "POST", c.baseURL, bytes.NewBuffer(jsonBody))
+	mutation := isMutation(query, operationName)
+
+	var body []byte
+	refreshed := false
+	for attempt := 0; ; attempt++ {
+		authHeader, err := c.authorization(ctx, false)
+		if err != nil {
+			return err
+		}
+
+		var status int
+		var header http.Header
+		status, header, body, err = c.do(ctx, jsonBody, authHeader)
+		if err != nil {
+			return err
+		}
+
+		if status == http.StatusOK {
+			break
+		}
+
+		// A rejected OAuth token may have been revoked early; refresh once
+		if status == http.StatusUnauthorized && c.oauth != nil && !refreshed {
+			refreshed = true
+			if _, err := c.authorization(ctx, true); err != nil {
+				return err
+			}
+			attempt--
+			continue
+		}
+
+		apiErr := newHTTPError(status, body)
+		retryable := apiErr.IsTransient()
+		if mutation {
+			retryable = apiErr.IsRateLimited()
+		}
+		if !retryable || attempt >= c.maxRetries {
+			return apiErr
+		}
+
+		if err := sleepContext(ctx, c.retryDelay(attempt, header)); err != nil {
+			return err
+		}
+	}
+
+	var gqlResp GraphQLResponse
+	if err := json.Unmarshal(body, &gqlResp); err != nil {
+		return fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	if len(gqlResp.Errors) > 0 {
+		return newGraphQLError(http.StatusOK, gqlResp.Errors)
+	}
+
+	if result != nil {
+		if err := json.Unmarshal(gqlResp.Data, result); err != nil {
+			return fmt.Errorf("failed to unmarshal data: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// do sends a single HTTP request and records the rate limit headers of the response
+func (c *Client) do(ctx context.Context, jsonBody []byte, authHeader string) (int, http.Header, []byte, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", c.baseURL, bytes.NewReader(jsonBody))
 	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
+		return 0, nil, nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
+	for name, value := range c.headers {
+		req.Header.Set(name, value)
+	}
 	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Authorization", c.authHeader)
+	req.Header.Set("Authorization", authHeader)
 	req.Header.Set("User-Agent", "linctl/0.1.0")
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return fmt.Errorf("request failed: %w", err)
+		return 0, nil, nil, &NetworkError{Err: err}
 	}
 	defer func() { _ = resp.Body.Close() }()
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return fmt.Errorf("failed to read response: %w", err)
+		return 0, nil, nil, fmt.Errorf("failed to read response: %w", err)
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+	c.recordRateLimit(resp.Header)
+
+	return resp.StatusCode, resp.Header, body, nil
+}
+
+// retryDelay picks how long to wait before the next attempt.
+// Retry-After wins, then the rate limit reset time, then exponential backoff.
+func (c *Client) retryDelay(attempt int, header http.Header) time.Duration {
+	if delay, ok := parseRetryAfter(header.Get("Retry-After"), time.Now()); ok {
+		return capDelay(delay)
 	}
 
-	var gqlResp GraphQLResponse
-	if err := json.Unmarshal(body, &gqlResp); err != nil {
-		return fmt.Errorf("failed to parse response: %w", err)
+	if reset, ok := parseResetHeader(header.Get(headerRequestsReset)); ok {
+		if remaining, err := strconv.Atoi(header.Get(headerRequestsRemaining)); err == nil && remaining <= 0 {
+			return capDelay(time.Until(reset))
+		}
 	}
 
-	if len(gqlResp.Errors) > 0 {
-		return fmt.Errorf("GraphQL errors: %v", gqlResp.Errors)
+	delay := c.retryBackoff << attempt
+	return capDelay(delay)
+}
+
+// capDelay keeps a retry delay within sane bounds
+func capDelay(delay time.Duration) time.Duration {
+	if delay < 0 {
+		return 0
 	}
+	if delay > maxRetryDelay {
+		return maxRetryDelay
+	}
+	return delay
+}
 
-	if result != nil {
-		if err := json.Unmarshal(gqlResp.Data, result); err != nil {
-			return fmt.Errorf("failed to unmarshal data: %w", err)
-		}
+// parseRetryAfter parses a Retry-After header given in seconds or as an HTTP date
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, false
 	}
 
-	return nil
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if when, err := http.ParseTime(value); err == nil {
+		return when.Sub(now), true
+	}
+
+	return 0, false
+}
+
+// parseResetHeader parses Linear's reset headers, which are UTC epoch milliseconds
+func parseResetHeader(value string) (time.Time, bool) {
+	ms, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return time.UnixMilli(ms), true
+}
+
+// sleepContext waits for the delay or until the context is done
+func sleepContext(ctx context.Context, delay time.Duration) error {
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// recordRateLimit stores the quota reported by Linear's response headers
+func (c *Client) recordRateLimit(header http.Header) {
+	limit, err := strconv.Atoi(header.Get(headerRequestsLimit))
+	if err != nil {
+		return
+	}
+
+	rl := &RateLimit{Limit: limit}
+	rl.Remaining, _ = strconv.Atoi(header.Get(headerRequestsRemaining))
+	rl.Reset, _ = parseResetHeader(header.Get(headerRequestsReset))
+	rl.ComplexityLimit, _ = strconv.Atoi(header.Get(headerComplexityLimit))
+	rl.ComplexityRemaining, _ = strconv.Atoi(header.Get(headerComplexityRemaining))
+	rl.ComplexityReset, _ = parseResetHeader(header.Get(headerComplexityReset))
+
+	c.mu.Lock()
+	c.rateLimit = rl
+	c.mu.Unlock()
 }
 
-// Rate limiting helper
+// GetRateLimit returns the quota reported by the most recent response.
+// If no request has been made yet, a minimal query is sent to read the headers.
 func (c *Client) GetRateLimit(ctx context.Context) (*RateLimit, error) {
-	// This would query Linear's rate limiting info
-	// For now, we'll return a placeholder
-	return &RateLimit{
-		Limit:     5000,
-		Remaining: 4999,
-		Reset:     time.Now().Add(time.Hour),
-	}, nil
+	c.mu.Lock()
+	rl := c.rateLimit
+	c.mu.Unlock()
+
+	if rl == nil {
+		if err := c.Execute(ctx, `query RateLimit { viewer { id } }`, nil, nil); err != nil {
+			return nil, err
+		}
+
+		c.mu.Lock()
+		rl = c.rateLimit
+		c.mu.Unlock()
+	}
+
+	if rl == nil {
+		return nil, fmt.Errorf("rate limit headers not present in API response")
+	}
+
+	copied := *rl
+	return &copied, nil
 }
 
+// RateLimit describes the request and complexity quotas reported by Linear
 type RateLimit struct {
-	Limit     int       `json:"limit"`
-	Remaining int       `json:"remaining"`
-	Reset     time.Time `json:"reset"`
+	Limit               int       `json:"limit"`
+	Remaining           int       `json:"remaining"`
+	Reset               time.Time `json:"reset"`
+	ComplexityLimit     int       `json:"complexityLimit"`
+	ComplexityRemaining int       `json:"complexityRemaining"`
+	ComplexityReset     time.Time `json:"complexityReset"`
 }
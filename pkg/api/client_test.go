@@ -0,0 +1,196 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// headers builds a header from name/value pairs
+func headers(pairs ...string) http.Header {
+	header := http.Header{}
+	for i := 0; i+1 < len(pairs); i += 2 {
+		header.Set(pairs[i], pairs[i+1])
+	}
+	return header
+}
+
+// statusServer answers each request with the next of statuses, repeating the
+// last one, and counts the requests it receives
+func statusServer(t *testing.T, header http.Header, statuses ...int) (*Client, *int32) {
+	t.Helper()
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := int(atomic.AddInt32(&requests, 1))
+		status := statuses[len(statuses)-1]
+		if n <= len(statuses) {
+			status = statuses[n-1]
+		}
+		for name, values := range header {
+			w.Header()[name] = values
+		}
+		w.WriteHeader(status)
+		switch status {
+		case http.StatusOK:
+			fmt.Fprint(w, `{"data": {"viewer": {"id": "user-1"}}}`)
+		case http.StatusTooManyRequests:
+			fmt.Fprint(w, `{"errors": [{"message": "Rate limit exceeded", "extensions": {"code": "RATELIMITED"}}]}`)
+		default:
+			fmt.Fprintf(w, `{"errors": [{"message": "%s"}]}`, http.StatusText(status))
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	client := NewClientWithURL(server.URL, "lin_api_test")
+	client.retryBackoff = time.Millisecond
+	return client, &requests
+}
+
+func TestExecuteRetries(t *testing.T) {
+	const query = `query Viewer { viewer { id } }`
+	const mutation = `
+		mutation CreateIssue($input: IssueCreateInput!) {
+			issueCreate(input: $input) { success }
+		}
+	`
+
+	const commentedMutation = `# Close the issue
+		mutation CloseIssue { issueUpdate(id: "ENG-1", input: {stateId: "done"}) { success } }
+	`
+	const fragmentFirstMutation = `
+		fragment IssueFields on Issue { id title }
+		mutation CreateIssue($input: IssueCreateInput!) {
+			issueCreate(input: $input) { issue { ...IssueFields } }
+		}
+	`
+
+	tests := []struct {
+		name     string
+		query    string
+		statuses []int
+		wantErr  bool
+		attempts int32
+	}{
+		{"query retried after a server error", query, []int{http.StatusBadGateway, http.StatusOK}, false, 2},
+		{"query retried when rate limited", query, []int{http.StatusTooManyRequests, http.StatusOK}, false, 2},
+		{"query gives up after max retries", query, []int{http.StatusServiceUnavailable}, true, DefaultMaxRetries + 1},
+		{"client error not retried", query, []int{http.StatusBadRequest}, true, 1},
+		{"mutation not retried after a server error", mutation, []int{http.StatusGatewayTimeout, http.StatusOK}, true, 1},
+		{"mutation retried when rate limited", mutation, []int{http.StatusTooManyRequests, http.StatusOK}, false, 2},
+		{"mutation after a comment not retried", commentedMutation, []int{http.StatusInternalServerError, http.StatusOK}, true, 1},
+		{"mutation after a fragment not retried", fragmentFirstMutation, []int{http.StatusInternalServerError, http.StatusOK}, true, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, requests := statusServer(t, nil, tt.statuses...)
+
+			err := client.Execute(context.Background(), tt.query, nil, nil)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Execute() error = %v, want error %v", err, tt.wantErr)
+			}
+			if got := atomic.LoadInt32(requests); got != tt.attempts {
+				t.Errorf("sent %d requests, want %d", got, tt.attempts)
+			}
+		})
+	}
+}
+
+func TestExecuteOperationRetriesNamedQuery(t *testing.T) {
+	const document = `
+		query Viewer { viewer { id } }
+		mutation Archive { issueArchive(id: "ENG-1") { success } }
+	`
+	tests := []struct {
+		operation string
+		attempts  int32
+	}{
+		{"Viewer", 2},
+		{"Archive", 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.operation, func(t *testing.T) {
+			client, requests := statusServer(t, nil, http.StatusBadGateway, http.StatusOK)
+			_ = client.ExecuteOperation(context.Background(), document, tt.operation, nil, nil)
+			if got := atomic.LoadInt32(requests); got != tt.attempts {
+				t.Errorf("sent %d requests, want %d", got, tt.attempts)
+			}
+		})
+	}
+}
+
+func TestExecuteWaitsForRetryAfter(t *testing.T) {
+	header := headers("Retry-After", "1")
+	client, requests := statusServer(t, header, http.StatusTooManyRequests, http.StatusOK)
+
+	start := time.Now()
+	if err := client.Execute(context.Background(), `query Viewer { viewer { id } }`, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("retried after %v, want to wait for Retry-After", elapsed)
+	}
+	if got := atomic.LoadInt32(requests); got != 2 {
+		t.Errorf("sent %d requests, want 2", got)
+	}
+}
+
+func TestExecuteStopsRetryingWhenContextEnds(t *testing.T) {
+	header := headers("Retry-After", "30")
+	client, _ := statusServer(t, header, http.StatusTooManyRequests)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := client.Execute(ctx, `query Viewer { viewer { id } }`, nil, nil); err != context.DeadlineExceeded {
+		t.Errorf("Execute() error = %v, want the context's deadline", err)
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	client := NewClientWithURL(BaseURL, "")
+	reset := time.Now().Add(20 * time.Second)
+	resetMillis := strconv.FormatInt(reset.UnixMilli(), 10)
+
+	tests := []struct {
+		name    string
+		attempt int
+		header  http.Header
+		min     time.Duration
+		max     time.Duration
+	}{
+		{"first backoff", 0, headers(), time.Second, time.Second},
+		{"backoff doubles", 2, headers(), 4 * time.Second, 4 * time.Second},
+		{"backoff capped", 10, headers(), maxRetryDelay, maxRetryDelay},
+		{"retry-after seconds", 0, headers("Retry-After", "7"), 7 * time.Second, 7 * time.Second},
+		{"retry-after date", 0, headers("Retry-After", time.Now().Add(10*time.Second).UTC().Format(http.TimeFormat)), 8 * time.Second, 10 * time.Second},
+		{"retry-after in the past", 3, headers("Retry-After", "-5"), 0, 0},
+		{"retry-after capped", 0, headers("Retry-After", "3600"), maxRetryDelay, maxRetryDelay},
+		{
+			"quota reset when exhausted", 0,
+			headers(headerRequestsRemaining, "0", headerRequestsReset, resetMillis),
+			19 * time.Second, 20 * time.Second,
+		},
+		{
+			"quota reset ignored while requests remain", 1,
+			headers(headerRequestsRemaining, "12", headerRequestsReset, resetMillis),
+			2 * time.Second, 2 * time.Second,
+		},
+		{
+			"retry-after wins over reset", 0,
+			headers("Retry-After", "3", headerRequestsRemaining, "0", headerRequestsReset, resetMillis),
+			3 * time.Second, 3 * time.Second,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := client.retryDelay(tt.attempt, tt.header)
+			if got < tt.min || got > tt.max {
+				t.Errorf("retryDelay(%d) = %v, want between %v and %v", tt.attempt, got, tt.min, tt.max)
+			}
+		})
+	}
+}
@@ -0,0 +1,314 @@
+package api
+
+import (
+	"context"
+	"fmt"
+)
+
+const commentFields = `
+	id
+	body
+	createdAt
+	updatedAt
+	editedAt
+	resolvedAt
+	url
+	user {
+		id
+		name
+		email
+	}
+	parent {
+		id
+	}
+	resolvingUser {
+		id
+		name
+	}
+	reactions {
+		id
+		emoji
+		user {
+			id
+			name
+		}
+	}
+`
+
+// GetComment returns a comment with the issue it belongs to
+func (c *Client) GetComment(ctx context.Context, id string) (*Comment, error) {
+	query := `
+		query Comment($id: String!) {
+			comment(id: $id) {` + commentFields + `
+				issue {
+					id
+					identifier
+					title
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": id,
+	}
+
+	var response struct {
+		Comment *Comment `json:"comment"`
+	}
+
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return nil, err
+	}
+
+	if response.Comment == nil || response.Comment.ID == "" {
+		return nil, newNotFoundError("comment", id)
+	}
+
+	return response.Comment, nil
+}
+
+// CreateReply replies to a comment, starting or continuing its thread
+func (c *Client) CreateReply(ctx context.Context, issueID, parentID, body string) (*Comment, error) {
+	return c.createComment(ctx, map[string]interface{}{
+		"issueId":  issueID,
+		"parentId": parentID,
+		"body":     body,
+	})
+}
+
+func (c *Client) createComment(ctx context.Context, input map[string]interface{}) (*Comment, error) {
+	query := `
+		mutation CreateComment($input: CommentCreateInput!) {
+			commentCreate(input: $input) {
+				success
+				comment {` + commentFields + `}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	var response struct {
+		CommentCreate struct {
+			Success bool     `json:"success"`
+			Comment *Comment `json:"comment"`
+		} `json:"commentCreate"`
+	}
+
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return nil, err
+	}
+
+	if !response.CommentCreate.Success || response.CommentCreate.Comment == nil {
+		return nil, fmt.Errorf("commentCreate failed")
+	}
+
+	return response.CommentCreate.Comment, nil
+}
+
+// UpdateComment replaces a comment's body
+func (c *Client) UpdateComment(ctx context.Context, id, body string) (*Comment, error) {
+	query := `
+		mutation UpdateComment($id: String!, $input: CommentUpdateInput!) {
+			commentUpdate(id: $id, input: $input) {
+				success
+				comment {` + commentFields + `}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": id,
+		"input": map[string]interface{}{
+			"body": body,
+		},
+	}
+
+	var response struct {
+		CommentUpdate struct {
+			Success bool     `json:"success"`
+			Comment *Comment `json:"comment"`
+		} `json:"commentUpdate"`
+	}
+
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return nil, err
+	}
+
+	if !response.CommentUpdate.Success || response.CommentUpdate.Comment == nil {
+		return nil, fmt.Errorf("commentUpdate failed")
+	}
+
+	return response.CommentUpdate.Comment, nil
+}
+
+// DeleteComment deletes a comment
+func (c *Client) DeleteComment(ctx context.Context, id string) error {
+	query := `
+		mutation DeleteComment($id: String!) {
+			commentDelete(id: $id) {
+				success
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": id,
+	}
+
+	var response struct {
+		CommentDelete struct {
+			Success bool `json:"success"`
+		} `json:"commentDelete"`
+	}
+
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return err
+	}
+
+	if !response.CommentDelete.Success {
+		return fmt.Errorf("commentDelete failed")
+	}
+
+	return nil
+}
+
+// ResolveComment marks a comment thread as resolved
+func (c *Client) ResolveComment(ctx context.Context, id string) error {
+	query := `
+		mutation ResolveComment($id: String!) {
+			commentResolve(id: $id) {
+				success
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": id,
+	}
+
+	var response struct {
+		CommentResolve struct {
+			Success bool `json:"success"`
+		} `json:"commentResolve"`
+	}
+
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return err
+	}
+
+	if !response.CommentResolve.Success {
+		return fmt.Errorf("commentResolve failed")
+	}
+
+	return nil
+}
+
+// UnresolveComment reopens a resolved comment thread
+func (c *Client) UnresolveComment(ctx context.Context, id string) error {
+	query := `
+		mutation UnresolveComment($id: String!) {
+			commentUnresolve(id: $id) {
+				success
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": id,
+	}
+
+	var response struct {
+		CommentUnresolve struct {
+			Success bool `json:"success"`
+		} `json:"commentUnresolve"`
+	}
+
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return err
+	}
+
+	if !response.CommentUnresolve.Success {
+		return fmt.Errorf("commentUnresolve failed")
+	}
+
+	return nil
+}
+
+// CreateReaction adds an emoji reaction to a comment
+func (c *Client) CreateReaction(ctx context.Context, commentID, emoji string) (*Reaction, error) {
+	query := `
+		mutation CreateReaction($input: ReactionCreateInput!) {
+			reactionCreate(input: $input) {
+				success
+				reaction {
+					id
+					emoji
+					createdAt
+					user {
+						id
+						name
+					}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": map[string]interface{}{
+			"commentId": commentID,
+			"emoji":     emoji,
+		},
+	}
+
+	var response struct {
+		ReactionCreate struct {
+			Success  bool      `json:"success"`
+			Reaction *Reaction `json:"reaction"`
+		} `json:"reactionCreate"`
+	}
+
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return nil, err
+	}
+
+	if !response.ReactionCreate.Success || response.ReactionCreate.Reaction == nil {
+		return nil, fmt.Errorf("reactionCreate failed")
+	}
+
+	return response.ReactionCreate.Reaction, nil
+}
+
+// DeleteReaction removes a reaction
+func (c *Client) DeleteReaction(ctx context.Context, id string) error {
+	query := `
+		mutation DeleteReaction($id: String!) {
+			reactionDelete(id: $id) {
+				success
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": id,
+	}
+
+	var response struct {
+		ReactionDelete struct {
+			Success bool `json:"success"`
+		} `json:"reactionDelete"`
+	}
+
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return err
+	}
+
+	if !response.ReactionDelete.Success {
+		return fmt.Errorf("reactionDelete failed")
+	}
+
+	return nil
+}
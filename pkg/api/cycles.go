@@ -0,0 +1,255 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Cycles is a paginated list of cycles
+type Cycles struct {
+	Nodes    []Cycle  `json:"nodes"`
+	PageInfo PageInfo `json:"pageInfo"`
+}
+
+const cycleFields = `
+	id
+	number
+	name
+	description
+	startsAt
+	endsAt
+	progress
+	completedAt
+	isActive
+	isNext
+	isPrevious
+	isFuture
+	isPast
+	team {
+		id
+		key
+		name
+	}
+`
+
+// CycleFilter builds a CycleFilter for a cycle reference: "current", "next",
+// "previous", a cycle number, or a cycle ID
+func CycleFilter(ref string) (map[string]interface{}, error) {
+	ref = strings.TrimSpace(ref)
+	switch strings.ToLower(ref) {
+	case "":
+		return nil, fmt.Errorf("empty cycle reference")
+	case "current", "active":
+		return map[string]interface{}{"isActive": map[string]interface{}{"eq": true}}, nil
+	case "next":
+		return map[string]interface{}{"isNext": map[string]interface{}{"eq": true}}, nil
+	case "previous", "prev", "last":
+		return map[string]interface{}{"isPrevious": map[string]interface{}{"eq": true}}, nil
+	}
+
+	if number, err := strconv.Atoi(ref); err == nil {
+		if number <= 0 {
+			return nil, fmt.Errorf("invalid cycle number: %d", number)
+		}
+		return map[string]interface{}{"number": map[string]interface{}{"eq": number}}, nil
+	}
+
+	return map[string]interface{}{"id": map[string]interface{}{"eq": ref}}, nil
+}
+
+// GetCycles returns cycles matching the filter
+func (c *Client) GetCycles(ctx context.Context, filter map[string]interface{}, first int, after string) (*Cycles, error) {
+	query := `
+		query Cycles($filter: CycleFilter, $first: Int, $after: String) {
+			cycles(filter: $filter, first: $first, after: $after, orderBy: createdAt) {
+				nodes {` + cycleFields + `}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"first": first,
+	}
+	if len(filter) > 0 {
+		variables["filter"] = filter
+	}
+	if after != "" {
+		variables["after"] = after
+	}
+
+	var response struct {
+		Cycles Cycles `json:"cycles"`
+	}
+
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return nil, err
+	}
+
+	return &response.Cycles, nil
+}
+
+// CyclePages returns a PageFetcher over GetCycles
+func (c *Client) CyclePages(filter map[string]interface{}) PageFetcher[Cycle] {
+	return func(ctx context.Context, first int, after string) ([]Cycle, PageInfo, error) {
+		cycles, err := c.GetCycles(ctx, filter, first, after)
+		if err != nil {
+			return nil, PageInfo{}, err
+		}
+		return cycles.Nodes, cycles.PageInfo, nil
+	}
+}
+
+// cycleIssueFields are the fields of the issues listed with a cycle
+const cycleIssueFields = `
+	id
+	identifier
+	title
+	priority
+	estimate
+	url
+	createdAt
+	team {
+		key
+	}
+	state {
+		name
+		type
+		color
+	}
+	assignee {
+		name
+		email
+	}
+`
+
+// GetCycle returns a cycle by ID along with all of its issues
+func (c *Client) GetCycle(ctx context.Context, id string) (*Cycle, error) {
+	query := `
+		query Cycle($id: String!, $first: Int) {
+			cycle(id: $id) {` + cycleFields + `
+				issues(first: $first) {
+					nodes {` + cycleIssueFields + `}
+					pageInfo {
+						hasNextPage
+						endCursor
+					}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id":    id,
+		"first": MaxPageSize,
+	}
+
+	var response struct {
+		Cycle *Cycle `json:"cycle"`
+	}
+
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return nil, err
+	}
+
+	cycle := response.Cycle
+	if cycle == nil || cycle.ID == "" {
+		return nil, newNotFoundError("cycle", id)
+	}
+
+	if cycle.Issues != nil && cycle.Issues.PageInfo.HasNextPage {
+		rest, err := NewPaginator(resumePages(c.CycleIssuePages(cycle.ID), cycle.Issues.PageInfo.EndCursor), 0).All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		cycle.Issues.Nodes = append(cycle.Issues.Nodes, rest...)
+		cycle.Issues.PageInfo = PageInfo{}
+	}
+
+	return cycle, nil
+}
+
+// GetCycleIssues returns one page of a cycle's issues
+func (c *Client) GetCycleIssues(ctx context.Context, cycleID string, first int, after string) (*Issues, error) {
+	query := `
+		query CycleIssues($id: String!, $first: Int, $after: String) {
+			cycle(id: $id) {
+				id
+				issues(first: $first, after: $after) {
+					nodes {` + cycleIssueFields + `}
+					pageInfo {
+						hasNextPage
+						endCursor
+					}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id":    cycleID,
+		"first": first,
+	}
+	if after != "" {
+		variables["after"] = after
+	}
+
+	var response struct {
+		Cycle *struct {
+			ID     string `json:"id"`
+			Issues Issues `json:"issues"`
+		} `json:"cycle"`
+	}
+
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return nil, err
+	}
+
+	if response.Cycle == nil || response.Cycle.ID == "" {
+		return nil, newNotFoundError("cycle", cycleID)
+	}
+
+	return &response.Cycle.Issues, nil
+}
+
+// CycleIssuePages returns a PageFetcher over GetCycleIssues
+func (c *Client) CycleIssuePages(cycleID string) PageFetcher[Issue] {
+	return func(ctx context.Context, first int, after string) ([]Issue, PageInfo, error) {
+		issues, err := c.GetCycleIssues(ctx, cycleID, first, after)
+		if err != nil {
+			return nil, PageInfo{}, err
+		}
+		return issues.Nodes, issues.PageInfo, nil
+	}
+}
+
+// FindTeamCycle resolves a cycle reference (see CycleFilter) within a team.
+// An empty teamKey searches every team, which only makes sense for IDs.
+func (c *Client) FindTeamCycle(ctx context.Context, teamKey, ref string) (*Cycle, error) {
+	filter, err := CycleFilter(ref)
+	if err != nil {
+		return nil, err
+	}
+	if teamKey != "" {
+		filter["team"] = map[string]interface{}{"key": map[string]interface{}{"eq": teamKey}}
+	}
+
+	cycles, err := c.GetCycles(ctx, filter, 1, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if len(cycles.Nodes) == 0 {
+		if teamKey != "" {
+			return nil, newNotFoundError("cycle", fmt.Sprintf("%s in team %s", ref, teamKey))
+		}
+		return nil, newNotFoundError("cycle", ref)
+	}
+
+	return &cycles.Nodes[0], nil
+}
@@ -0,0 +1,173 @@
+package api
+
+import (
+	"context"
+	"fmt"
+)
+
+const documentFields = `
+	id
+	slugId
+	title
+	content
+	icon
+	color
+	url
+	createdAt
+	updatedAt
+	creator {
+		id
+		name
+		email
+	}
+	updatedBy {
+		id
+		name
+		email
+	}
+	project {
+		id
+		name
+		slugId
+	}
+`
+
+// GetDocuments returns documents matching the filter, including their content
+func (c *Client) GetDocuments(ctx context.Context, filter map[string]interface{}, first int, after string) (*Documents, error) {
+	query := `
+		query Documents($filter: DocumentFilter, $first: Int, $after: String) {
+			documents(filter: $filter, first: $first, after: $after) {
+				nodes {` + documentFields + `}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"first": first,
+	}
+	if len(filter) > 0 {
+		variables["filter"] = filter
+	}
+	if after != "" {
+		variables["after"] = after
+	}
+
+	var response struct {
+		Documents Documents `json:"documents"`
+	}
+
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return nil, err
+	}
+
+	return &response.Documents, nil
+}
+
+// DocumentPages returns a PageFetcher over GetDocuments
+func (c *Client) DocumentPages(filter map[string]interface{}) PageFetcher[Document] {
+	return func(ctx context.Context, first int, after string) ([]Document, PageInfo, error) {
+		documents, err := c.GetDocuments(ctx, filter, first, after)
+		if err != nil {
+			return nil, PageInfo{}, err
+		}
+		return documents.Nodes, documents.PageInfo, nil
+	}
+}
+
+// GetDocument returns a document by ID or slug
+func (c *Client) GetDocument(ctx context.Context, id string) (*Document, error) {
+	query := `
+		query Document($id: String!) {
+			document(id: $id) {` + documentFields + `}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": id,
+	}
+
+	var response struct {
+		Document *Document `json:"document"`
+	}
+
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return nil, err
+	}
+
+	if response.Document == nil || response.Document.ID == "" {
+		return nil, newNotFoundError("document", id)
+	}
+
+	return response.Document, nil
+}
+
+// CreateDocument creates a new document
+func (c *Client) CreateDocument(ctx context.Context, input map[string]interface{}) (*Document, error) {
+	query := `
+		mutation CreateDocument($input: DocumentCreateInput!) {
+			documentCreate(input: $input) {
+				success
+				document {` + documentFields + `}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	var response struct {
+		DocumentCreate struct {
+			Success  bool      `json:"success"`
+			Document *Document `json:"document"`
+		} `json:"documentCreate"`
+	}
+
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return nil, err
+	}
+
+	if !response.DocumentCreate.Success || response.DocumentCreate.Document == nil {
+		return nil, fmt.Errorf("documentCreate failed")
+	}
+
+	return response.DocumentCreate.Document, nil
+}
+
+// UpdateDocument updates a document's fields
+func (c *Client) UpdateDocument(ctx context.Context, id string, input map[string]interface{}) (*Document, error) {
+	query := `
+		mutation UpdateDocument($id: String!, $input: DocumentUpdateInput!) {
+			documentUpdate(id: $id, input: $input) {
+				success
+				document {` + documentFields + `}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id":    id,
+		"input": input,
+	}
+
+	var response struct {
+		DocumentUpdate struct {
+			Success  bool      `json:"success"`
+			Document *Document `json:"document"`
+		} `json:"documentUpdate"`
+	}
+
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return nil, err
+	}
+
+	if !response.DocumentUpdate.Success || response.DocumentUpdate.Document == nil {
+		return nil, fmt.Errorf("documentUpdate failed")
+	}
+
+	return response.DocumentUpdate.Document, nil
+}
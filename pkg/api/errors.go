@@ -0,0 +1,159 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// GraphQL extension codes returned by Linear
+const (
+	CodeAuthentication = "AUTHENTICATION_ERROR"
+	CodeForbidden      = "FORBIDDEN"
+	CodeRateLimited    = "RATELIMITED"
+	CodeInvalidInput   = "INVALID_INPUT"
+	CodeEntityNotFound = "ENTITY_NOT_FOUND"
+	CodeInternal       = "INTERNAL_SERVER_ERROR"
+)
+
+// Error is returned by Client.Execute when Linear rejects a request,
+// either with a non-200 HTTP status or with GraphQL errors in the payload.
+// Use errors.As to inspect it.
+type Error struct {
+	StatusCode int            `json:"statusCode,omitempty"`
+	Code       string         `json:"code,omitempty"`
+	Path       []interface{}  `json:"path,omitempty"`
+	Message    string         `json:"message"`
+	Errors     []GraphQLError `json:"errors,omitempty"`
+}
+
+func (e *Error) Error() string {
+	msg := e.Message
+	if msg == "" {
+		msg = http.StatusText(e.StatusCode)
+	}
+
+	if len(e.Path) > 0 {
+		parts := make([]string, len(e.Path))
+		for i, p := range e.Path {
+			parts[i] = fmt.Sprint(p)
+		}
+		msg = fmt.Sprintf("%s (at %s)", msg, strings.Join(parts, "."))
+	}
+
+	if e.Code != "" {
+		msg = fmt.Sprintf("%s [%s]", msg, e.Code)
+	}
+
+	if len(e.Errors) > 1 {
+		msg = fmt.Sprintf("%s (and %d more error(s))", msg, len(e.Errors)-1)
+	}
+
+	if e.StatusCode != 0 && e.StatusCode != http.StatusOK {
+		return fmt.Sprintf("API request failed with status %d: %s", e.StatusCode, msg)
+	}
+	return msg
+}
+
+// IsNotFound reports whether the entity requested does not exist
+func (e *Error) IsNotFound() bool {
+	if e.StatusCode == http.StatusNotFound || e.Code == CodeEntityNotFound {
+		return true
+	}
+	return strings.HasPrefix(strings.ToLower(e.Message), "entity not found")
+}
+
+// IsAuth reports whether the request failed authentication or authorization
+func (e *Error) IsAuth() bool {
+	switch e.Code {
+	case CodeAuthentication, CodeForbidden:
+		return true
+	}
+	return e.StatusCode == http.StatusUnauthorized || e.StatusCode == http.StatusForbidden
+}
+
+// IsRateLimited reports whether the request was rejected by Linear's rate limiter
+func (e *Error) IsRateLimited() bool {
+	return e.Code == CodeRateLimited || e.StatusCode == http.StatusTooManyRequests
+}
+
+// IsTransient reports whether retrying the request later may succeed
+func (e *Error) IsTransient() bool {
+	return e.IsRateLimited() || e.Code == CodeInternal || e.StatusCode >= http.StatusInternalServerError
+}
+
+// IsNotFound reports whether err is an *Error for a missing entity
+func IsNotFound(err error) bool {
+	var apiErr *Error
+	return errors.As(err, &apiErr) && apiErr.IsNotFound()
+}
+
+// IsAuthError reports whether err is an *Error for failed authentication or authorization
+func IsAuthError(err error) bool {
+	var apiErr *Error
+	return errors.As(err, &apiErr) && apiErr.IsAuth()
+}
+
+// IsRateLimited reports whether err is an *Error from Linear's rate limiter
+func IsRateLimited(err error) bool {
+	var apiErr *Error
+	return errors.As(err, &apiErr) && apiErr.IsRateLimited()
+}
+
+// IsTransient reports whether err is worth retrying later, including network failures
+func IsTransient(err error) bool {
+	var apiErr *Error
+	if errors.As(err, &apiErr) {
+		return apiErr.IsTransient()
+	}
+	var netErr *NetworkError
+	return errors.As(err, &netErr)
+}
+
+// NetworkError wraps a failure to reach the API at all
+type NetworkError struct {
+	Err error
+}
+
+func (e *NetworkError) Error() string {
+	return fmt.Sprintf("request failed: %v", e.Err)
+}
+
+func (e *NetworkError) Unwrap() error {
+	return e.Err
+}
+
+// newGraphQLError builds an *Error from the GraphQL errors of a response
+func newGraphQLError(statusCode int, gqlErrors []GraphQLError) *Error {
+	first := gqlErrors[0]
+	return &Error{
+		StatusCode: statusCode,
+		Code:       first.Extensions.Code,
+		Path:       first.Path,
+		Message:    first.Message,
+		Errors:     gqlErrors,
+	}
+}
+
+// newHTTPError builds an *Error from a non-200 response, using its GraphQL errors when present
+func newHTTPError(statusCode int, body []byte) *Error {
+	var gqlResp GraphQLResponse
+	if err := json.Unmarshal(body, &gqlResp); err == nil && len(gqlResp.Errors) > 0 {
+		return newGraphQLError(statusCode, gqlResp.Errors)
+	}
+
+	return &Error{
+		StatusCode: statusCode,
+		Message:    strings.TrimSpace(string(body)),
+	}
+}
+
+// newNotFoundError reports an entity the API returned no data for
+func newNotFoundError(entity, ref string) *Error {
+	return &Error{
+		Code:    CodeEntityNotFound,
+		Message: fmt.Sprintf("%s not found: %s", entity, ref),
+	}
+}
@@ -0,0 +1,155 @@
+package api
+
+import (
+	"context"
+)
+
+// GetIssueHistory returns one page of an issue's history, oldest first
+func (c *Client) GetIssueHistory(ctx context.Context, issueID string, first int, after string) (*IssueHistory, error) {
+	query := `
+		query IssueHistory($id: String!, $first: Int, $after: String) {
+			issue(id: $id) {
+				id
+				history(first: $first, after: $after, orderBy: createdAt) {
+					nodes {
+						id
+						createdAt
+						updatedAt
+						actor {
+							id
+							name
+							email
+						}
+						fromAssignee {
+							id
+							name
+						}
+						toAssignee {
+							id
+							name
+						}
+						fromState {
+							id
+							name
+							type
+						}
+						toState {
+							id
+							name
+							type
+						}
+						fromPriority
+						toPriority
+						fromTitle
+						toTitle
+						fromCycle {
+							id
+							number
+							name
+						}
+						toCycle {
+							id
+							number
+							name
+						}
+						fromProject {
+							id
+							name
+						}
+						toProject {
+							id
+							name
+						}
+						addedLabelIds
+						removedLabelIds
+					}
+					pageInfo {
+						hasNextPage
+						endCursor
+					}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id":    issueID,
+		"first": first,
+	}
+	if after != "" {
+		variables["after"] = after
+	}
+
+	var response struct {
+		Issue *struct {
+			ID      string       `json:"id"`
+			History IssueHistory `json:"history"`
+		} `json:"issue"`
+	}
+
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return nil, err
+	}
+
+	if response.Issue == nil || response.Issue.ID == "" {
+		return nil, newNotFoundError("issue", issueID)
+	}
+
+	return &response.Issue.History, nil
+}
+
+// IssueHistoryPages returns a PageFetcher over GetIssueHistory
+func (c *Client) IssueHistoryPages(issueID string) PageFetcher[IssueHistoryEntry] {
+	return func(ctx context.Context, first int, after string) ([]IssueHistoryEntry, PageInfo, error) {
+		history, err := c.GetIssueHistory(ctx, issueID, first, after)
+		if err != nil {
+			return nil, PageInfo{}, err
+		}
+		return history.Nodes, history.PageInfo, nil
+	}
+}
+
+// GetLabelsByID looks up labels by ID, including archived ones. IDs that no
+// longer exist are simply missing from the result.
+func (c *Client) GetLabelsByID(ctx context.Context, ids []string) ([]Label, error) {
+	if len(ids) == 0 {
+		return []Label{}, nil
+	}
+
+	query := `
+		query LabelsByID($ids: [ID!], $first: Int) {
+			issueLabels(filter: { id: { in: $ids } }, first: $first, includeArchived: true) {
+				nodes {
+					id
+					name
+					color
+				}
+			}
+		}
+	`
+
+	labels := []Label{}
+	for start := 0; start < len(ids); start += MaxPageSize {
+		end := start + MaxPageSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+
+		variables := map[string]interface{}{
+			"ids":   ids[start:end],
+			"first": end - start,
+		}
+
+		var response struct {
+			IssueLabels Labels `json:"issueLabels"`
+		}
+
+		if err := c.Execute(ctx, query, variables, &response); err != nil {
+			return nil, err
+		}
+
+		labels = append(labels, response.IssueLabels.Nodes...)
+	}
+
+	return labels, nil
+}
@@ -0,0 +1,401 @@
+package api
+
+import (
+	"context"
+	"fmt"
+)
+
+// rollupProjectFields are the project fields shown in initiative and roadmap rollups
+const rollupProjectFields = `
+	id
+	slugId
+	name
+	state
+	progress
+	health
+	startDate
+	targetDate
+	url
+	lead {
+		id
+		name
+		email
+	}
+`
+
+// rollupPageSize is the page size for initiative and roadmap lists. Each node
+// nests up to 100 projects, so larger pages exceed Linear's 10,000 point
+// limit for a single query.
+const rollupPageSize = 50
+
+// GetInitiatives returns one page of initiatives with their project IDs
+func (c *Client) GetInitiatives(ctx context.Context, first int, after string) (*Initiatives, error) {
+	query := `
+		query Initiatives($first: Int, $after: String) {
+			initiatives(first: $first, after: $after) {
+				nodes {
+					id
+					slugId
+					name
+					description
+					status
+					health
+					targetDate
+					url
+					createdAt
+					updatedAt
+					owner {
+						id
+						name
+						email
+					}
+					projects(first: 100) {
+						nodes {
+							id
+							progress
+						}
+					}
+				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"first": first,
+	}
+	if after != "" {
+		variables["after"] = after
+	}
+
+	var response struct {
+		Initiatives Initiatives `json:"initiatives"`
+	}
+
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return nil, err
+	}
+
+	return &response.Initiatives, nil
+}
+
+// InitiativePages returns a PageFetcher over GetInitiatives, capped at
+// rollupPageSize initiatives a page
+func (c *Client) InitiativePages() PageFetcher[Initiative] {
+	return func(ctx context.Context, first int, after string) ([]Initiative, PageInfo, error) {
+		if first > rollupPageSize {
+			first = rollupPageSize
+		}
+		initiatives, err := c.GetInitiatives(ctx, first, after)
+		if err != nil {
+			return nil, PageInfo{}, err
+		}
+		return initiatives.Nodes, initiatives.PageInfo, nil
+	}
+}
+
+// GetInitiativeNames returns one page of initiatives with only their IDs,
+// slugs and names, for resolving an initiative by name
+func (c *Client) GetInitiativeNames(ctx context.Context, first int, after string) (*Initiatives, error) {
+	query := `
+		query InitiativeNames($first: Int, $after: String) {
+			initiatives(first: $first, after: $after) {
+				nodes {
+					id
+					slugId
+					name
+				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"first": first,
+	}
+	if after != "" {
+		variables["after"] = after
+	}
+
+	var response struct {
+		Initiatives Initiatives `json:"initiatives"`
+	}
+
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return nil, err
+	}
+
+	return &response.Initiatives, nil
+}
+
+// InitiativeNamePages returns a PageFetcher over GetInitiativeNames
+func (c *Client) InitiativeNamePages() PageFetcher[Initiative] {
+	return func(ctx context.Context, first int, after string) ([]Initiative, PageInfo, error) {
+		initiatives, err := c.GetInitiativeNames(ctx, first, after)
+		if err != nil {
+			return nil, PageInfo{}, err
+		}
+		return initiatives.Nodes, initiatives.PageInfo, nil
+	}
+}
+
+// GetInitiative returns an initiative, by ID or slug, with its projects
+func (c *Client) GetInitiative(ctx context.Context, id string) (*Initiative, error) {
+	query := `
+		query Initiative($id: String!, $first: Int) {
+			initiative(id: $id) {
+				id
+				slugId
+				name
+				description
+				status
+				health
+				targetDate
+				url
+				createdAt
+				updatedAt
+				owner {
+					id
+					name
+					email
+				}
+				projects(first: $first) {
+					nodes {` + rollupProjectFields + `}
+					pageInfo {
+						hasNextPage
+						endCursor
+					}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id":    id,
+		"first": MaxPageSize,
+	}
+
+	var response struct {
+		Initiative *Initiative `json:"initiative"`
+	}
+
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return nil, err
+	}
+
+	initiative := response.Initiative
+	if initiative == nil || initiative.ID == "" {
+		return nil, newNotFoundError("initiative", id)
+	}
+
+	if initiative.Projects != nil && initiative.Projects.PageInfo.HasNextPage {
+		rest, err := NewPaginator(resumePages(c.InitiativeProjectPages(initiative.ID), initiative.Projects.PageInfo.EndCursor), 0).All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		initiative.Projects.Nodes = append(initiative.Projects.Nodes, rest...)
+		initiative.Projects.PageInfo = PageInfo{}
+	}
+
+	return initiative, nil
+}
+
+// GetInitiativeProjects returns one page of the projects in an initiative
+func (c *Client) GetInitiativeProjects(ctx context.Context, initiativeID string, first int, after string) (*Projects, error) {
+	query := `
+		query InitiativeProjects($id: String!, $first: Int, $after: String) {
+			initiative(id: $id) {
+				id
+				projects(first: $first, after: $after) {
+					nodes {` + rollupProjectFields + `}
+					pageInfo {
+						hasNextPage
+						endCursor
+					}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id":    initiativeID,
+		"first": first,
+	}
+	if after != "" {
+		variables["after"] = after
+	}
+
+	var response struct {
+		Initiative *struct {
+			ID       string   `json:"id"`
+			Projects Projects `json:"projects"`
+		} `json:"initiative"`
+	}
+
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return nil, err
+	}
+
+	if response.Initiative == nil || response.Initiative.ID == "" {
+		return nil, newNotFoundError("initiative", initiativeID)
+	}
+
+	return &response.Initiative.Projects, nil
+}
+
+// InitiativeProjectPages returns a PageFetcher over GetInitiativeProjects
+func (c *Client) InitiativeProjectPages(initiativeID string) PageFetcher[Project] {
+	return func(ctx context.Context, first int, after string) ([]Project, PageInfo, error) {
+		projects, err := c.GetInitiativeProjects(ctx, initiativeID, first, after)
+		if err != nil {
+			return nil, PageInfo{}, err
+		}
+		return projects.Nodes, projects.PageInfo, nil
+	}
+}
+
+// LinkInitiativeProject adds a project to an initiative
+func (c *Client) LinkInitiativeProject(ctx context.Context, initiativeID, projectID string) error {
+	query := `
+		mutation LinkInitiativeProject($input: InitiativeToProjectCreateInput!) {
+			initiativeToProjectCreate(input: $input) {
+				success
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": map[string]interface{}{
+			"initiativeId": initiativeID,
+			"projectId":    projectID,
+		},
+	}
+
+	var response struct {
+		InitiativeToProjectCreate struct {
+			Success bool `json:"success"`
+		} `json:"initiativeToProjectCreate"`
+	}
+
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return err
+	}
+
+	if !response.InitiativeToProjectCreate.Success {
+		return fmt.Errorf("initiativeToProjectCreate failed")
+	}
+
+	return nil
+}
+
+// UnlinkInitiativeProject removes a project from an initiative
+func (c *Client) UnlinkInitiativeProject(ctx context.Context, initiativeID, projectID string) error {
+	linkID, err := c.findInitiativeToProject(ctx, initiativeID, projectID)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		mutation UnlinkInitiativeProject($id: String!) {
+			initiativeToProjectDelete(id: $id) {
+				success
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": linkID,
+	}
+
+	var response struct {
+		InitiativeToProjectDelete struct {
+			Success bool `json:"success"`
+		} `json:"initiativeToProjectDelete"`
+	}
+
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return err
+	}
+
+	if !response.InitiativeToProjectDelete.Success {
+		return fmt.Errorf("initiativeToProjectDelete failed")
+	}
+
+	return nil
+}
+
+// findInitiativeToProject pages through initiative/project links to find the
+// one joining the given initiative and project
+func (c *Client) findInitiativeToProject(ctx context.Context, initiativeID, projectID string) (string, error) {
+	query := `
+		query InitiativeToProjects($first: Int, $after: String) {
+			initiativeToProjects(first: $first, after: $after) {
+				nodes {
+					id
+					initiative {
+						id
+					}
+					project {
+						id
+					}
+				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+			}
+		}
+	`
+
+	type link struct {
+		ID         string `json:"id"`
+		Initiative struct {
+			ID string `json:"id"`
+		} `json:"initiative"`
+		Project struct {
+			ID string `json:"id"`
+		} `json:"project"`
+	}
+
+	fetch := func(ctx context.Context, first int, after string) ([]link, PageInfo, error) {
+		variables := map[string]interface{}{
+			"first": first,
+		}
+		if after != "" {
+			variables["after"] = after
+		}
+
+		var response struct {
+			InitiativeToProjects struct {
+				Nodes    []link   `json:"nodes"`
+				PageInfo PageInfo `json:"pageInfo"`
+			} `json:"initiativeToProjects"`
+		}
+
+		if err := c.Execute(ctx, query, variables, &response); err != nil {
+			return nil, PageInfo{}, err
+		}
+		return response.InitiativeToProjects.Nodes, response.InitiativeToProjects.PageInfo, nil
+	}
+
+	paginator := NewPaginator(fetch, 0)
+	for paginator.HasNext() {
+		links, err := paginator.Next(ctx)
+		if err != nil {
+			return "", err
+		}
+		for _, l := range links {
+			if l.Initiative.ID == initiativeID && l.Project.ID == projectID {
+				return l.ID, nil
+			}
+		}
+	}
+
+	return "", newNotFoundError("initiative project link", projectID)
+}
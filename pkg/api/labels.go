@@ -0,0 +1,167 @@
+package api
+
+import (
+	"context"
+	"fmt"
+)
+
+const labelFields = `
+	id
+	name
+	color
+	description
+	isGroup
+	parent {
+		id
+		name
+	}
+	team {
+		id
+		key
+		name
+	}
+`
+
+// GetLabels returns issue labels matching the filter
+func (c *Client) GetLabels(ctx context.Context, filter map[string]interface{}, first int, after string) (*Labels, error) {
+	query := `
+		query IssueLabels($filter: IssueLabelFilter, $first: Int, $after: String) {
+			issueLabels(filter: $filter, first: $first, after: $after) {
+				nodes {` + labelFields + `}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"first": first,
+	}
+	if len(filter) > 0 {
+		variables["filter"] = filter
+	}
+	if after != "" {
+		variables["after"] = after
+	}
+
+	var response struct {
+		IssueLabels Labels `json:"issueLabels"`
+	}
+
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return nil, err
+	}
+
+	return &response.IssueLabels, nil
+}
+
+// LabelPages returns a PageFetcher over GetLabels
+func (c *Client) LabelPages(filter map[string]interface{}) PageFetcher[Label] {
+	return func(ctx context.Context, first int, after string) ([]Label, PageInfo, error) {
+		labels, err := c.GetLabels(ctx, filter, first, after)
+		if err != nil {
+			return nil, PageInfo{}, err
+		}
+		return labels.Nodes, labels.PageInfo, nil
+	}
+}
+
+// CreateLabel creates an issue label or label group
+func (c *Client) CreateLabel(ctx context.Context, input map[string]interface{}) (*Label, error) {
+	query := `
+		mutation CreateIssueLabel($input: IssueLabelCreateInput!) {
+			issueLabelCreate(input: $input) {
+				success
+				issueLabel {` + labelFields + `}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	var response struct {
+		IssueLabelCreate struct {
+			Success    bool   `json:"success"`
+			IssueLabel *Label `json:"issueLabel"`
+		} `json:"issueLabelCreate"`
+	}
+
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return nil, err
+	}
+
+	if !response.IssueLabelCreate.Success || response.IssueLabelCreate.IssueLabel == nil {
+		return nil, fmt.Errorf("issueLabelCreate failed")
+	}
+
+	return response.IssueLabelCreate.IssueLabel, nil
+}
+
+// UpdateLabel updates an issue label's fields
+func (c *Client) UpdateLabel(ctx context.Context, id string, input map[string]interface{}) (*Label, error) {
+	query := `
+		mutation UpdateIssueLabel($id: String!, $input: IssueLabelUpdateInput!) {
+			issueLabelUpdate(id: $id, input: $input) {
+				success
+				issueLabel {` + labelFields + `}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id":    id,
+		"input": input,
+	}
+
+	var response struct {
+		IssueLabelUpdate struct {
+			Success    bool   `json:"success"`
+			IssueLabel *Label `json:"issueLabel"`
+		} `json:"issueLabelUpdate"`
+	}
+
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return nil, err
+	}
+
+	if !response.IssueLabelUpdate.Success || response.IssueLabelUpdate.IssueLabel == nil {
+		return nil, fmt.Errorf("issueLabelUpdate failed")
+	}
+
+	return response.IssueLabelUpdate.IssueLabel, nil
+}
+
+// DeleteLabel deletes an issue label, removing it from the issues carrying it
+func (c *Client) DeleteLabel(ctx context.Context, id string) error {
+	query := `
+		mutation DeleteIssueLabel($id: String!) {
+			issueLabelDelete(id: $id) {
+				success
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": id,
+	}
+
+	var response struct {
+		IssueLabelDelete struct {
+			Success bool `json:"success"`
+		} `json:"issueLabelDelete"`
+	}
+
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return err
+	}
+
+	if !response.IssueLabelDelete.Success {
+		return fmt.Errorf("issueLabelDelete failed")
+	}
+
+	return nil
+}
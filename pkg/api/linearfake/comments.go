@@ -0,0 +1,205 @@
+package linearfake
+
+import (
+	"strings"
+
+	"github.com/yjiky/linctl/pkg/api"
+)
+
+// lookupComment finds the comment an operation's id variable names
+func (s *Server) lookupComment(variables map[string]interface{}) (*comment, error) {
+	c, ok := s.findComment(stringVar(variables, "id"))
+	if !ok {
+		return nil, notFound("Comment")
+	}
+	return c, nil
+}
+
+// queryIssueComments returns every comment on the issue, replies included
+func (s *Server) queryIssueComments(variables map[string]interface{}) (interface{}, error) {
+	i, err := s.lookupIssue(variables)
+	if err != nil {
+		return nil, err
+	}
+	comments := []api.Comment{}
+	for _, c := range s.comments {
+		if c.issueID == i.id {
+			comments = append(comments, s.commentView(c))
+		}
+	}
+	orderNodes(comments, variables["orderBy"], commentUpdatedAt)
+	return map[string]interface{}{
+		"issue": map[string]interface{}{
+			"id":       i.id,
+			"comments": paginate(comments, variables, commentID),
+		},
+	}, nil
+}
+
+func (s *Server) queryComment(variables map[string]interface{}) (interface{}, error) {
+	c, err := s.lookupComment(variables)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"comment": s.commentView(c)}, nil
+}
+
+// createComment adds a comment or, with parentId, a reply. Like Linear,
+// replies to a reply join the thread of its top-level comment.
+func (s *Server) createComment(variables map[string]interface{}) (interface{}, error) {
+	input := inputVar(variables)
+	i, ok := s.findIssue(stringOf(input["issueId"]))
+	if !ok {
+		return nil, notFound("Issue")
+	}
+	body := stringOf(input["body"])
+	if strings.TrimSpace(body) == "" {
+		return nil, invalidInput("body must not be empty")
+	}
+
+	created := now()
+	c := &comment{
+		id:        s.newID(),
+		issueID:   i.id,
+		userID:    s.viewerID,
+		body:      body,
+		createdAt: created,
+		updatedAt: created,
+	}
+	for field, value := range input {
+		switch field {
+		case "issueId", "body":
+		case "parentId":
+			parent, ok := s.findComment(stringOf(value))
+			if !ok {
+				return nil, notFound("Comment")
+			}
+			if parent.issueID != i.id {
+				return nil, invalidInput("parentId must be a comment on the same issue")
+			}
+			for parent.parentID != "" {
+				parent, _ = s.findComment(parent.parentID)
+			}
+			c.parentID = parent.id
+		default:
+			return nil, invalidInput("Field %q is not defined by type CommentCreateInput", field)
+		}
+	}
+
+	s.comments = append(s.comments, c)
+	return map[string]interface{}{
+		"commentCreate": map[string]interface{}{
+			"success": true,
+			"comment": s.commentView(c),
+		},
+	}, nil
+}
+
+func (s *Server) updateComment(variables map[string]interface{}) (interface{}, error) {
+	c, err := s.lookupComment(variables)
+	if err != nil {
+		return nil, err
+	}
+	input := inputVar(variables)
+	body := stringOf(input["body"])
+	if strings.TrimSpace(body) == "" {
+		return nil, invalidInput("body must not be empty")
+	}
+	if c.userID != s.viewerID {
+		return nil, &api.Error{Code: api.CodeForbidden, Message: "You can only edit your own comments"}
+	}
+
+	edited := now()
+	c.body = body
+	c.updatedAt = edited
+	c.editedAt = &edited
+	return map[string]interface{}{
+		"commentUpdate": map[string]interface{}{
+			"success": true,
+			"comment": s.commentView(c),
+		},
+	}, nil
+}
+
+// deleteComment deletes a comment along with its replies
+func (s *Server) deleteComment(variables map[string]interface{}) (interface{}, error) {
+	c, err := s.lookupComment(variables)
+	if err != nil {
+		return nil, err
+	}
+	if c.userID != s.viewerID {
+		return nil, &api.Error{Code: api.CodeForbidden, Message: "You can only delete your own comments"}
+	}
+
+	kept := []*comment{}
+	for _, candidate := range s.comments {
+		if candidate != c && candidate.parentID != c.id {
+			kept = append(kept, candidate)
+		}
+	}
+	s.comments = kept
+	return success("commentDelete"), nil
+}
+
+func (s *Server) resolveComment(variables map[string]interface{}) (interface{}, error) {
+	c, err := s.lookupComment(variables)
+	if err != nil {
+		return nil, err
+	}
+	if c.parentID != "" {
+		return nil, invalidInput("Only top-level comments can be resolved")
+	}
+	resolved := now()
+	c.resolvedAt = &resolved
+	c.resolvingUserID = s.viewerID
+	return success("commentResolve"), nil
+}
+
+func (s *Server) unresolveComment(variables map[string]interface{}) (interface{}, error) {
+	c, err := s.lookupComment(variables)
+	if err != nil {
+		return nil, err
+	}
+	c.resolvedAt = nil
+	c.resolvingUserID = ""
+	return success("commentUnresolve"), nil
+}
+
+func (s *Server) createReaction(variables map[string]interface{}) (interface{}, error) {
+	input := inputVar(variables)
+	c, ok := s.findComment(stringOf(input["commentId"]))
+	if !ok {
+		return nil, notFound("Comment")
+	}
+	emoji := stringOf(input["emoji"])
+	if emoji == "" {
+		return nil, invalidInput("emoji must not be empty")
+	}
+	for _, r := range c.reactions {
+		if r.emoji == emoji && r.userID == s.viewerID {
+			return nil, invalidInput("You already reacted with %s", emoji)
+		}
+	}
+
+	r := &reaction{id: s.newID(), emoji: emoji, userID: s.viewerID, createdAt: now()}
+	c.reactions = append(c.reactions, r)
+	return map[string]interface{}{
+		"reactionCreate": map[string]interface{}{
+			"success":  true,
+			"reaction": s.reactionView(r),
+		},
+	}, nil
+}
+
+func (s *Server) deleteReaction(variables map[string]interface{}) (interface{}, error) {
+	id := stringVar(variables, "id")
+	for _, c := range s.comments {
+		for n, r := range c.reactions {
+			if r.id == id {
+				c.reactions = append(c.reactions[:n], c.reactions[n+1:]...)
+				return success("reactionDelete"), nil
+			}
+		}
+	}
+	return nil, notFound("Reaction")
+}
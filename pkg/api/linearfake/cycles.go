@@ -0,0 +1,56 @@
+package linearfake
+
+import (
+	"github.com/yjiky/linctl/pkg/api"
+)
+
+func (s *Server) queryCycles(variables map[string]interface{}) (interface{}, error) {
+	cycles := []api.Cycle{}
+	for _, c := range s.cycles {
+		cycles = append(cycles, s.cycleView(c, false))
+	}
+	cycles, err := filterNodes(cycles, variables["filter"])
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{
+		"cycles": paginate(cycles, variables, func(c api.Cycle) string { return c.ID }),
+	}, nil
+}
+
+// lookupCycle finds the cycle an operation's id variable names
+func (s *Server) lookupCycle(variables map[string]interface{}) (*cycle, error) {
+	id := stringVar(variables, "id")
+	for _, c := range s.cycles {
+		if c.ID == id {
+			return c, nil
+		}
+	}
+	return nil, notFound("Cycle")
+}
+
+// queryCycle returns a cycle with the first page of its issues
+func (s *Server) queryCycle(variables map[string]interface{}) (interface{}, error) {
+	c, err := s.lookupCycle(variables)
+	if err != nil {
+		return nil, err
+	}
+	view := s.cycleView(c, true)
+	cycle := generic(view).(map[string]interface{})
+	cycle["issues"] = paginate(view.Issues.Nodes, variables, issueID)
+	return map[string]interface{}{"cycle": cycle}, nil
+}
+
+// queryCycleIssues returns a page of the cycle's unarchived issues
+func (s *Server) queryCycleIssues(variables map[string]interface{}) (interface{}, error) {
+	c, err := s.lookupCycle(variables)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{
+		"cycle": map[string]interface{}{
+			"id":     c.ID,
+			"issues": paginate(s.cycleView(c, true).Issues.Nodes, variables, issueID),
+		},
+	}, nil
+}
@@ -0,0 +1,351 @@
+package linearfake
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/yjiky/linctl/pkg/api"
+)
+
+// Filters are evaluated against the JSON form of a view, the way Linear
+// evaluates them against its schema: a key names a field, a nested object
+// filters a related entity, and a collection matches when any of its nodes
+// does. Comparators not listed in compare are rejected so that a typo in a
+// command's filter fails the test instead of matching everything.
+
+// generic converts a view to its JSON form
+func generic(view interface{}) interface{} {
+	encoded, err := json.Marshal(view)
+	if err != nil {
+		panic(fmt.Sprintf("linearfake: cannot marshal view: %v", err))
+	}
+	var decoded interface{}
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		panic(fmt.Sprintf("linearfake: cannot unmarshal view: %v", err))
+	}
+	return decoded
+}
+
+// filterNodes keeps the views that match a filter variable
+func filterNodes[T any](nodes []T, filter interface{}) ([]T, error) {
+	conditions, _ := filter.(map[string]interface{})
+	if len(conditions) == 0 {
+		return nodes, nil
+	}
+
+	matched := []T{}
+	for _, node := range nodes {
+		ok, err := match(conditions, generic(node))
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			matched = append(matched, node)
+		}
+	}
+	return matched, nil
+}
+
+// match reports whether a value satisfies a filter object
+func match(filter map[string]interface{}, value interface{}) (bool, error) {
+	if nodes, ok := collection(value); ok {
+		return matchCollection(filter, nodes)
+	}
+
+	for key, arg := range filter {
+		var ok bool
+		var err error
+		switch key {
+		case "and", "or":
+			ok, err = matchLogical(key, arg, value)
+		default:
+			if isComparator(key) {
+				ok, err = compare(key, value, arg)
+				break
+			}
+			sub, isFilter := arg.(map[string]interface{})
+			if !isFilter {
+				return false, invalidInput("linearfake: filter on %q must be an object, got %v", key, arg)
+			}
+			object, _ := value.(map[string]interface{})
+			ok, err = match(sub, object[key])
+		}
+		if err != nil || !ok {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// matchCollection applies a filter to a connection's nodes. Without an
+// explicit some, every or length condition, any matching node will do.
+func matchCollection(filter map[string]interface{}, nodes []interface{}) (bool, error) {
+	rest := map[string]interface{}{}
+	for key, arg := range filter {
+		sub, _ := arg.(map[string]interface{})
+		switch key {
+		case "some", "every":
+			count, err := countMatches(sub, nodes)
+			if err != nil {
+				return false, err
+			}
+			if (key == "some" && count == 0) || (key == "every" && count != len(nodes)) {
+				return false, nil
+			}
+		case "length":
+			ok, err := match(sub, float64(len(nodes)))
+			if err != nil || !ok {
+				return false, err
+			}
+		default:
+			rest[key] = arg
+		}
+	}
+	if len(rest) == 0 {
+		return true, nil
+	}
+	count, err := countMatches(rest, nodes)
+	return count > 0, err
+}
+
+func countMatches(filter map[string]interface{}, nodes []interface{}) (int, error) {
+	count := 0
+	for _, node := range nodes {
+		ok, err := match(filter, node)
+		if err != nil {
+			return 0, err
+		}
+		if ok {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func matchLogical(op string, arg interface{}, value interface{}) (bool, error) {
+	filters, ok := arg.([]interface{})
+	if !ok {
+		return false, invalidInput("linearfake: %s must be a list of filters", op)
+	}
+	for _, f := range filters {
+		sub, _ := f.(map[string]interface{})
+		ok, err := match(sub, value)
+		if err != nil {
+			return false, err
+		}
+		if op == "or" && ok {
+			return true, nil
+		}
+		if op == "and" && !ok {
+			return false, nil
+		}
+	}
+	return op == "and", nil
+}
+
+// collection returns the nodes of a connection value
+func collection(value interface{}) ([]interface{}, bool) {
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, false
+	}
+	nodes, ok := object["nodes"].([]interface{})
+	return nodes, ok
+}
+
+func isComparator(key string) bool {
+	switch key {
+	case "eq", "neq", "in", "nin", "null", "eqIgnoreCase", "neqIgnoreCase",
+		"contains", "containsIgnoreCase", "notContains", "startsWith", "endsWith",
+		"gt", "gte", "lt", "lte":
+		return true
+	}
+	return false
+}
+
+func compare(op string, value, arg interface{}) (bool, error) {
+	switch op {
+	case "null":
+		want, _ := arg.(bool)
+		return (value == nil) == want, nil
+	case "eq":
+		return equal(value, arg), nil
+	case "neq":
+		return !equal(value, arg), nil
+	case "in", "nin":
+		list, ok := arg.([]interface{})
+		if !ok {
+			return false, invalidInput("linearfake: %s needs a list, got %v", op, arg)
+		}
+		found := false
+		for _, item := range list {
+			if equal(value, item) {
+				found = true
+			}
+		}
+		return found == (op == "in"), nil
+	}
+
+	if value == nil {
+		return false, nil
+	}
+
+	switch op {
+	case "eqIgnoreCase", "neqIgnoreCase", "contains", "containsIgnoreCase", "notContains", "startsWith", "endsWith":
+		have, want := fmt.Sprint(value), fmt.Sprint(arg)
+		switch op {
+		case "eqIgnoreCase":
+			return strings.EqualFold(have, want), nil
+		case "neqIgnoreCase":
+			return !strings.EqualFold(have, want), nil
+		case "contains":
+			return strings.Contains(have, want), nil
+		case "containsIgnoreCase":
+			return strings.Contains(strings.ToLower(have), strings.ToLower(want)), nil
+		case "notContains":
+			return !strings.Contains(have, want), nil
+		case "startsWith":
+			return strings.HasPrefix(have, want), nil
+		default:
+			return strings.HasSuffix(have, want), nil
+		}
+	}
+
+	cmp := order(value, arg)
+	switch op {
+	case "gt":
+		return cmp > 0, nil
+	case "gte":
+		return cmp >= 0, nil
+	case "lt":
+		return cmp < 0, nil
+	default:
+		return cmp <= 0, nil
+	}
+}
+
+func equal(a, b interface{}) bool {
+	if af, ok := a.(float64); ok {
+		if bf, ok := b.(float64); ok {
+			return af == bf
+		}
+	}
+	return a == b
+}
+
+// order compares numbers numerically, timestamps and dates chronologically
+// and anything else as strings
+func order(a, b interface{}) int {
+	if af, ok := a.(float64); ok {
+		if bf, ok := b.(float64); ok {
+			switch {
+			case af < bf:
+				return -1
+			case af > bf:
+				return 1
+			}
+			return 0
+		}
+	}
+
+	as, bs := fmt.Sprint(a), fmt.Sprint(b)
+	if at, ok := parseTime(as); ok {
+		if bt, ok := parseTime(bs); ok {
+			return at.Compare(bt)
+		}
+	}
+	return strings.Compare(as, bs)
+}
+
+func parseTime(value string) (time.Time, bool) {
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// orderNodes sorts views most recently updated first when the client asks for
+// orderBy: updatedAt; otherwise they stay in the order they were created
+func orderNodes[T any](nodes []T, orderBy interface{}, updatedAt func(T) time.Time) {
+	if orderBy != "updatedAt" {
+		return
+	}
+	sort.SliceStable(nodes, func(a, b int) bool {
+		return updatedAt(nodes[a]).After(updatedAt(nodes[b]))
+	})
+}
+
+// paginate returns one page of a connection, honouring first and after.
+// Cursors are node IDs.
+func paginate[T any](nodes []T, variables map[string]interface{}, id func(T) string) map[string]interface{} {
+	start := 0
+	if after := stringVar(variables, "after"); after != "" {
+		for n, node := range nodes {
+			if id(node) == after {
+				start = n + 1
+			}
+		}
+	}
+
+	end := start + intVar(variables, "first", 50)
+	if end > len(nodes) {
+		end = len(nodes)
+	}
+	page := append([]T{}, nodes[start:end]...)
+
+	pageInfo := api.PageInfo{HasNextPage: end < len(nodes)}
+	if len(page) > 0 {
+		pageInfo.EndCursor = id(page[len(page)-1])
+	}
+	return map[string]interface{}{
+		"nodes":    page,
+		"pageInfo": pageInfo,
+	}
+}
+
+// stringVar returns a string variable, or "" when it is missing or null
+func stringVar(variables map[string]interface{}, key string) string {
+	value, _ := variables[key].(string)
+	return value
+}
+
+// intVar returns an integer variable, or def when it is missing or null
+func intVar(variables map[string]interface{}, key string, def int) int {
+	if value, ok := variables[key].(float64); ok {
+		return int(value)
+	}
+	return def
+}
+
+// inputVar returns the input object of a mutation
+func inputVar(variables map[string]interface{}) map[string]interface{} {
+	input, _ := variables["input"].(map[string]interface{})
+	if input == nil {
+		input = map[string]interface{}{}
+	}
+	return input
+}
+
+// stringList returns a list variable of strings
+func stringList(value interface{}) []string {
+	items, _ := value.([]interface{})
+	list := make([]string, 0, len(items))
+	for _, item := range items {
+		if str, ok := item.(string); ok {
+			list = append(list, str)
+		}
+	}
+	return list
+}
+
+// success is the payload of mutations that only report whether they worked
+func success(field string) map[string]interface{} {
+	return map[string]interface{}{
+		field: map[string]interface{}{"success": true},
+	}
+}
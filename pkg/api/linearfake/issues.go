@@ -0,0 +1,497 @@
+package linearfake
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/yjiky/linctl/pkg/api"
+)
+
+// issueViews renders the issues a list or search can return
+func (s *Server) issueViews(includeArchived bool) []api.Issue {
+	views := []api.Issue{}
+	for _, i := range s.issues {
+		if i.trashed || (i.archivedAt != nil && !includeArchived) {
+			continue
+		}
+		views = append(views, s.issueView(i))
+	}
+	return views
+}
+
+func issueID(i api.Issue) string               { return i.ID }
+func issueUpdatedAt(i api.Issue) time.Time     { return i.UpdatedAt }
+func commentID(c api.Comment) string           { return c.ID }
+func commentUpdatedAt(c api.Comment) time.Time { return c.UpdatedAt }
+
+// issueConnection filters, orders and paginates issues
+func issueConnection(views []api.Issue, variables map[string]interface{}) (map[string]interface{}, error) {
+	views, err := filterNodes(views, variables["filter"])
+	if err != nil {
+		return nil, err
+	}
+	orderNodes(views, variables["orderBy"], issueUpdatedAt)
+	return paginate(views, variables, issueID), nil
+}
+
+func (s *Server) queryIssues(variables map[string]interface{}) (interface{}, error) {
+	includeArchived, _ := variables["includeArchived"].(bool)
+	issues, err := issueConnection(s.issueViews(includeArchived), variables)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"issues": issues}, nil
+}
+
+// querySearchIssues matches the term against identifiers, titles and
+// descriptions
+func (s *Server) querySearchIssues(variables map[string]interface{}) (interface{}, error) {
+	term := strings.ToLower(strings.TrimSpace(stringVar(variables, "term")))
+	if term == "" {
+		return nil, invalidInput("term must not be empty")
+	}
+
+	includeArchived, _ := variables["includeArchived"].(bool)
+	matched := []api.Issue{}
+	for _, view := range s.issueViews(includeArchived) {
+		text := strings.ToLower(view.Identifier + "\n" + view.Title + "\n" + view.Description)
+		if strings.Contains(text, term) {
+			matched = append(matched, view)
+		}
+	}
+
+	issues, err := issueConnection(matched, variables)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"searchIssues": issues}, nil
+}
+
+// lookupIssue finds the issue an operation's id variable names
+func (s *Server) lookupIssue(variables map[string]interface{}) (*issue, error) {
+	ref := stringVar(variables, "id")
+	i, ok := s.findIssue(ref)
+	if !ok {
+		return nil, notFound("Issue")
+	}
+	return i, nil
+}
+
+func (s *Server) queryIssue(variables map[string]interface{}) (interface{}, error) {
+	i, err := s.lookupIssue(variables)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"issue": s.issueView(i)}, nil
+}
+
+func relationID(r api.IssueRelation) string { return r.ID }
+
+func (s *Server) queryIssueRelations(variables map[string]interface{}) (interface{}, error) {
+	i, err := s.lookupIssue(variables)
+	if err != nil {
+		return nil, err
+	}
+	view := s.issueView(i)
+	return map[string]interface{}{
+		"issue": map[string]interface{}{
+			"id":               view.ID,
+			"identifier":       view.Identifier,
+			"title":            view.Title,
+			"state":            view.State,
+			"relations":        paginate(view.Relations.Nodes, variables, relationID),
+			"inverseRelations": paginate(view.InverseRelations.Nodes, variables, relationID),
+		},
+	}, nil
+}
+
+func (s *Server) queryRelationPage(variables map[string]interface{}) (interface{}, error) {
+	i, err := s.lookupIssue(variables)
+	if err != nil {
+		return nil, err
+	}
+	view := s.issueView(i)
+	return map[string]interface{}{
+		"issue": map[string]interface{}{
+			"id":        view.ID,
+			"relations": paginate(view.Relations.Nodes, variables, relationID),
+		},
+	}, nil
+}
+
+func (s *Server) queryInverseRelationPage(variables map[string]interface{}) (interface{}, error) {
+	i, err := s.lookupIssue(variables)
+	if err != nil {
+		return nil, err
+	}
+	view := s.issueView(i)
+	return map[string]interface{}{
+		"issue": map[string]interface{}{
+			"id":               view.ID,
+			"inverseRelations": paginate(view.InverseRelations.Nodes, variables, relationID),
+		},
+	}, nil
+}
+
+func (s *Server) createIssue(variables map[string]interface{}) (interface{}, error) {
+	input := inputVar(variables)
+
+	t, ok := s.findTeam(stringOf(input["teamId"]))
+	if !ok {
+		return nil, invalidInput("teamId must be a valid team")
+	}
+
+	// A template presets the fields the input leaves out
+	if templateID := stringOf(input["templateId"]); templateID != "" {
+		var template *api.Template
+		for _, tmpl := range s.templates {
+			if tmpl.ID == templateID {
+				template = tmpl
+			}
+		}
+		if template == nil {
+			return nil, notFound("Template")
+		}
+		data, err := template.Data()
+		if err != nil {
+			return nil, invalidInput("template data is invalid: %v", err)
+		}
+		merged := map[string]interface{}{}
+		for key, value := range data {
+			merged[key] = value
+		}
+		for key, value := range input {
+			merged[key] = value
+		}
+		input = merged
+	}
+
+	if strings.TrimSpace(stringOf(input["title"])) == "" {
+		return nil, invalidInput("title must not be empty")
+	}
+
+	created := now()
+	i := &issue{
+		id:        s.newID(),
+		teamID:    t.ID,
+		creatorID: s.viewerID,
+		createdAt: created,
+		updatedAt: created,
+	}
+	i.stateID = s.defaultState(t.ID)
+	if err := s.applyIssueInput(i, input, "IssueCreateInput"); err != nil {
+		return nil, err
+	}
+	t.issueSeq++
+	i.number = t.issueSeq
+	s.issues = append(s.issues, i)
+
+	return map[string]interface{}{
+		"issueCreate": map[string]interface{}{
+			"success": true,
+			"issue":   s.issueView(i),
+		},
+	}, nil
+}
+
+func (s *Server) updateIssue(variables map[string]interface{}) (interface{}, error) {
+	i, err := s.lookupIssue(variables)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate against a copy so a rejected update leaves the issue alone
+	updated := *i
+	updated.labelIDs = append([]string(nil), i.labelIDs...)
+	if err := s.applyIssueInput(&updated, inputVar(variables), "IssueUpdateInput"); err != nil {
+		return nil, err
+	}
+	updated.updatedAt = now()
+	*i = updated
+
+	return map[string]interface{}{
+		"issueUpdate": map[string]interface{}{
+			"success": true,
+			"issue":   s.issueView(i),
+		},
+	}, nil
+}
+
+// applyIssueInput applies the fields of an IssueCreateInput or
+// IssueUpdateInput, rejecting unknown fields and dangling IDs like Linear
+func (s *Server) applyIssueInput(i *issue, input map[string]interface{}, inputType string) error {
+	stateID := i.stateID
+	for field, value := range input {
+		switch field {
+		case "title":
+			i.title = stringOf(value)
+		case "description":
+			i.description = stringOf(value)
+		case "priority":
+			priority, ok := value.(float64)
+			if !ok || priority < 0 || priority > 4 || priority != float64(int(priority)) {
+				return invalidInput("priority must be an integer from 0 to 4")
+			}
+			i.priority = int(priority)
+		case "estimate":
+			if value == nil {
+				i.estimate = nil
+				break
+			}
+			estimate, ok := value.(float64)
+			if !ok {
+				return invalidInput("estimate must be a number")
+			}
+			i.estimate = &estimate
+		case "dueDate":
+			if value == nil {
+				i.dueDate = nil
+				break
+			}
+			due := stringOf(value)
+			if _, err := time.Parse("2006-01-02", due); err != nil {
+				return invalidInput("dueDate must be a date (YYYY-MM-DD), got %q", due)
+			}
+			i.dueDate = &due
+		case "stateId":
+			st, ok := s.findState(i.teamID, stringOf(value))
+			if !ok || st.ID != stringOf(value) || st.teamID != i.teamID {
+				return invalidInput("stateId must be a workflow state of the issue's team")
+			}
+			stateID = st.ID
+		case "assigneeId":
+			id, err := s.userID(value)
+			if err != nil {
+				return err
+			}
+			i.assigneeID = id
+		case "labelIds", "addedLabelIds", "removedLabelIds":
+			ids := stringList(value)
+			for _, id := range ids {
+				if l, ok := s.findLabel(i.teamID, id); !ok || l.id != id || l.isGroup || (l.teamID != "" && l.teamID != i.teamID) {
+					return invalidInput("%s contains an invalid label: %s", field, id)
+				}
+			}
+			switch field {
+			case "labelIds":
+				i.labelIDs = ids
+			case "addedLabelIds":
+				i.labelIDs = appendMissing(i.labelIDs, ids...)
+			default:
+				i.labelIDs = removeAll(i.labelIDs, ids...)
+			}
+		case "projectId":
+			if value == nil {
+				i.projectID = ""
+				break
+			}
+			p, ok := s.findProject(stringOf(value))
+			if !ok || p.id != stringOf(value) {
+				return notFound("Project")
+			}
+			i.projectID = p.id
+		case "cycleId":
+			if value == nil {
+				i.cycleID = ""
+				break
+			}
+			c, ok := s.findCycle(i.teamID, stringOf(value))
+			if !ok || c.ID != stringOf(value) {
+				return notFound("Cycle")
+			}
+			i.cycleID = c.ID
+		case "projectMilestoneId":
+			if value == nil {
+				i.milestoneID = ""
+				break
+			}
+			m, ok := s.findMilestone("", stringOf(value))
+			if !ok {
+				return notFound("ProjectMilestone")
+			}
+			i.milestoneID = m.id
+		case "parentId":
+			if value == nil {
+				i.parentID = ""
+				break
+			}
+			parent, ok := s.findIssue(stringOf(value))
+			if !ok {
+				return notFound("Issue")
+			}
+			if parent.id == i.id {
+				return invalidInput("an issue cannot be its own parent")
+			}
+			i.parentID = parent.id
+		case "teamId", "templateId":
+			// Handled by createIssue
+			if inputType != "IssueCreateInput" {
+				return invalidInput("Field %q is not defined by type %s", field, inputType)
+			}
+		default:
+			return invalidInput("Field %q is not defined by type %s", field, inputType)
+		}
+	}
+
+	// A milestone belongs to a project: moving the issue to another project
+	// drops it, and Linear rejects one from another project
+	if i.milestoneID != "" {
+		m, _ := s.findMilestone("", i.milestoneID)
+		if m.projectID != i.projectID {
+			if _, set := input["projectMilestoneId"]; set {
+				return invalidInput("projectMilestoneId must belong to the issue's project")
+			}
+			i.milestoneID = ""
+		}
+	}
+	if stateID != i.stateID {
+		s.setIssueState(i, stateID)
+	}
+	return nil
+}
+
+// userID resolves an assignee or lead ID, where null clears it
+func (s *Server) userID(value interface{}) (string, error) {
+	if value == nil {
+		return "", nil
+	}
+	u, ok := s.findUser(stringOf(value))
+	if !ok || u.ID != stringOf(value) {
+		return "", notFound("User")
+	}
+	return u.ID, nil
+}
+
+func (s *Server) archiveIssue(variables map[string]interface{}) (interface{}, error) {
+	i, err := s.lookupIssue(variables)
+	if err != nil {
+		return nil, err
+	}
+	archived := now()
+	i.archivedAt = &archived
+	if trash, _ := variables["trash"].(bool); trash {
+		i.trashed = true
+	}
+	return success("issueArchive"), nil
+}
+
+func (s *Server) unarchiveIssue(variables map[string]interface{}) (interface{}, error) {
+	i, err := s.lookupIssue(variables)
+	if err != nil {
+		return nil, err
+	}
+	i.archivedAt = nil
+	i.trashed = false
+	return success("issueUnarchive"), nil
+}
+
+func (s *Server) deleteIssue(variables map[string]interface{}) (interface{}, error) {
+	i, err := s.lookupIssue(variables)
+	if err != nil {
+		return nil, err
+	}
+	if permanent, _ := variables["permanentlyDelete"].(bool); permanent {
+		for n, candidate := range s.issues {
+			if candidate == i {
+				s.issues = append(s.issues[:n], s.issues[n+1:]...)
+				break
+			}
+		}
+		return success("issueDelete"), nil
+	}
+	deleted := now()
+	i.archivedAt = &deleted
+	i.trashed = true
+	return success("issueDelete"), nil
+}
+
+func (s *Server) createIssueRelation(variables map[string]interface{}) (interface{}, error) {
+	input := inputVar(variables)
+	from, ok := s.findIssue(stringOf(input["issueId"]))
+	if !ok {
+		return nil, notFound("Issue")
+	}
+	to, ok := s.findIssue(stringOf(input["relatedIssueId"]))
+	if !ok {
+		return nil, notFound("Issue")
+	}
+	kind := stringOf(input["type"])
+	switch kind {
+	case api.RelationBlocks, api.RelationDuplicate, api.RelationRelated, api.RelationSimilar:
+	default:
+		return nil, invalidInput("type must be one of blocks, duplicate, related or similar")
+	}
+	if from == to {
+		return nil, invalidInput("an issue cannot be related to itself")
+	}
+
+	r := &relation{id: s.newID(), kind: kind, issueID: from.id, relatedIssueID: to.id}
+	s.relations = append(s.relations, r)
+	return map[string]interface{}{
+		"issueRelationCreate": map[string]interface{}{
+			"success":       true,
+			"issueRelation": s.relationView(r),
+		},
+	}, nil
+}
+
+func (s *Server) deleteIssueRelation(variables map[string]interface{}) (interface{}, error) {
+	n, ok := s.findRelation(stringVar(variables, "id"))
+	if !ok {
+		return nil, notFound("IssueRelation")
+	}
+	s.relations = append(s.relations[:n], s.relations[n+1:]...)
+	return success("issueRelationDelete"), nil
+}
+
+func (s *Server) queryTemplates(map[string]interface{}) (interface{}, error) {
+	templates := []api.Template{}
+	for _, t := range s.templates {
+		view := *t
+		if view.TemplateData == nil {
+			view.TemplateData = json.RawMessage("{}")
+		}
+		templates = append(templates, view)
+	}
+	return map[string]interface{}{"templates": templates}, nil
+}
+
+// stringOf returns a JSON value as a string, or "" for null and non-strings
+func stringOf(value interface{}) string {
+	str, _ := value.(string)
+	return str
+}
+
+// appendMissing appends the values not already in list
+func appendMissing(list []string, values ...string) []string {
+	for _, value := range values {
+		found := false
+		for _, existing := range list {
+			if existing == value {
+				found = true
+			}
+		}
+		if !found {
+			list = append(list, value)
+		}
+	}
+	return list
+}
+
+// removeAll removes every occurrence of the values from list
+func removeAll(list []string, values ...string) []string {
+	kept := []string{}
+	for _, existing := range list {
+		remove := false
+		for _, value := range values {
+			if existing == value {
+				remove = true
+			}
+		}
+		if !remove {
+			kept = append(kept, existing)
+		}
+	}
+	return kept
+}
@@ -0,0 +1,172 @@
+package linearfake
+
+import (
+	"strings"
+	"time"
+
+	"github.com/yjiky/linctl/pkg/api"
+)
+
+func milestoneID(m api.Milestone) string { return m.ID }
+
+func (s *Server) queryProjectMilestones(variables map[string]interface{}) (interface{}, error) {
+	p, err := s.lookupProject(variables)
+	if err != nil {
+		return nil, err
+	}
+	milestones := []api.Milestone{}
+	for _, m := range s.milestones {
+		if m.projectID == p.id {
+			milestones = append(milestones, s.milestoneView(m))
+		}
+	}
+	return map[string]interface{}{
+		"project": map[string]interface{}{
+			"id":                p.id,
+			"projectMilestones": paginate(milestones, variables, milestoneID),
+		},
+	}, nil
+}
+
+// lookupMilestone finds the milestone an operation's id variable names
+func (s *Server) lookupMilestone(variables map[string]interface{}) (*milestone, error) {
+	id := stringVar(variables, "id")
+	for _, m := range s.milestones {
+		if m.id == id {
+			return m, nil
+		}
+	}
+	return nil, notFound("ProjectMilestone")
+}
+
+func (s *Server) queryProjectMilestone(variables map[string]interface{}) (interface{}, error) {
+	m, err := s.lookupMilestone(variables)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"projectMilestone": s.milestoneView(m)}, nil
+}
+
+// queryMilestoneIssues returns a page of the milestone's unarchived issues
+func (s *Server) queryMilestoneIssues(variables map[string]interface{}) (interface{}, error) {
+	m, err := s.lookupMilestone(variables)
+	if err != nil {
+		return nil, err
+	}
+	issues := []api.Issue{}
+	for _, i := range s.issues {
+		if i.milestoneID == m.id && i.archivedAt == nil {
+			issues = append(issues, *s.issueRef(i.id))
+		}
+	}
+	return map[string]interface{}{
+		"projectMilestone": map[string]interface{}{
+			"id":     m.id,
+			"issues": paginate(issues, variables, issueID),
+		},
+	}, nil
+}
+
+func (s *Server) createProjectMilestone(variables map[string]interface{}) (interface{}, error) {
+	input := inputVar(variables)
+	m := &milestone{id: s.newID()}
+	if err := s.applyMilestoneInput(m, input, "ProjectMilestoneCreateInput"); err != nil {
+		return nil, err
+	}
+	if m.projectID == "" {
+		return nil, invalidInput("projectId must be a valid project")
+	}
+	if strings.TrimSpace(m.name) == "" {
+		return nil, invalidInput("name must not be empty")
+	}
+	for _, existing := range s.milestones {
+		if existing.projectID == m.projectID {
+			m.sortOrder = existing.sortOrder + 1
+		}
+	}
+
+	s.milestones = append(s.milestones, m)
+	return map[string]interface{}{
+		"projectMilestoneCreate": map[string]interface{}{
+			"success":          true,
+			"projectMilestone": s.milestoneView(m),
+		},
+	}, nil
+}
+
+func (s *Server) updateProjectMilestone(variables map[string]interface{}) (interface{}, error) {
+	m, err := s.lookupMilestone(variables)
+	if err != nil {
+		return nil, err
+	}
+	updated := *m
+	if err := s.applyMilestoneInput(&updated, inputVar(variables), "ProjectMilestoneUpdateInput"); err != nil {
+		return nil, err
+	}
+	*m = updated
+
+	return map[string]interface{}{
+		"projectMilestoneUpdate": map[string]interface{}{
+			"success":          true,
+			"projectMilestone": s.milestoneView(m),
+		},
+	}, nil
+}
+
+// applyMilestoneInput applies the fields of a ProjectMilestoneCreateInput or
+// ProjectMilestoneUpdateInput
+func (s *Server) applyMilestoneInput(m *milestone, input map[string]interface{}, inputType string) error {
+	for field, value := range input {
+		switch field {
+		case "name":
+			m.name = stringOf(value)
+			if strings.TrimSpace(m.name) == "" {
+				return invalidInput("name must not be empty")
+			}
+		case "description":
+			m.description = stringOf(value)
+		case "targetDate":
+			if value == nil {
+				m.targetDate = nil
+				break
+			}
+			date := stringOf(value)
+			if _, err := time.Parse("2006-01-02", date); err != nil {
+				return invalidInput("targetDate must be a date (YYYY-MM-DD), got %q", date)
+			}
+			m.targetDate = &date
+		case "projectId":
+			if inputType != "ProjectMilestoneCreateInput" {
+				return invalidInput("Field %q is not defined by type %s", field, inputType)
+			}
+			p, err := s.lookupProject(map[string]interface{}{"id": value})
+			if err != nil {
+				return err
+			}
+			m.projectID = p.id
+		default:
+			return invalidInput("Field %q is not defined by type %s", field, inputType)
+		}
+	}
+	return nil
+}
+
+// deleteProjectMilestone deletes a milestone, unsetting it on its issues
+func (s *Server) deleteProjectMilestone(variables map[string]interface{}) (interface{}, error) {
+	m, err := s.lookupMilestone(variables)
+	if err != nil {
+		return nil, err
+	}
+	for n, candidate := range s.milestones {
+		if candidate == m {
+			s.milestones = append(s.milestones[:n], s.milestones[n+1:]...)
+			break
+		}
+	}
+	for _, i := range s.issues {
+		if i.milestoneID == m.id {
+			i.milestoneID = ""
+		}
+	}
+	return success("projectMilestoneDelete"), nil
+}
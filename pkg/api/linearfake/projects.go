@@ -0,0 +1,211 @@
+package linearfake
+
+import (
+	"strings"
+	"time"
+
+	"github.com/yjiky/linctl/pkg/api"
+)
+
+// Project states accepted by Linear
+var projectStates = []string{"backlog", "planned", "started", "paused", "completed", "canceled"}
+
+// lookupProject finds the project an operation's id variable names, by ID
+// or slug like Linear
+func (s *Server) lookupProject(variables map[string]interface{}) (*project, error) {
+	ref := stringVar(variables, "id")
+	for _, p := range s.projects {
+		if p.id == ref || p.slugID == ref {
+			return p, nil
+		}
+	}
+	return nil, notFound("Project")
+}
+
+// projectNode is a project as filters see it, with the teams that can
+// access it under the name ProjectFilter uses
+type projectNode struct {
+	api.Project
+	AccessibleTeams *api.Teams `json:"accessibleTeams"`
+}
+
+func (s *Server) queryProjects(variables map[string]interface{}) (interface{}, error) {
+	nodes := []projectNode{}
+	for _, p := range s.projects {
+		if p.archivedAt == nil {
+			view := s.projectView(p)
+			nodes = append(nodes, projectNode{Project: view, AccessibleTeams: view.Teams})
+		}
+	}
+	nodes, err := filterNodes(nodes, variables["filter"])
+	if err != nil {
+		return nil, err
+	}
+	projects := make([]api.Project, 0, len(nodes))
+	for _, node := range nodes {
+		projects = append(projects, node.Project)
+	}
+	orderNodes(projects, variables["orderBy"], func(p api.Project) time.Time { return p.UpdatedAt })
+	return map[string]interface{}{
+		"projects": paginate(projects, variables, func(p api.Project) string { return p.ID }),
+	}, nil
+}
+
+func (s *Server) queryProject(variables map[string]interface{}) (interface{}, error) {
+	p, err := s.lookupProject(variables)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"project": s.projectView(p)}, nil
+}
+
+func (s *Server) createProject(variables map[string]interface{}) (interface{}, error) {
+	input := inputVar(variables)
+	created := now()
+	p := &project{
+		id:        s.newID(),
+		state:     "planned",
+		creatorID: s.viewerID,
+		createdAt: created,
+		updatedAt: created,
+	}
+	p.slugID = p.id[len(p.id)-12:]
+	if err := s.applyProjectInput(p, input, "ProjectCreateInput"); err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(p.name) == "" {
+		return nil, invalidInput("name must not be empty")
+	}
+	if len(p.teamIDs) == 0 {
+		return nil, invalidInput("teamIds must contain at least one team")
+	}
+
+	s.projects = append(s.projects, p)
+	return map[string]interface{}{
+		"projectCreate": map[string]interface{}{
+			"success": true,
+			"project": s.projectView(p),
+		},
+	}, nil
+}
+
+func (s *Server) updateProject(variables map[string]interface{}) (interface{}, error) {
+	p, err := s.lookupProject(variables)
+	if err != nil {
+		return nil, err
+	}
+	updated := *p
+	if err := s.applyProjectInput(&updated, inputVar(variables), "ProjectUpdateInput"); err != nil {
+		return nil, err
+	}
+	updated.updatedAt = now()
+	*p = updated
+
+	return map[string]interface{}{
+		"projectUpdate": map[string]interface{}{
+			"success": true,
+			"project": s.projectView(p),
+		},
+	}, nil
+}
+
+// applyProjectInput applies the fields of a ProjectCreateInput or
+// ProjectUpdateInput
+func (s *Server) applyProjectInput(p *project, input map[string]interface{}, inputType string) error {
+	for field, value := range input {
+		switch field {
+		case "name":
+			p.name = stringOf(value)
+		case "description":
+			p.description = stringOf(value)
+		case "content":
+			p.content = stringOf(value)
+		case "color":
+			p.color = stringOf(value)
+		case "icon":
+			icon := stringOf(value)
+			p.icon = &icon
+		case "state":
+			valid := false
+			for _, state := range projectStates {
+				if stringOf(value) == state {
+					valid = true
+				}
+			}
+			if !valid {
+				return invalidInput("state must be one of %s", strings.Join(projectStates, ", "))
+			}
+			p.state = stringOf(value)
+			stamp := now()
+			p.completedAt, p.canceledAt = nil, nil
+			switch p.state {
+			case "completed":
+				p.completedAt = &stamp
+			case "canceled":
+				p.canceledAt = &stamp
+			}
+		case "startDate", "targetDate":
+			var date *string
+			if value != nil {
+				str := stringOf(value)
+				if _, err := time.Parse("2006-01-02", str); err != nil {
+					return invalidInput("%s must be a date (YYYY-MM-DD), got %q", field, str)
+				}
+				date = &str
+			}
+			if field == "startDate" {
+				p.startDate = date
+			} else {
+				p.targetDate = date
+			}
+		case "leadId":
+			id, err := s.userID(value)
+			if err != nil {
+				return err
+			}
+			p.leadID = id
+		case "memberIds":
+			ids := []string{}
+			for _, ref := range stringList(value) {
+				id, err := s.userID(ref)
+				if err != nil {
+					return err
+				}
+				ids = append(ids, id)
+			}
+			p.memberIDs = ids
+		case "teamIds":
+			ids := []string{}
+			for _, ref := range stringList(value) {
+				t, ok := s.findTeam(ref)
+				if !ok || t.ID != ref {
+					return notFound("Team")
+				}
+				ids = append(ids, t.ID)
+			}
+			p.teamIDs = ids
+		default:
+			return invalidInput("Field %q is not defined by type %s", field, inputType)
+		}
+	}
+	return nil
+}
+
+func (s *Server) archiveProject(variables map[string]interface{}) (interface{}, error) {
+	p, err := s.lookupProject(variables)
+	if err != nil {
+		return nil, err
+	}
+	archived := now()
+	p.archivedAt = &archived
+	return success("projectArchive"), nil
+}
+
+func (s *Server) unarchiveProject(variables map[string]interface{}) (interface{}, error) {
+	p, err := s.lookupProject(variables)
+	if err != nil {
+		return nil, err
+	}
+	p.archivedAt = nil
+	return success("projectUnarchive"), nil
+}
@@ -0,0 +1,346 @@
+// Package linearfake is an in-process fake of Linear's GraphQL API for tests.
+//
+// A Server answers the operations linctl sends, keyed by GraphQL operation
+// name, from an in-memory workspace of users, teams, workflow states, labels,
+// issues, comments, projects and file uploads. Seed it with the Add methods,
+// point a client at Server.URL, and inspect the recorded requests or the
+// workspace afterwards. Operations the fake does not model, or responses a
+// test wants to control, can be served from canned data with Handle and
+// Respond.
+package linearfake
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/yjiky/linctl/pkg/api"
+)
+
+// Handler answers one GraphQL operation. The returned value is marshaled as
+// the response's data; an error is reported in its errors, with the code of
+// an *api.Error.
+type Handler func(variables map[string]interface{}) (interface{}, error)
+
+// Request is a GraphQL request received by the server
+type Request struct {
+	Operation string
+	Query     string
+	Variables map[string]interface{}
+	Header    http.Header
+}
+
+// Server is a fake Linear API served over HTTP on the loopback interface
+type Server struct {
+	// URL is the GraphQL endpoint to give clients
+	URL string
+
+	server *httptest.Server
+
+	mu       sync.Mutex
+	apiKey   string
+	handlers map[string]Handler
+	builtins map[string]Handler
+	requests []Request
+
+	seq         int
+	viewerID    string
+	users       []*api.User
+	teams       []*team
+	states      []*state
+	labels      []*label
+	issues      []*issue
+	comments    []*comment
+	projects    []*project
+	cycles      []*cycle
+	milestones  []*milestone
+	templates   []*api.Template
+	relations   []*relation
+	attachments []*attachment
+	uploads     []*Upload
+}
+
+// New starts a server with an empty workspace. Close it when done.
+func New() *Server {
+	s := &Server{handlers: map[string]Handler{}}
+	s.builtins = s.operations()
+	s.server = httptest.NewServer(s)
+	s.URL = s.server.URL + "/graphql"
+	return s
+}
+
+// Close shuts the server down
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// SetAPIKey makes the server reject requests whose Authorization header is
+// not key, as Linear does for a revoked key. By default any key is accepted.
+func (s *Server) SetAPIKey(key string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.apiKey = key
+}
+
+// Handle serves an operation with h instead of the workspace, for operations
+// the fake does not model or to inject failures
+func (s *Server) Handle(operation string, h Handler) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.handlers[operation] = h
+}
+
+// Respond serves an operation with fixed data. A string or []byte is used as
+// raw JSON; anything else is marshaled.
+func (s *Server) Respond(operation string, data interface{}) {
+	var raw json.RawMessage
+	switch v := data.(type) {
+	case string:
+		raw = json.RawMessage(v)
+	case []byte:
+		raw = json.RawMessage(v)
+	default:
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			panic(fmt.Sprintf("linearfake: cannot marshal response for %s: %v", operation, err))
+		}
+		raw = encoded
+	}
+	s.Handle(operation, func(map[string]interface{}) (interface{}, error) {
+		return raw, nil
+	})
+}
+
+// Requests returns the GraphQL requests received so far, oldest first
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request(nil), s.requests...)
+}
+
+// LastRequest returns the most recent request for an operation
+func (s *Server) LastRequest(operation string) (Request, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := len(s.requests) - 1; i >= 0; i-- {
+		if s.requests[i].Operation == operation {
+			return s.requests[i], true
+		}
+	}
+	return Request{}, false
+}
+
+// ServeHTTP serves GraphQL requests and the file storage used by uploads
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case strings.HasPrefix(r.URL.Path, uploadPath):
+		s.serveUpload(w, r)
+	case strings.HasPrefix(r.URL.Path, filePath):
+		s.serveFile(w, r)
+	case r.Method == http.MethodPost:
+		s.serveGraphQL(w, r)
+	default:
+		http.Error(w, "not found", http.StatusNotFound)
+	}
+}
+
+// operationPattern extracts the operation name from a query document
+var operationPattern = regexp.MustCompile(`^\s*(?:query|mutation)\s+(\w+)`)
+
+func (s *Server) serveGraphQL(w http.ResponseWriter, r *http.Request) {
+	var body struct {
+		Query     string                 `json:"query"`
+		Variables map[string]interface{} `json:"variables"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeErrors(w, http.StatusBadRequest, &api.Error{Message: "invalid request body: " + err.Error()})
+		return
+	}
+	if body.Variables == nil {
+		body.Variables = map[string]interface{}{}
+	}
+
+	operation := ""
+	if match := operationPattern.FindStringSubmatch(body.Query); match != nil {
+		operation = match[1]
+	}
+
+	s.mu.Lock()
+	s.requests = append(s.requests, Request{
+		Operation: operation,
+		Query:     body.Query,
+		Variables: body.Variables,
+		Header:    r.Header.Clone(),
+	})
+	apiKey := s.apiKey
+	handler, custom := s.handlers[operation]
+	if !custom {
+		handler = s.builtins[operation]
+	}
+	s.mu.Unlock()
+
+	if r.Header.Get("Authorization") == "" || (apiKey != "" && r.Header.Get("Authorization") != apiKey) {
+		writeErrors(w, http.StatusBadRequest, &api.Error{
+			Code:    api.CodeAuthentication,
+			Message: "Authentication required, not authenticated",
+		})
+		return
+	}
+
+	if handler == nil {
+		writeErrors(w, http.StatusOK, &api.Error{
+			Code:    api.CodeInvalidInput,
+			Message: fmt.Sprintf("linearfake: unsupported operation %q", operation),
+		})
+		return
+	}
+
+	// Built-in handlers share the workspace, so they run under the lock;
+	// custom ones may call back into the server's methods
+	var data interface{}
+	var err error
+	if custom {
+		data, err = handler(body.Variables)
+	} else {
+		s.mu.Lock()
+		data, err = handler(body.Variables)
+		s.mu.Unlock()
+	}
+	if err != nil {
+		writeErrors(w, http.StatusOK, err)
+		return
+	}
+
+	raw, err := json.Marshal(data)
+	if err != nil {
+		writeErrors(w, http.StatusInternalServerError, &api.Error{Code: api.CodeInternal, Message: err.Error()})
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": json.RawMessage(raw)})
+}
+
+// writeErrors writes a GraphQL error response
+func writeErrors(w http.ResponseWriter, status int, err error) {
+	gqlErr := map[string]interface{}{"message": err.Error()}
+	if apiErr, ok := err.(*api.Error); ok {
+		gqlErr["message"] = apiErr.Message
+		if apiErr.Code != "" {
+			gqlErr["extensions"] = map[string]interface{}{"code": apiErr.Code}
+		}
+		if len(apiErr.Path) > 0 {
+			gqlErr["path"] = apiErr.Path
+		}
+	}
+	writeJSON(w, status, map[string]interface{}{
+		"data":   nil,
+		"errors": []interface{}{gqlErr},
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	reset := strconv.FormatInt(time.Now().Add(time.Hour).UnixMilli(), 10)
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-RateLimit-Requests-Limit", "1500")
+	w.Header().Set("X-RateLimit-Requests-Remaining", "1499")
+	w.Header().Set("X-RateLimit-Requests-Reset", reset)
+	w.Header().Set("X-RateLimit-Complexity-Limit", "250000")
+	w.Header().Set("X-RateLimit-Complexity-Remaining", "249000")
+	w.Header().Set("X-RateLimit-Complexity-Reset", reset)
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// notFound is the error Linear returns for a missing entity
+func notFound(entity string) error {
+	return &api.Error{
+		Code:    api.CodeInvalidInput,
+		Message: fmt.Sprintf("Entity not found: %s", entity),
+	}
+}
+
+// invalidInput is the error Linear returns for a rejected argument
+func invalidInput(format string, args ...interface{}) error {
+	return &api.Error{
+		Code:    api.CodeInvalidInput,
+		Message: fmt.Sprintf(format, args...),
+	}
+}
+
+// operations maps the operation names linctl sends to the handlers serving
+// them from the workspace
+func (s *Server) operations() map[string]Handler {
+	return map[string]Handler{
+		// Users and teams
+		"Me":          s.queryViewer,
+		"RateLimit":   s.queryViewer,
+		"Users":       s.queryUsers,
+		"User":        s.queryUser,
+		"Teams":       s.queryTeams,
+		"Team":        s.queryTeam,
+		"TeamStates":  s.queryTeamStates,
+		"TeamLabels":  s.queryTeamLabels,
+		"TeamMembers": s.queryTeamMembers,
+
+		// Issues
+		"Issues":              s.queryIssues,
+		"IssueSearch":         s.querySearchIssues,
+		"Issue":               s.queryIssue,
+		"IssueRelations":      s.queryIssueRelations,
+		"RelationPage":        s.queryRelationPage,
+		"InverseRelationPage": s.queryInverseRelationPage,
+		"CreateIssue":         s.createIssue,
+		"UpdateIssue":         s.updateIssue,
+		"ArchiveIssue":        s.archiveIssue,
+		"UnarchiveIssue":      s.unarchiveIssue,
+		"DeleteIssue":         s.deleteIssue,
+		"CreateIssueRelation": s.createIssueRelation,
+		"DeleteIssueRelation": s.deleteIssueRelation,
+		"Templates":           s.queryTemplates,
+
+		// Labels
+		"IssueLabels":      s.queryIssueLabels,
+		"LabelsByID":       s.queryLabelsByID,
+		"CreateIssueLabel": s.createIssueLabel,
+		"UpdateIssueLabel": s.updateIssueLabel,
+		"DeleteIssueLabel": s.deleteIssueLabel,
+
+		// Comments
+		"IssueComments":    s.queryIssueComments,
+		"Comment":          s.queryComment,
+		"CreateComment":    s.createComment,
+		"UpdateComment":    s.updateComment,
+		"DeleteComment":    s.deleteComment,
+		"ResolveComment":   s.resolveComment,
+		"UnresolveComment": s.unresolveComment,
+		"CreateReaction":   s.createReaction,
+		"DeleteReaction":   s.deleteReaction,
+
+		// Projects, milestones and cycles
+		"Projects":               s.queryProjects,
+		"Project":                s.queryProject,
+		"CreateProject":          s.createProject,
+		"UpdateProject":          s.updateProject,
+		"ArchiveProject":         s.archiveProject,
+		"UnarchiveProject":       s.unarchiveProject,
+		"ProjectMilestones":      s.queryProjectMilestones,
+		"ProjectMilestone":       s.queryProjectMilestone,
+		"MilestoneIssues":        s.queryMilestoneIssues,
+		"CreateProjectMilestone": s.createProjectMilestone,
+		"UpdateProjectMilestone": s.updateProjectMilestone,
+		"DeleteProjectMilestone": s.deleteProjectMilestone,
+		"Cycles":                 s.queryCycles,
+		"Cycle":                  s.queryCycle,
+		"CycleIssues":            s.queryCycleIssues,
+
+		// Files and attachments
+		"FileUpload":       s.fileUpload,
+		"AttachmentCreate": s.createAttachment,
+		"IssueAttachments": s.queryIssueAttachments,
+	}
+}
@@ -0,0 +1,89 @@
+package linearfake
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/yjiky/linctl/pkg/api"
+)
+
+func newServer(t *testing.T) (*Server, *api.Client) {
+	t.Helper()
+	s := New()
+	t.Cleanup(s.Close)
+	s.AddUser(api.User{Name: "Alice Smith", Email: "alice@example.com"})
+	return s, api.NewClientWithURL(s.URL, "lin_api_test")
+}
+
+func TestCreateAndGetIssue(t *testing.T) {
+	s, client := newServer(t)
+	team := s.AddTeam(api.Team{Key: "ENG"})
+	ctx := context.Background()
+
+	created, err := client.CreateIssue(ctx, map[string]interface{}{
+		"teamId": team.ID,
+		"title":  "Fix login redirect",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.Identifier != "ENG-1" {
+		t.Errorf("created issue %s, want ENG-1", created.Identifier)
+	}
+
+	issue, err := client.GetIssue(ctx, "ENG-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if issue.Title != "Fix login redirect" || issue.State == nil || issue.State.Name != "Todo" {
+		t.Errorf("issue = %s in %+v, want the created issue in the default state", issue.Title, issue.State)
+	}
+	if stored, ok := s.Issue(created.ID); !ok || stored.Identifier != "ENG-1" {
+		t.Errorf("Issue(%s) = %+v, %v", created.ID, stored, ok)
+	}
+
+	if _, err := client.GetIssue(ctx, "ENG-2"); !api.IsNotFound(err) {
+		t.Errorf("missing issue error = %v, want not found", err)
+	}
+}
+
+func TestHandleAndRespond(t *testing.T) {
+	s, client := newServer(t)
+	ctx := context.Background()
+
+	s.Respond("Me", `{"viewer": {"id": "user-1", "name": "Canned"}}`)
+	viewer, err := client.GetViewer(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if viewer.Name != "Canned" {
+		t.Errorf("viewer = %q, want the canned response", viewer.Name)
+	}
+
+	s.Handle("Me", func(map[string]interface{}) (interface{}, error) {
+		return nil, &api.Error{Code: api.CodeInternal, Message: "boom"}
+	})
+	var apiErr *api.Error
+	if _, err := client.GetViewer(ctx); !errors.As(err, &apiErr) || apiErr.Message != "boom" {
+		t.Errorf("handler error = %v, want boom", err)
+	}
+
+	var result map[string]interface{}
+	err = client.Execute(ctx, `query Unmodelled { thing }`, nil, &result)
+	if err == nil {
+		t.Error("unsupported operation succeeded")
+	}
+	if req, ok := s.LastRequest("Unmodelled"); !ok || req.Header.Get("Authorization") != "lin_api_test" {
+		t.Errorf("last request = %+v, %v", req, ok)
+	}
+}
+
+func TestSetAPIKey(t *testing.T) {
+	s, client := newServer(t)
+	s.SetAPIKey("lin_api_other")
+
+	if _, err := client.GetViewer(context.Background()); !api.IsAuthError(err) {
+		t.Errorf("request with another key: %v, want an authentication error", err)
+	}
+}
@@ -0,0 +1,811 @@
+package linearfake
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/yjiky/linctl/pkg/api"
+)
+
+// Default workflow states given to every team added with AddTeam
+var defaultStates = []api.WorkflowState{
+	{Name: "Backlog", Type: "backlog", Color: "#bec2c8", Position: 0},
+	{Name: "Todo", Type: "unstarted", Color: "#e2e2e2", Position: 1},
+	{Name: "In Progress", Type: "started", Color: "#f2c94c", Position: 2},
+	{Name: "Done", Type: "completed", Color: "#5e6ad2", Position: 3},
+	{Name: "Canceled", Type: "canceled", Color: "#95a2b3", Position: 4},
+}
+
+// Priority labels indexed by priority
+var priorityLabels = []string{"No priority", "Urgent", "High", "Medium", "Low"}
+
+type team struct {
+	api.Team
+	memberIDs []string
+	issueSeq  int
+}
+
+type state struct {
+	api.WorkflowState
+	teamID string
+}
+
+type label struct {
+	id          string
+	name        string
+	color       string
+	description *string
+	parentID    string
+	teamID      string
+	isGroup     bool
+	archivedAt  *time.Time
+}
+
+type issue struct {
+	id          string
+	number      int
+	title       string
+	description string
+	priority    int
+	estimate    *float64
+	dueDate     *string
+	teamID      string
+	stateID     string
+	assigneeID  string
+	creatorID   string
+	projectID   string
+	cycleID     string
+	milestoneID string
+	parentID    string
+	labelIDs    []string
+	createdAt   time.Time
+	updatedAt   time.Time
+	completedAt *time.Time
+	canceledAt  *time.Time
+	archivedAt  *time.Time
+	trashed     bool
+}
+
+type comment struct {
+	id              string
+	issueID         string
+	parentID        string
+	userID          string
+	body            string
+	createdAt       time.Time
+	updatedAt       time.Time
+	editedAt        *time.Time
+	resolvedAt      *time.Time
+	resolvingUserID string
+	reactions       []*reaction
+}
+
+type reaction struct {
+	id        string
+	emoji     string
+	userID    string
+	createdAt time.Time
+}
+
+type project struct {
+	id          string
+	slugID      string
+	name        string
+	description string
+	content     string
+	state       string
+	health      string
+	progress    float64
+	icon        *string
+	color       string
+	startDate   *string
+	targetDate  *string
+	leadID      string
+	creatorID   string
+	teamIDs     []string
+	memberIDs   []string
+	createdAt   time.Time
+	updatedAt   time.Time
+	completedAt *time.Time
+	canceledAt  *time.Time
+	archivedAt  *time.Time
+}
+
+type cycle struct {
+	api.Cycle
+	teamID string
+}
+
+type milestone struct {
+	id          string
+	name        string
+	description string
+	targetDate  *string
+	sortOrder   float64
+	projectID   string
+}
+
+type relation struct {
+	id             string
+	kind           string
+	issueID        string
+	relatedIssueID string
+}
+
+type attachment struct {
+	id        string
+	issueID   string
+	title     string
+	subtitle  *string
+	url       string
+	metadata  map[string]interface{}
+	creatorID string
+	createdAt time.Time
+}
+
+// newID returns the next UUID-shaped ID. IDs are deterministic so tests can
+// predict them from the order entities are added.
+func (s *Server) newID() string {
+	s.seq++
+	return fmt.Sprintf("00000000-0000-4000-8000-%012d", s.seq)
+}
+
+// now is the timestamp given to created and updated entities
+func now() time.Time {
+	return time.Now().UTC().Truncate(time.Millisecond)
+}
+
+// mustFind panics when a seed references an entity that was never added,
+// which is a bug in the test rather than something to report to a client
+func mustFind(kind, ref string, found bool) {
+	if !found {
+		panic(fmt.Sprintf("linearfake: %s not found: %s", kind, ref))
+	}
+}
+
+// AddUser adds a workspace member. The first user added is the viewer, the
+// user the API key belongs to, unless SetViewer picks another.
+func (s *Server) AddUser(u api.User) api.User {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if u.ID == "" {
+		u.ID = s.newID()
+	}
+	if u.DisplayName == "" && strings.TrimSpace(u.Name) != "" {
+		u.DisplayName = strings.ToLower(strings.Fields(u.Name)[0])
+	}
+	if u.CreatedAt == nil {
+		created := now()
+		u.CreatedAt = &created
+	}
+	u.Active = true
+	u.IsMe = false
+	s.users = append(s.users, &u)
+	if s.viewerID == "" {
+		s.viewerID = u.ID
+	}
+	return s.userView(u.ID)
+}
+
+// SetViewer makes the user with the given ID, email or name the viewer
+func (s *Server) SetViewer(ref string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, ok := s.findUser(ref)
+	mustFind("user", ref, ok)
+	s.viewerID = u.ID
+}
+
+// AddTeam adds a team with the Backlog, Todo, In Progress, Done and
+// Canceled workflow states
+func (s *Server) AddTeam(t api.Team) api.Team {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if t.ID == "" {
+		t.ID = s.newID()
+	}
+	if t.Name == "" {
+		t.Name = t.Key
+	}
+	s.teams = append(s.teams, &team{Team: t})
+	for _, ws := range defaultStates {
+		ws.ID = s.newID()
+		s.states = append(s.states, &state{WorkflowState: ws, teamID: t.ID})
+	}
+	return s.teamView(t.ID)
+}
+
+// AddTeamMember adds a user, by ID, email or name, to a team
+func (s *Server) AddTeamMember(teamRef, userRef string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t, ok := s.findTeam(teamRef)
+	mustFind("team", teamRef, ok)
+	u, ok := s.findUser(userRef)
+	mustFind("user", userRef, ok)
+	t.memberIDs = append(t.memberIDs, u.ID)
+}
+
+// AddState adds a workflow state to a team
+func (s *Server) AddState(teamRef string, ws api.WorkflowState) api.WorkflowState {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t, ok := s.findTeam(teamRef)
+	mustFind("team", teamRef, ok)
+	if ws.ID == "" {
+		ws.ID = s.newID()
+	}
+	s.states = append(s.states, &state{WorkflowState: ws, teamID: t.ID})
+	return ws
+}
+
+// AddLabel adds an issue label to a team, or to the workspace when teamRef is
+// empty. A label's Parent is resolved by ID or name.
+func (s *Server) AddLabel(teamRef string, l api.Label) api.Label {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	lbl := &label{
+		id:          l.ID,
+		name:        l.Name,
+		color:       l.Color,
+		description: l.Description,
+		isGroup:     l.IsGroup,
+	}
+	if lbl.id == "" {
+		lbl.id = s.newID()
+	}
+	if lbl.color == "" {
+		lbl.color = "#bec2c8"
+	}
+	if teamRef != "" {
+		t, ok := s.findTeam(teamRef)
+		mustFind("team", teamRef, ok)
+		lbl.teamID = t.ID
+	}
+	if l.Parent != nil {
+		parent, ok := s.findLabel(lbl.teamID, refOf(l.Parent.ID, l.Parent.Name))
+		mustFind("label", refOf(l.Parent.ID, l.Parent.Name), ok)
+		parent.isGroup = true
+		lbl.parentID = parent.id
+	}
+	s.labels = append(s.labels, lbl)
+	return s.labelView(lbl)
+}
+
+// AddIssue adds an issue. Team is required; State, Assignee, Creator,
+// Labels, Project, Cycle, ProjectMilestone and Parent are resolved by ID or
+// by key, name, email or identifier. The state defaults to the team's first
+// unstarted state and the creator to the viewer.
+func (s *Server) AddIssue(in api.Issue) api.Issue {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if in.Team == nil {
+		panic("linearfake: issue needs a team")
+	}
+	t, ok := s.findTeam(refOf(in.Team.ID, in.Team.Key))
+	mustFind("team", refOf(in.Team.ID, in.Team.Key), ok)
+
+	i := &issue{
+		id:          in.ID,
+		title:       in.Title,
+		description: in.Description,
+		priority:    in.Priority,
+		estimate:    in.Estimate,
+		dueDate:     in.DueDate,
+		teamID:      t.ID,
+		createdAt:   in.CreatedAt,
+		updatedAt:   in.UpdatedAt,
+		archivedAt:  in.ArchivedAt,
+		creatorID:   s.viewerID,
+	}
+	if i.id == "" {
+		i.id = s.newID()
+	}
+	if i.createdAt.IsZero() {
+		i.createdAt = now()
+	}
+	if i.updatedAt.IsZero() {
+		i.updatedAt = i.createdAt
+	}
+	t.issueSeq++
+	i.number = t.issueSeq
+	if in.Number > i.number {
+		i.number = in.Number
+		t.issueSeq = in.Number
+	}
+
+	if in.State != nil {
+		st, ok := s.findState(t.ID, refOf(in.State.ID, in.State.Name))
+		mustFind("state", refOf(in.State.ID, in.State.Name), ok)
+		i.stateID = st.ID
+	} else {
+		i.stateID = s.defaultState(t.ID)
+	}
+	if in.Assignee != nil {
+		u, ok := s.findUser(refOf(in.Assignee.ID, in.Assignee.Email, in.Assignee.Name))
+		mustFind("user", refOf(in.Assignee.ID, in.Assignee.Email, in.Assignee.Name), ok)
+		i.assigneeID = u.ID
+	}
+	if in.Creator != nil {
+		u, ok := s.findUser(refOf(in.Creator.ID, in.Creator.Email, in.Creator.Name))
+		mustFind("user", refOf(in.Creator.ID, in.Creator.Email, in.Creator.Name), ok)
+		i.creatorID = u.ID
+	}
+	if in.Labels != nil {
+		for _, l := range in.Labels.Nodes {
+			lbl, ok := s.findLabel(t.ID, refOf(l.ID, l.Name))
+			mustFind("label", refOf(l.ID, l.Name), ok)
+			i.labelIDs = append(i.labelIDs, lbl.id)
+		}
+	}
+	if in.Project != nil {
+		p, ok := s.findProject(refOf(in.Project.ID, in.Project.Name))
+		mustFind("project", refOf(in.Project.ID, in.Project.Name), ok)
+		i.projectID = p.id
+	}
+	if in.Cycle != nil {
+		ref := refOf(in.Cycle.ID, in.Cycle.Name)
+		if ref == "" {
+			ref = strconv.Itoa(in.Cycle.Number)
+		}
+		c, ok := s.findCycle(t.ID, ref)
+		mustFind("cycle", ref, ok)
+		i.cycleID = c.ID
+	}
+	if in.ProjectMilestone != nil {
+		m, ok := s.findMilestone(i.projectID, refOf(in.ProjectMilestone.ID, in.ProjectMilestone.Name))
+		mustFind("milestone", refOf(in.ProjectMilestone.ID, in.ProjectMilestone.Name), ok)
+		i.milestoneID = m.id
+	}
+	if in.Parent != nil {
+		parent, ok := s.findIssue(refOf(in.Parent.ID, in.Parent.Identifier))
+		mustFind("issue", refOf(in.Parent.ID, in.Parent.Identifier), ok)
+		i.parentID = parent.id
+	}
+	s.setIssueState(i, i.stateID)
+
+	s.issues = append(s.issues, i)
+	return s.issueView(i)
+}
+
+// AddComment adds a comment to an issue. User defaults to the viewer and
+// Parent, when set, is resolved by ID.
+func (s *Server) AddComment(issueRef string, in api.Comment) api.Comment {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i, ok := s.findIssue(issueRef)
+	mustFind("issue", issueRef, ok)
+
+	c := &comment{
+		id:         in.ID,
+		issueID:    i.id,
+		userID:     s.viewerID,
+		body:       in.Body,
+		createdAt:  in.CreatedAt,
+		editedAt:   in.EditedAt,
+		resolvedAt: in.ResolvedAt,
+	}
+	if c.id == "" {
+		c.id = s.newID()
+	}
+	if c.createdAt.IsZero() {
+		c.createdAt = now()
+	}
+	c.updatedAt = c.createdAt
+	if in.User != nil {
+		u, ok := s.findUser(refOf(in.User.ID, in.User.Email, in.User.Name))
+		mustFind("user", refOf(in.User.ID, in.User.Email, in.User.Name), ok)
+		c.userID = u.ID
+	}
+	if in.Parent != nil {
+		parent, ok := s.findComment(in.Parent.ID)
+		mustFind("comment", in.Parent.ID, ok)
+		c.parentID = parent.id
+	}
+	if c.resolvedAt != nil {
+		c.resolvingUserID = s.viewerID
+	}
+	for _, r := range in.Reactions {
+		userID := s.viewerID
+		if r.User != nil {
+			u, ok := s.findUser(refOf(r.User.ID, r.User.Email, r.User.Name))
+			mustFind("user", refOf(r.User.ID, r.User.Email, r.User.Name), ok)
+			userID = u.ID
+		}
+		c.reactions = append(c.reactions, &reaction{id: s.newID(), emoji: r.Emoji, userID: userID, createdAt: now()})
+	}
+
+	s.comments = append(s.comments, c)
+	return s.commentView(c)
+}
+
+// AddProject adds a project. Teams are resolved by ID or key, and Lead and
+// Members by ID, email or name.
+func (s *Server) AddProject(in api.Project) api.Project {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p := &project{
+		id:          in.ID,
+		slugID:      in.SlugId,
+		name:        in.Name,
+		description: in.Description,
+		content:     in.Content,
+		state:       in.State,
+		health:      in.Health,
+		progress:    in.Progress,
+		icon:        in.Icon,
+		color:       in.Color,
+		startDate:   in.StartDate,
+		targetDate:  in.TargetDate,
+		creatorID:   s.viewerID,
+		createdAt:   in.CreatedAt,
+		archivedAt:  in.ArchivedAt,
+	}
+	if p.id == "" {
+		p.id = s.newID()
+	}
+	if p.slugID == "" {
+		p.slugID = fmt.Sprintf("%x", s.seq)
+	}
+	if p.state == "" {
+		p.state = "planned"
+	}
+	if p.createdAt.IsZero() {
+		p.createdAt = now()
+	}
+	p.updatedAt = p.createdAt
+	if in.Lead != nil {
+		u, ok := s.findUser(refOf(in.Lead.ID, in.Lead.Email, in.Lead.Name))
+		mustFind("user", refOf(in.Lead.ID, in.Lead.Email, in.Lead.Name), ok)
+		p.leadID = u.ID
+	}
+	if in.Teams != nil {
+		for _, ref := range in.Teams.Nodes {
+			t, ok := s.findTeam(refOf(ref.ID, ref.Key))
+			mustFind("team", refOf(ref.ID, ref.Key), ok)
+			p.teamIDs = append(p.teamIDs, t.ID)
+		}
+	}
+	if in.Members != nil {
+		for _, ref := range in.Members.Nodes {
+			u, ok := s.findUser(refOf(ref.ID, ref.Email, ref.Name))
+			mustFind("user", refOf(ref.ID, ref.Email, ref.Name), ok)
+			p.memberIDs = append(p.memberIDs, u.ID)
+		}
+	}
+
+	s.projects = append(s.projects, p)
+	return s.projectView(p)
+}
+
+// AddCycle adds a cycle to a team. Numbers are assigned in order when unset.
+func (s *Server) AddCycle(teamRef string, in api.Cycle) api.Cycle {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t, ok := s.findTeam(teamRef)
+	mustFind("team", teamRef, ok)
+	if in.ID == "" {
+		in.ID = s.newID()
+	}
+	if in.Number == 0 {
+		for _, c := range s.cycles {
+			if c.teamID == t.ID && c.Number >= in.Number {
+				in.Number = c.Number
+			}
+		}
+		in.Number++
+	}
+	in.Team = nil
+	in.Issues = nil
+	c := &cycle{Cycle: in, teamID: t.ID}
+	s.cycles = append(s.cycles, c)
+	return s.cycleView(c, false)
+}
+
+// AddMilestone adds a milestone to a project, resolved by ID, slug or name
+func (s *Server) AddMilestone(projectRef string, in api.Milestone) api.Milestone {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, ok := s.findProject(projectRef)
+	mustFind("project", projectRef, ok)
+	m := &milestone{
+		id:          in.ID,
+		name:        in.Name,
+		description: in.Description,
+		targetDate:  in.TargetDate,
+		sortOrder:   in.SortOrder,
+		projectID:   p.id,
+	}
+	if m.id == "" {
+		m.id = s.newID()
+	}
+	s.milestones = append(s.milestones, m)
+	return s.milestoneView(m)
+}
+
+// AddTemplate adds a template. Team, when set, is resolved by ID or key.
+func (s *Server) AddTemplate(in api.Template) api.Template {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if in.ID == "" {
+		in.ID = s.newID()
+	}
+	if in.Type == "" {
+		in.Type = api.TemplateTypeIssue
+	}
+	if in.Team != nil {
+		t, ok := s.findTeam(refOf(in.Team.ID, in.Team.Key))
+		mustFind("team", refOf(in.Team.ID, in.Team.Key), ok)
+		in.Team = &api.Team{ID: t.ID, Key: t.Key, Name: t.Name}
+	}
+	s.templates = append(s.templates, &in)
+	return in
+}
+
+// AddRelation relates two issues, each resolved by ID or identifier
+func (s *Server) AddRelation(issueRef, relatedRef, kind string) api.IssueRelation {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	from, ok := s.findIssue(issueRef)
+	mustFind("issue", issueRef, ok)
+	to, ok := s.findIssue(relatedRef)
+	mustFind("issue", relatedRef, ok)
+	r := &relation{id: s.newID(), kind: kind, issueID: from.id, relatedIssueID: to.id}
+	s.relations = append(s.relations, r)
+	return s.relationView(r)
+}
+
+// AddAttachment attaches a link to an issue
+func (s *Server) AddAttachment(issueRef string, in api.Attachment) api.Attachment {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i, ok := s.findIssue(issueRef)
+	mustFind("issue", issueRef, ok)
+	a := &attachment{
+		id:        in.ID,
+		issueID:   i.id,
+		title:     in.Title,
+		subtitle:  in.Subtitle,
+		url:       in.URL,
+		metadata:  in.Metadata,
+		creatorID: s.viewerID,
+		createdAt: in.CreatedAt,
+	}
+	if a.id == "" {
+		a.id = s.newID()
+	}
+	if a.createdAt.IsZero() {
+		a.createdAt = now()
+	}
+	s.attachments = append(s.attachments, a)
+	return s.attachmentView(a)
+}
+
+// Issue returns an issue by ID or identifier as the API would render it
+func (s *Server) Issue(ref string) (api.Issue, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i, ok := s.findIssue(ref)
+	if !ok {
+		return api.Issue{}, false
+	}
+	return s.issueView(i), true
+}
+
+// Issues returns every issue, including archived and trashed ones
+func (s *Server) Issues() []api.Issue {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	issues := make([]api.Issue, 0, len(s.issues))
+	for _, i := range s.issues {
+		issues = append(issues, s.issueView(i))
+	}
+	return issues
+}
+
+// Comment returns a comment by ID
+func (s *Server) Comment(id string) (api.Comment, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, ok := s.findComment(id)
+	if !ok {
+		return api.Comment{}, false
+	}
+	return s.commentView(c), true
+}
+
+// Project returns a project by ID, slug or name
+func (s *Server) Project(ref string) (api.Project, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, ok := s.findProject(ref)
+	if !ok {
+		return api.Project{}, false
+	}
+	return s.projectView(p), true
+}
+
+// Labels returns every label, including archived ones
+func (s *Server) Labels() []api.Label {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	labels := make([]api.Label, 0, len(s.labels))
+	for _, l := range s.labels {
+		labels = append(labels, s.labelView(l))
+	}
+	return labels
+}
+
+// refOf returns the first non-empty reference
+func refOf(refs ...string) string {
+	for _, ref := range refs {
+		if ref != "" {
+			return ref
+		}
+	}
+	return ""
+}
+
+func (s *Server) findUser(ref string) (*api.User, bool) {
+	for _, u := range s.users {
+		if u.ID == ref || strings.EqualFold(u.Email, ref) || strings.EqualFold(u.Name, ref) || strings.EqualFold(u.DisplayName, ref) {
+			return u, true
+		}
+	}
+	return nil, false
+}
+
+func (s *Server) findTeam(ref string) (*team, bool) {
+	for _, t := range s.teams {
+		if t.ID == ref || strings.EqualFold(t.Key, ref) {
+			return t, true
+		}
+	}
+	return nil, false
+}
+
+func (s *Server) findState(teamID, ref string) (*state, bool) {
+	for _, st := range s.states {
+		if st.ID == ref || (st.teamID == teamID && strings.EqualFold(st.Name, ref)) {
+			return st, true
+		}
+	}
+	return nil, false
+}
+
+// defaultState is the state new issues start in
+func (s *Server) defaultState(teamID string) string {
+	first := ""
+	for _, st := range s.states {
+		if st.teamID != teamID {
+			continue
+		}
+		if st.Type == "unstarted" {
+			return st.ID
+		}
+		if first == "" {
+			first = st.ID
+		}
+	}
+	return first
+}
+
+// findLabel finds a label by ID, or by name among the team's and the
+// workspace's labels
+func (s *Server) findLabel(teamID, ref string) (*label, bool) {
+	for _, l := range s.labels {
+		if l.id == ref || (strings.EqualFold(l.name, ref) && (l.teamID == "" || l.teamID == teamID)) {
+			return l, true
+		}
+	}
+	return nil, false
+}
+
+func (s *Server) findIssue(ref string) (*issue, bool) {
+	for _, i := range s.issues {
+		if i.id == ref || strings.EqualFold(s.identifier(i), ref) {
+			return i, true
+		}
+	}
+	return nil, false
+}
+
+func (s *Server) findComment(id string) (*comment, bool) {
+	for _, c := range s.comments {
+		if c.id == id {
+			return c, true
+		}
+	}
+	return nil, false
+}
+
+func (s *Server) findProject(ref string) (*project, bool) {
+	for _, p := range s.projects {
+		if p.id == ref || p.slugID == ref || strings.EqualFold(p.name, ref) {
+			return p, true
+		}
+	}
+	return nil, false
+}
+
+// findCycle finds a cycle by ID, or by number or name within a team
+func (s *Server) findCycle(teamID, ref string) (*cycle, bool) {
+	for _, c := range s.cycles {
+		if c.ID == ref {
+			return c, true
+		}
+		if c.teamID == teamID && (strconv.Itoa(c.Number) == ref || (c.Name != "" && strings.EqualFold(c.Name, ref))) {
+			return c, true
+		}
+	}
+	return nil, false
+}
+
+// findMilestone finds a milestone by ID, or by name within a project
+func (s *Server) findMilestone(projectID, ref string) (*milestone, bool) {
+	for _, m := range s.milestones {
+		if m.id == ref || (m.projectID == projectID && strings.EqualFold(m.name, ref)) {
+			return m, true
+		}
+	}
+	return nil, false
+}
+
+func (s *Server) findRelation(id string) (int, bool) {
+	for n, r := range s.relations {
+		if r.id == id {
+			return n, true
+		}
+	}
+	return 0, false
+}
+
+func (s *Server) identifier(i *issue) string {
+	for _, t := range s.teams {
+		if t.ID == i.teamID {
+			return fmt.Sprintf("%s-%d", t.Key, i.number)
+		}
+	}
+	return strconv.Itoa(i.number)
+}
+
+// setIssueState moves an issue to a state, stamping when it was completed
+// or canceled
+func (s *Server) setIssueState(i *issue, stateID string) {
+	i.stateID = stateID
+	i.completedAt = nil
+	i.canceledAt = nil
+	for _, st := range s.states {
+		if st.ID != stateID {
+			continue
+		}
+		stamp := now()
+		switch st.Type {
+		case "completed":
+			i.completedAt = &stamp
+		case "canceled":
+			i.canceledAt = &stamp
+		}
+	}
+}
@@ -0,0 +1,257 @@
+package linearfake
+
+import (
+	"strings"
+	"time"
+
+	"github.com/yjiky/linctl/pkg/api"
+)
+
+func (s *Server) queryViewer(map[string]interface{}) (interface{}, error) {
+	if s.viewerID == "" {
+		return nil, &api.Error{Code: api.CodeAuthentication, Message: "linearfake: no viewer, add a user first"}
+	}
+	return map[string]interface{}{"viewer": s.userView(s.viewerID)}, nil
+}
+
+func (s *Server) queryUsers(variables map[string]interface{}) (interface{}, error) {
+	users := []api.User{}
+	for _, u := range s.users {
+		users = append(users, s.userView(u.ID))
+	}
+	return map[string]interface{}{
+		"users": paginate(users, variables, func(u api.User) string { return u.ID }),
+	}, nil
+}
+
+func (s *Server) queryUser(variables map[string]interface{}) (interface{}, error) {
+	email := stringVar(variables, "email")
+	for _, u := range s.users {
+		if strings.EqualFold(u.Email, email) {
+			return map[string]interface{}{"user": s.userView(u.ID)}, nil
+		}
+	}
+	return nil, notFound("User")
+}
+
+func (s *Server) queryTeams(variables map[string]interface{}) (interface{}, error) {
+	teams := []api.Team{}
+	for _, t := range s.teams {
+		teams = append(teams, s.teamView(t.ID))
+	}
+	return map[string]interface{}{
+		"teams": paginate(teams, variables, func(t api.Team) string { return t.ID }),
+	}, nil
+}
+
+// lookupTeam finds the team an operation's key or teamKey variable names
+func (s *Server) lookupTeam(variables map[string]interface{}) (*team, error) {
+	ref := refOf(stringVar(variables, "key"), stringVar(variables, "teamKey"))
+	t, ok := s.findTeam(ref)
+	if !ok {
+		return nil, notFound("Team")
+	}
+	return t, nil
+}
+
+func (s *Server) queryTeam(variables map[string]interface{}) (interface{}, error) {
+	t, err := s.lookupTeam(variables)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"team": s.teamView(t.ID)}, nil
+}
+
+func (s *Server) queryTeamStates(variables map[string]interface{}) (interface{}, error) {
+	t, err := s.lookupTeam(variables)
+	if err != nil {
+		return nil, err
+	}
+	states := []api.WorkflowState{}
+	for _, st := range s.states {
+		if st.teamID == t.ID {
+			states = append(states, st.WorkflowState)
+		}
+	}
+	return map[string]interface{}{
+		"team": map[string]interface{}{
+			"id":     t.ID,
+			"states": map[string]interface{}{"nodes": states},
+		},
+	}, nil
+}
+
+// queryTeamLabels returns the labels usable on the team's issues: its own and
+// the workspace's
+func (s *Server) queryTeamLabels(variables map[string]interface{}) (interface{}, error) {
+	t, err := s.lookupTeam(variables)
+	if err != nil {
+		return nil, err
+	}
+	labels := []api.Label{}
+	for _, l := range s.labels {
+		if l.archivedAt == nil && (l.teamID == "" || l.teamID == t.ID) {
+			labels = append(labels, s.labelView(l))
+		}
+	}
+	return map[string]interface{}{
+		"team": map[string]interface{}{
+			"id":     t.ID,
+			"labels": map[string]interface{}{"nodes": labels},
+		},
+	}, nil
+}
+
+func (s *Server) queryTeamMembers(variables map[string]interface{}) (interface{}, error) {
+	t, err := s.lookupTeam(variables)
+	if err != nil {
+		return nil, err
+	}
+	members := []api.User{}
+	for _, id := range t.memberIDs {
+		members = append(members, s.userView(id))
+	}
+	return map[string]interface{}{
+		"team": map[string]interface{}{
+			"id": t.ID,
+			"members": map[string]interface{}{
+				"nodes":    members,
+				"pageInfo": api.PageInfo{},
+			},
+		},
+	}, nil
+}
+
+// labelViews renders labels, leaving out archived ones unless asked
+func (s *Server) labelViews(includeArchived bool) []api.Label {
+	labels := []api.Label{}
+	for _, l := range s.labels {
+		if l.archivedAt == nil || includeArchived {
+			labels = append(labels, s.labelView(l))
+		}
+	}
+	return labels
+}
+
+func labelID(l api.Label) string { return l.ID }
+
+func (s *Server) queryIssueLabels(variables map[string]interface{}) (interface{}, error) {
+	labels, err := filterNodes(s.labelViews(false), variables["filter"])
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"issueLabels": paginate(labels, variables, labelID)}, nil
+}
+
+// queryLabelsByID serves GetLabelsByID, whose filter is part of the query
+func (s *Server) queryLabelsByID(variables map[string]interface{}) (interface{}, error) {
+	filter := map[string]interface{}{
+		"id": map[string]interface{}{"in": variables["ids"]},
+	}
+	labels, err := filterNodes(s.labelViews(true), filter)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"issueLabels": paginate(labels, variables, labelID)}, nil
+}
+
+func (s *Server) createIssueLabel(variables map[string]interface{}) (interface{}, error) {
+	input := inputVar(variables)
+	l := &label{id: s.newID(), color: "#bec2c8"}
+	if teamID, ok := input["teamId"]; ok && teamID != nil {
+		t, found := s.findTeam(stringOf(teamID))
+		if !found || t.ID != stringOf(teamID) {
+			return nil, notFound("Team")
+		}
+		l.teamID = t.ID
+	}
+	if err := s.applyLabelInput(l, input, "IssueLabelCreateInput"); err != nil {
+		return nil, err
+	}
+	for _, existing := range s.labels {
+		if existing.archivedAt == nil && existing.teamID == l.teamID && strings.EqualFold(existing.name, l.name) {
+			return nil, invalidInput("Duplicate label name: a label named %q already exists", l.name)
+		}
+	}
+	s.labels = append(s.labels, l)
+	return map[string]interface{}{
+		"issueLabelCreate": map[string]interface{}{
+			"success":    true,
+			"issueLabel": s.labelView(l),
+		},
+	}, nil
+}
+
+func (s *Server) updateIssueLabel(variables map[string]interface{}) (interface{}, error) {
+	l, ok := s.findLabel("", stringVar(variables, "id"))
+	if !ok {
+		return nil, notFound("IssueLabel")
+	}
+	updated := *l
+	if err := s.applyLabelInput(&updated, inputVar(variables), "IssueLabelUpdateInput"); err != nil {
+		return nil, err
+	}
+	*l = updated
+	return map[string]interface{}{
+		"issueLabelUpdate": map[string]interface{}{
+			"success":    true,
+			"issueLabel": s.labelView(l),
+		},
+	}, nil
+}
+
+// applyLabelInput applies the fields of an IssueLabelCreateInput or
+// IssueLabelUpdateInput
+func (s *Server) applyLabelInput(l *label, input map[string]interface{}, inputType string) error {
+	for field, value := range input {
+		switch field {
+		case "name":
+			l.name = strings.TrimSpace(stringOf(value))
+			if l.name == "" {
+				return invalidInput("name must not be empty")
+			}
+		case "color":
+			l.color = stringOf(value)
+		case "description":
+			description := stringOf(value)
+			l.description = &description
+		case "isGroup":
+			l.isGroup, _ = value.(bool)
+		case "parentId":
+			if value == nil {
+				l.parentID = ""
+				break
+			}
+			parent, ok := s.findLabel("", stringOf(value))
+			if !ok || !parent.isGroup {
+				return invalidInput("parentId must be a label group")
+			}
+			if parent.id == l.id {
+				return invalidInput("a label cannot be its own parent")
+			}
+			l.parentID = parent.id
+		case "teamId":
+			if inputType != "IssueLabelCreateInput" {
+				return invalidInput("Field %q is not defined by type %s", field, inputType)
+			}
+		default:
+			return invalidInput("Field %q is not defined by type %s", field, inputType)
+		}
+	}
+	return nil
+}
+
+// deleteIssueLabel removes the label from every issue and hides it, as
+// Linear keeps deleted labels around as archived
+func (s *Server) deleteIssueLabel(variables map[string]interface{}) (interface{}, error) {
+	l, ok := s.findLabel("", stringVar(variables, "id"))
+	if !ok || l.archivedAt != nil {
+		return nil, notFound("IssueLabel")
+	}
+	for _, i := range s.issues {
+		i.labelIDs = removeAll(i.labelIDs, l.id)
+	}
+	deleted := time.Now().UTC()
+	l.archivedAt = &deleted
+	return success("issueLabelDelete"), nil
+}
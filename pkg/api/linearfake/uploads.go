@@ -0,0 +1,244 @@
+package linearfake
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/yjiky/linctl/pkg/api"
+)
+
+// Paths of the fake's file storage. Uploads are PUT to uploadPath and
+// served from filePath, like Linear's pre-signed storage URLs.
+const (
+	uploadPath = "/uploads/"
+	filePath   = "/files/"
+)
+
+// Upload is a file requested with the FileUpload mutation
+type Upload struct {
+	ID          string
+	Filename    string
+	ContentType string
+	Size        int64
+	UploadURL   string
+	AssetURL    string
+
+	// Uploaded reports whether the file's content was PUT; Data and Header
+	// are the body and headers of that request
+	Uploaded bool
+	Data     []byte
+	Header   http.Header
+}
+
+// AddFile stores a file as if it had been uploaded and returns its asset URL,
+// for attachments that are downloaded
+func (s *Server) AddFile(filename, contentType string, data []byte) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u := s.newUpload(filename, contentType, int64(len(data)))
+	u.Uploaded = true
+	u.Data = data
+	u.Header = http.Header{}
+	return u.AssetURL
+}
+
+// Upload returns the upload with the given asset URL
+func (s *Server) Upload(assetURL string) (Upload, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, u := range s.uploads {
+		if u.AssetURL == assetURL {
+			return *u, true
+		}
+	}
+	return Upload{}, false
+}
+
+// Uploads returns every upload requested so far
+func (s *Server) Uploads() []Upload {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	uploads := make([]Upload, 0, len(s.uploads))
+	for _, u := range s.uploads {
+		uploads = append(uploads, *u)
+	}
+	return uploads
+}
+
+func (s *Server) newUpload(filename, contentType string, size int64) *Upload {
+	id := s.newID()
+	u := &Upload{
+		ID:          id,
+		Filename:    filename,
+		ContentType: contentType,
+		Size:        size,
+		UploadURL:   s.server.URL + uploadPath + id,
+		AssetURL:    s.server.URL + filePath + id + "/" + filename,
+	}
+	s.uploads = append(s.uploads, u)
+	return u
+}
+
+func (s *Server) fileUpload(variables map[string]interface{}) (interface{}, error) {
+	filename := stringVar(variables, "filename")
+	contentType := stringVar(variables, "contentType")
+	size := intVar(variables, "size", -1)
+	if filename == "" || contentType == "" {
+		return nil, invalidInput("filename and contentType must not be empty")
+	}
+	if size < 0 {
+		return nil, invalidInput("size must be a non-negative integer")
+	}
+
+	u := s.newUpload(filename, contentType, int64(size))
+	return map[string]interface{}{
+		"fileUpload": map[string]interface{}{
+			"success": true,
+			"uploadFile": api.UploadFile{
+				UploadURL: u.UploadURL,
+				AssetURL:  u.AssetURL,
+				Headers: []api.UploadHeader{
+					{Key: "Content-Disposition", Value: fmt.Sprintf("attachment; filename=%q", filename)},
+					{Key: "X-Goog-Content-Length-Range", Value: fmt.Sprintf("%d,%d", size, size)},
+				},
+			},
+		},
+	}, nil
+}
+
+// serveUpload accepts the PUT of a file requested with FileUpload, checking
+// it carries the headers and size it was requested with
+func (s *Server) serveUpload(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPut {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := strings.TrimPrefix(r.URL.Path, uploadPath)
+	var upload *Upload
+	for _, u := range s.uploads {
+		if u.ID == id {
+			upload = u
+		}
+	}
+	switch {
+	case upload == nil:
+		http.Error(w, "no such upload", http.StatusNotFound)
+	case r.Header.Get("Content-Type") != upload.ContentType:
+		http.Error(w, fmt.Sprintf("content type %q does not match %q", r.Header.Get("Content-Type"), upload.ContentType), http.StatusForbidden)
+	case r.Header.Get("X-Goog-Content-Length-Range") == "":
+		http.Error(w, "missing upload headers", http.StatusForbidden)
+	case int64(len(data)) != upload.Size:
+		http.Error(w, fmt.Sprintf("size %d does not match %d", len(data), upload.Size), http.StatusBadRequest)
+	default:
+		upload.Uploaded = true
+		upload.Data = data
+		upload.Header = r.Header.Clone()
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+// serveFile serves an uploaded file to authenticated requests
+func (s *Server) serveFile(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	authorization := r.Header.Get("Authorization")
+	if authorization == "" || (s.apiKey != "" && authorization != s.apiKey) {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+	for _, u := range s.uploads {
+		if s.server.URL+r.URL.Path == u.AssetURL && u.Uploaded {
+			w.Header().Set("Content-Type", u.ContentType)
+			_, _ = w.Write(u.Data)
+			return
+		}
+	}
+	http.Error(w, "not found", http.StatusNotFound)
+}
+
+// createAttachment attaches a URL to an issue. Like Linear, attaching the
+// same URL again updates the existing attachment.
+func (s *Server) createAttachment(variables map[string]interface{}) (interface{}, error) {
+	input := inputVar(variables)
+	i, ok := s.findIssue(stringOf(input["issueId"]))
+	if !ok {
+		return nil, notFound("Issue")
+	}
+	url := stringOf(input["url"])
+	if url == "" {
+		return nil, invalidInput("url must not be empty")
+	}
+
+	for field := range input {
+		switch field {
+		case "issueId", "url", "title", "subtitle", "metadata":
+		default:
+			return nil, invalidInput("Field %q is not defined by type AttachmentCreateInput", field)
+		}
+	}
+
+	var a *attachment
+	for _, existing := range s.attachments {
+		if existing.issueID == i.id && existing.url == url {
+			a = existing
+		}
+	}
+	if a == nil {
+		a = &attachment{id: s.newID(), issueID: i.id, url: url, creatorID: s.viewerID, createdAt: now()}
+		s.attachments = append(s.attachments, a)
+	}
+	for field, value := range input {
+		switch field {
+		case "title":
+			a.title = stringOf(value)
+		case "subtitle":
+			subtitle := stringOf(value)
+			a.subtitle = &subtitle
+		case "metadata":
+			a.metadata, _ = value.(map[string]interface{})
+		}
+	}
+
+	return map[string]interface{}{
+		"attachmentCreate": map[string]interface{}{
+			"success":    true,
+			"attachment": s.attachmentView(a),
+		},
+	}, nil
+}
+
+func (s *Server) queryIssueAttachments(variables map[string]interface{}) (interface{}, error) {
+	i, err := s.lookupIssue(variables)
+	if err != nil {
+		return nil, err
+	}
+	attachments := []api.Attachment{}
+	for _, a := range s.attachments {
+		if a.issueID == i.id && len(attachments) < intVar(variables, "first", 50) {
+			attachments = append(attachments, s.attachmentView(a))
+		}
+	}
+	return map[string]interface{}{
+		"issue": map[string]interface{}{
+			"id":          i.id,
+			"identifier":  s.identifier(i),
+			"title":       i.title,
+			"attachments": map[string]interface{}{"nodes": attachments},
+		},
+	}, nil
+}
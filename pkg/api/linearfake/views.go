@@ -0,0 +1,359 @@
+package linearfake
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/yjiky/linctl/pkg/api"
+)
+
+// The views below render entities the way the API returns them. They
+// include every field linctl queries for, so one view serves all of the
+// operations touching an entity; nested issues, comments and projects are
+// rendered shallowly to keep the graph finite.
+
+const webURL = "https://linear.app/linearfake"
+
+func (s *Server) userView(id string) api.User {
+	for _, u := range s.users {
+		if u.ID == id {
+			view := *u
+			view.IsMe = u.ID == s.viewerID
+			return view
+		}
+	}
+	return api.User{}
+}
+
+// userRef renders an optional user reference
+func (s *Server) userRef(id string) *api.User {
+	if id == "" {
+		return nil
+	}
+	u := s.userView(id)
+	return &u
+}
+
+func (s *Server) teamView(id string) api.Team {
+	t, ok := s.findTeam(id)
+	if !ok {
+		return api.Team{}
+	}
+	view := t.Team
+	view.IssueCount = 0
+	for _, i := range s.issues {
+		if i.teamID == t.ID && i.archivedAt == nil {
+			view.IssueCount++
+		}
+	}
+	return view
+}
+
+func (s *Server) teamRef(id string) *api.Team {
+	if id == "" {
+		return nil
+	}
+	t := s.teamView(id)
+	return &t
+}
+
+func (s *Server) stateRef(id string) *api.State {
+	for _, st := range s.states {
+		if st.ID == id {
+			description := st.Description
+			return &api.State{
+				ID:          st.ID,
+				Name:        st.Name,
+				Type:        st.Type,
+				Color:       st.Color,
+				Description: &description,
+				Position:    st.Position,
+			}
+		}
+	}
+	return nil
+}
+
+func (s *Server) labelView(l *label) api.Label {
+	view := api.Label{
+		ID:          l.id,
+		Name:        l.name,
+		Color:       l.color,
+		Description: l.description,
+		IsGroup:     l.isGroup,
+		Team:        s.teamRef(l.teamID),
+	}
+	for _, parent := range s.labels {
+		if parent.id == l.parentID {
+			view.Parent = &api.Label{ID: parent.id, Name: parent.name, Color: parent.color}
+		}
+	}
+	return view
+}
+
+func (s *Server) labelsOf(ids []string) *api.Labels {
+	labels := &api.Labels{Nodes: []api.Label{}}
+	for _, id := range ids {
+		for _, l := range s.labels {
+			if l.id == id {
+				labels.Nodes = append(labels.Nodes, s.labelView(l))
+			}
+		}
+	}
+	return labels
+}
+
+// issueRef renders the summary of an issue nested in another entity
+func (s *Server) issueRef(id string) *api.Issue {
+	for _, i := range s.issues {
+		if i.id == id {
+			return &api.Issue{
+				ID:         i.id,
+				Identifier: s.identifier(i),
+				Title:      i.title,
+				Priority:   i.priority,
+				CreatedAt:  i.createdAt,
+				UpdatedAt:  i.updatedAt,
+				URL:        s.issueURL(i),
+				State:      s.stateRef(i.stateID),
+				Assignee:   s.userRef(i.assigneeID),
+				Team:       s.teamRef(i.teamID),
+			}
+		}
+	}
+	return nil
+}
+
+func (s *Server) issueURL(i *issue) string {
+	return fmt.Sprintf("%s/issue/%s", webURL, s.identifier(i))
+}
+
+func (s *Server) issueView(i *issue) api.Issue {
+	identifier := s.identifier(i)
+	view := api.Issue{
+		ID:            i.id,
+		Identifier:    identifier,
+		Number:        i.number,
+		Title:         i.title,
+		Description:   i.description,
+		Priority:      i.priority,
+		PriorityLabel: priorityLabels[i.priority%len(priorityLabels)],
+		Estimate:      i.estimate,
+		DueDate:       i.dueDate,
+		CreatedAt:     i.createdAt,
+		UpdatedAt:     i.updatedAt,
+		CompletedAt:   i.completedAt,
+		CanceledAt:    i.canceledAt,
+		ArchivedAt:    i.archivedAt,
+		URL:           s.issueURL(i),
+		BranchName:    strings.ToLower(identifier),
+		State:         s.stateRef(i.stateID),
+		Assignee:      s.userRef(i.assigneeID),
+		Creator:       s.userRef(i.creatorID),
+		Team:          s.teamRef(i.teamID),
+		Labels:        s.labelsOf(i.labelIDs),
+		Parent:        s.issueRef(i.parentID),
+		Children:      &api.Issues{Nodes: []api.Issue{}},
+		Attachments:   &api.Attachments{Nodes: []api.Attachment{}},
+		Comments:      &api.Comments{Nodes: []api.Comment{}},
+		Relations:     &api.IssueRelations{Nodes: []api.IssueRelation{}},
+		InverseRelations: &api.IssueRelations{
+			Nodes: []api.IssueRelation{},
+		},
+		Subscribers: &api.Users{Nodes: []api.User{}},
+	}
+	for _, child := range s.issues {
+		if child.parentID == i.id && !child.trashed {
+			view.Children.Nodes = append(view.Children.Nodes, *s.issueRef(child.id))
+		}
+	}
+	if p, ok := s.findProject(i.projectID); ok && i.projectID != "" {
+		ref := s.projectRef(p)
+		view.Project = &ref
+	}
+	for _, c := range s.cycles {
+		if c.ID == i.cycleID {
+			ref := s.cycleView(c, false)
+			view.Cycle = &ref
+		}
+	}
+	for _, m := range s.milestones {
+		if m.id == i.milestoneID {
+			ref := s.milestoneView(m)
+			view.ProjectMilestone = &ref
+		}
+	}
+	for _, a := range s.attachments {
+		if a.issueID == i.id {
+			view.Attachments.Nodes = append(view.Attachments.Nodes, s.attachmentView(a))
+		}
+	}
+	for _, c := range s.comments {
+		if c.issueID == i.id {
+			view.Comments.Nodes = append(view.Comments.Nodes, s.commentView(c))
+		}
+	}
+	for _, r := range s.relations {
+		if r.issueID == i.id {
+			view.Relations.Nodes = append(view.Relations.Nodes, s.relationView(r))
+		}
+		if r.relatedIssueID == i.id {
+			view.InverseRelations.Nodes = append(view.InverseRelations.Nodes, s.relationView(r))
+		}
+	}
+	if i.assigneeID != "" {
+		view.Subscribers.Nodes = append(view.Subscribers.Nodes, s.userView(i.assigneeID))
+	}
+	return view
+}
+
+func (s *Server) commentView(c *comment) api.Comment {
+	view := api.Comment{
+		ID:            c.id,
+		Body:          c.body,
+		CreatedAt:     c.createdAt,
+		UpdatedAt:     c.updatedAt,
+		EditedAt:      c.editedAt,
+		User:          s.userRef(c.userID),
+		ResolvedAt:    c.resolvedAt,
+		ResolvingUser: s.userRef(c.resolvingUserID),
+		Reactions:     []api.Reaction{},
+		Children:      &api.Comments{Nodes: []api.Comment{}},
+		Issue:         s.issueRef(c.issueID),
+	}
+	if view.Issue != nil {
+		view.URL = fmt.Sprintf("%s#comment-%s", view.Issue.URL, c.id[len(c.id)-8:])
+	}
+	if c.parentID != "" {
+		view.Parent = &api.Comment{ID: c.parentID}
+	}
+	for _, r := range c.reactions {
+		view.Reactions = append(view.Reactions, s.reactionView(r))
+	}
+	for _, child := range s.comments {
+		if child.parentID == c.id {
+			view.Children.Nodes = append(view.Children.Nodes, api.Comment{
+				ID:        child.id,
+				Body:      child.body,
+				CreatedAt: child.createdAt,
+				UpdatedAt: child.updatedAt,
+				User:      s.userRef(child.userID),
+				Parent:    &api.Comment{ID: c.id},
+			})
+		}
+	}
+	return view
+}
+
+func (s *Server) reactionView(r *reaction) api.Reaction {
+	return api.Reaction{
+		ID:        r.id,
+		Emoji:     r.emoji,
+		User:      s.userRef(r.userID),
+		CreatedAt: r.createdAt,
+	}
+}
+
+// projectRef renders the summary of a project nested in another entity
+func (s *Server) projectRef(p *project) api.Project {
+	return api.Project{
+		ID:          p.id,
+		SlugId:      p.slugID,
+		Name:        p.name,
+		Description: p.description,
+		State:       p.state,
+		Progress:    p.progress,
+		StartDate:   p.startDate,
+		TargetDate:  p.targetDate,
+		Health:      p.health,
+		URL:         fmt.Sprintf("%s/project/%s", webURL, p.slugID),
+		Lead:        s.userRef(p.leadID),
+		CreatedAt:   p.createdAt,
+		UpdatedAt:   p.updatedAt,
+	}
+}
+
+func (s *Server) projectView(p *project) api.Project {
+	view := s.projectRef(p)
+	view.Content = p.content
+	view.Icon = p.icon
+	view.Color = p.color
+	view.CompletedAt = p.completedAt
+	view.CanceledAt = p.canceledAt
+	view.ArchivedAt = p.archivedAt
+	view.Creator = s.userRef(p.creatorID)
+	view.Teams = &api.Teams{Nodes: []api.Team{}}
+	for _, id := range p.teamIDs {
+		view.Teams.Nodes = append(view.Teams.Nodes, s.teamView(id))
+	}
+	view.Members = &api.Users{Nodes: []api.User{}}
+	for _, id := range p.memberIDs {
+		view.Members.Nodes = append(view.Members.Nodes, s.userView(id))
+	}
+	view.Issues = &api.Issues{Nodes: []api.Issue{}}
+	for _, i := range s.issues {
+		if i.projectID == p.id && i.archivedAt == nil {
+			ref := s.issueRef(i.id)
+			ref.Labels = s.labelsOf(i.labelIDs)
+			view.Issues.Nodes = append(view.Issues.Nodes, *ref)
+			view.Scope++
+		}
+	}
+	return view
+}
+
+// cycleView renders a cycle, with its issues when withIssues is set
+func (s *Server) cycleView(c *cycle, withIssues bool) api.Cycle {
+	view := c.Cycle
+	view.Team = s.teamRef(c.teamID)
+	if withIssues {
+		view.Issues = &api.Issues{Nodes: []api.Issue{}}
+		for _, i := range s.issues {
+			if i.cycleID == c.ID && i.archivedAt == nil {
+				view.Issues.Nodes = append(view.Issues.Nodes, *s.issueRef(i.id))
+			}
+		}
+	}
+	return view
+}
+
+// milestoneView renders a milestone
+func (s *Server) milestoneView(m *milestone) api.Milestone {
+	view := api.Milestone{
+		ID:          m.id,
+		Name:        m.name,
+		Description: m.description,
+		TargetDate:  m.targetDate,
+		SortOrder:   m.sortOrder,
+	}
+	if p, ok := s.findProject(m.projectID); ok {
+		ref := api.Project{ID: p.id, Name: p.name}
+		view.Project = &ref
+	}
+	return view
+}
+
+func (s *Server) relationView(r *relation) api.IssueRelation {
+	return api.IssueRelation{
+		ID:           r.id,
+		Type:         r.kind,
+		Issue:        s.issueRef(r.issueID),
+		RelatedIssue: s.issueRef(r.relatedIssueID),
+	}
+}
+
+func (s *Server) attachmentView(a *attachment) api.Attachment {
+	metadata := a.metadata
+	if metadata == nil {
+		metadata = map[string]interface{}{}
+	}
+	return api.Attachment{
+		ID:        a.id,
+		Title:     a.title,
+		Subtitle:  a.subtitle,
+		URL:       a.url,
+		Metadata:  metadata,
+		CreatedAt: a.createdAt,
+		Creator:   s.userRef(a.creatorID),
+	}
+}
@@ -0,0 +1,276 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+const milestoneFields = `
+	id
+	name
+	description
+	targetDate
+	sortOrder
+	project {
+		id
+		name
+		slugId
+	}
+`
+
+// MilestoneFilter builds a ProjectMilestoneFilter for a milestone ID or a
+// case-insensitive milestone name
+func MilestoneFilter(ref string) (map[string]interface{}, error) {
+	ref = strings.TrimSpace(ref)
+	if ref == "" {
+		return nil, fmt.Errorf("empty milestone reference")
+	}
+	if uuidPattern.MatchString(ref) {
+		return map[string]interface{}{"id": map[string]interface{}{"eq": ref}}, nil
+	}
+	return map[string]interface{}{"name": map[string]interface{}{"eqIgnoreCase": ref}}, nil
+}
+
+// GetProjectMilestones returns one page of a project's milestones, without
+// their issues
+func (c *Client) GetProjectMilestones(ctx context.Context, projectID string, first int, after string) (*Milestones, error) {
+	query := `
+		query ProjectMilestones($id: String!, $first: Int, $after: String) {
+			project(id: $id) {
+				id
+				projectMilestones(first: $first, after: $after) {
+					nodes {` + milestoneFields + `}
+					pageInfo {
+						hasNextPage
+						endCursor
+					}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id":    projectID,
+		"first": first,
+	}
+	if after != "" {
+		variables["after"] = after
+	}
+
+	var response struct {
+		Project *struct {
+			ID                string     `json:"id"`
+			ProjectMilestones Milestones `json:"projectMilestones"`
+		} `json:"project"`
+	}
+
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return nil, err
+	}
+
+	if response.Project == nil || response.Project.ID == "" {
+		return nil, newNotFoundError("project", projectID)
+	}
+
+	return &response.Project.ProjectMilestones, nil
+}
+
+// ProjectMilestonePages returns a PageFetcher over GetProjectMilestones
+func (c *Client) ProjectMilestonePages(projectID string) PageFetcher[Milestone] {
+	return func(ctx context.Context, first int, after string) ([]Milestone, PageInfo, error) {
+		milestones, err := c.GetProjectMilestones(ctx, projectID, first, after)
+		if err != nil {
+			return nil, PageInfo{}, err
+		}
+		return milestones.Nodes, milestones.PageInfo, nil
+	}
+}
+
+// GetMilestoneIssues returns one page of a milestone's issues with the state
+// type of each, so callers can report completion
+func (c *Client) GetMilestoneIssues(ctx context.Context, milestoneID string, first int, after string) (*Issues, error) {
+	query := `
+		query MilestoneIssues($id: String!, $first: Int, $after: String) {
+			projectMilestone(id: $id) {
+				id
+				issues(first: $first, after: $after) {
+					nodes {
+						id
+						state {
+							type
+						}
+					}
+					pageInfo {
+						hasNextPage
+						endCursor
+					}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id":    milestoneID,
+		"first": first,
+	}
+	if after != "" {
+		variables["after"] = after
+	}
+
+	var response struct {
+		ProjectMilestone *struct {
+			ID     string `json:"id"`
+			Issues Issues `json:"issues"`
+		} `json:"projectMilestone"`
+	}
+
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return nil, err
+	}
+
+	if response.ProjectMilestone == nil || response.ProjectMilestone.ID == "" {
+		return nil, newNotFoundError("milestone", milestoneID)
+	}
+
+	return &response.ProjectMilestone.Issues, nil
+}
+
+// MilestoneIssuePages returns a PageFetcher over GetMilestoneIssues
+func (c *Client) MilestoneIssuePages(milestoneID string) PageFetcher[Issue] {
+	return func(ctx context.Context, first int, after string) ([]Issue, PageInfo, error) {
+		issues, err := c.GetMilestoneIssues(ctx, milestoneID, first, after)
+		if err != nil {
+			return nil, PageInfo{}, err
+		}
+		return issues.Nodes, issues.PageInfo, nil
+	}
+}
+
+// GetProjectMilestone returns a milestone by ID
+func (c *Client) GetProjectMilestone(ctx context.Context, id string) (*Milestone, error) {
+	query := `
+		query ProjectMilestone($id: String!) {
+			projectMilestone(id: $id) {` + milestoneFields + `}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": id,
+	}
+
+	var response struct {
+		ProjectMilestone *Milestone `json:"projectMilestone"`
+	}
+
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return nil, err
+	}
+
+	if response.ProjectMilestone == nil || response.ProjectMilestone.ID == "" {
+		return nil, newNotFoundError("milestone", id)
+	}
+
+	return response.ProjectMilestone, nil
+}
+
+// CreateProjectMilestone creates a milestone; input must include projectId and name
+func (c *Client) CreateProjectMilestone(ctx context.Context, input map[string]interface{}) (*Milestone, error) {
+	query := `
+		mutation CreateProjectMilestone($input: ProjectMilestoneCreateInput!) {
+			projectMilestoneCreate(input: $input) {
+				success
+				projectMilestone {` + milestoneFields + `}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	var response struct {
+		ProjectMilestoneCreate struct {
+			Success          bool       `json:"success"`
+			ProjectMilestone *Milestone `json:"projectMilestone"`
+		} `json:"projectMilestoneCreate"`
+	}
+
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return nil, err
+	}
+
+	if !response.ProjectMilestoneCreate.Success || response.ProjectMilestoneCreate.ProjectMilestone == nil {
+		return nil, fmt.Errorf("projectMilestoneCreate failed")
+	}
+
+	return response.ProjectMilestoneCreate.ProjectMilestone, nil
+}
+
+// UpdateProjectMilestone updates a milestone's fields
+func (c *Client) UpdateProjectMilestone(ctx context.Context, id string, input map[string]interface{}) (*Milestone, error) {
+	query := `
+		mutation UpdateProjectMilestone($id: String!, $input: ProjectMilestoneUpdateInput!) {
+			projectMilestoneUpdate(id: $id, input: $input) {
+				success
+				projectMilestone {` + milestoneFields + `}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id":    id,
+		"input": input,
+	}
+
+	var response struct {
+		ProjectMilestoneUpdate struct {
+			Success          bool       `json:"success"`
+			ProjectMilestone *Milestone `json:"projectMilestone"`
+		} `json:"projectMilestoneUpdate"`
+	}
+
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return nil, err
+	}
+
+	if !response.ProjectMilestoneUpdate.Success || response.ProjectMilestoneUpdate.ProjectMilestone == nil {
+		return nil, fmt.Errorf("projectMilestoneUpdate failed")
+	}
+
+	return response.ProjectMilestoneUpdate.ProjectMilestone, nil
+}
+
+// DeleteProjectMilestone deletes a milestone; its issues stay in the project
+func (c *Client) DeleteProjectMilestone(ctx context.Context, id string) error {
+	query := `
+		mutation DeleteProjectMilestone($id: String!) {
+			projectMilestoneDelete(id: $id) {
+				success
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": id,
+	}
+
+	var response struct {
+		ProjectMilestoneDelete struct {
+			Success bool `json:"success"`
+		} `json:"projectMilestoneDelete"`
+	}
+
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return err
+	}
+
+	if !response.ProjectMilestoneDelete.Success {
+		return fmt.Errorf("projectMilestoneDelete failed")
+	}
+
+	return nil
+}
@@ -0,0 +1,281 @@
+package api
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Linear's OAuth 2.0 endpoints
+const (
+	OAuthAuthorizeURL = "https://linear.app/oauth/authorize"
+	OAuthTokenURL     = "https://api.linear.app/oauth/token"
+)
+
+// tokenExpiryLeeway refreshes tokens slightly before they expire, so a
+// request never races the expiry
+const tokenExpiryLeeway = time.Minute
+
+// OAuthToken is an access token issued by Linear's token endpoint
+type OAuthToken struct {
+	AccessToken  string    `json:"access_token"`
+	RefreshToken string    `json:"refresh_token,omitempty"`
+	TokenType    string    `json:"token_type,omitempty"`
+	Scope        string    `json:"scope,omitempty"`
+	ExpiresAt    time.Time `json:"expires_at,omitempty"`
+}
+
+// Expired reports whether the token has expired or is about to
+func (t *OAuthToken) Expired() bool {
+	if t.ExpiresAt.IsZero() {
+		return false
+	}
+	return time.Now().Add(tokenExpiryLeeway).After(t.ExpiresAt)
+}
+
+// AuthHeader returns the Authorization header value for the token
+func (t *OAuthToken) AuthHeader() string {
+	return "Bearer " + t.AccessToken
+}
+
+// OAuthConfig describes a Linear OAuth application and the endpoints used
+// to authorize it. Empty URLs default to Linear's.
+type OAuthConfig struct {
+	ClientID     string
+	ClientSecret string
+	AuthorizeURL string
+	TokenURL     string
+	RedirectURL  string
+	Scopes       []string
+	HTTPClient   *http.Client
+}
+
+// NewCodeVerifier returns a random PKCE code verifier
+func NewCodeVerifier() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate code verifier: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// CodeChallenge returns the S256 PKCE challenge for a code verifier
+func CodeChallenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// AuthCodeURL returns the URL the user visits to authorize the application
+func (c *OAuthConfig) AuthCodeURL(state, codeChallenge string) string {
+	authorizeURL := c.AuthorizeURL
+	if authorizeURL == "" {
+		authorizeURL = OAuthAuthorizeURL
+	}
+
+	params := url.Values{}
+	params.Set("response_type", "code")
+	params.Set("client_id", c.ClientID)
+	params.Set("redirect_uri", c.RedirectURL)
+	params.Set("scope", strings.Join(c.Scopes, ","))
+	params.Set("state", state)
+	params.Set("code_challenge", codeChallenge)
+	params.Set("code_challenge_method", "S256")
+
+	separator := "?"
+	if strings.Contains(authorizeURL, "?") {
+		separator = "&"
+	}
+	return authorizeURL + separator + params.Encode()
+}
+
+// Exchange trades an authorization code for a token
+func (c *OAuthConfig) Exchange(ctx context.Context, code, codeVerifier string) (*OAuthToken, error) {
+	params := url.Values{}
+	params.Set("grant_type", "authorization_code")
+	params.Set("code", code)
+	params.Set("redirect_uri", c.RedirectURL)
+	params.Set("code_verifier", codeVerifier)
+
+	return c.requestToken(ctx, params)
+}
+
+// Refresh obtains a new access token using a refresh token. Linear may not
+// rotate the refresh token, in which case the old one is kept.
+func (c *OAuthConfig) Refresh(ctx context.Context, refreshToken string) (*OAuthToken, error) {
+	if refreshToken == "" {
+		return nil, &Error{Code: CodeAuthentication, Message: "access token expired and no refresh token is stored; log in again"}
+	}
+
+	params := url.Values{}
+	params.Set("grant_type", "refresh_token")
+	params.Set("refresh_token", refreshToken)
+
+	token, err := c.requestToken(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	if token.RefreshToken == "" {
+		token.RefreshToken = refreshToken
+	}
+	return token, nil
+}
+
+// requestToken posts a form to the token endpoint and parses the token response
+func (c *OAuthConfig) requestToken(ctx context.Context, params url.Values) (*OAuthToken, error) {
+	tokenURL := c.TokenURL
+	if tokenURL == "" {
+		tokenURL = OAuthTokenURL
+	}
+	params.Set("client_id", c.ClientID)
+	if c.ClientSecret != "" {
+		params.Set("client_secret", c.ClientSecret)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", tokenURL, strings.NewReader(params.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", "linctl/0.1.0")
+
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 30 * time.Second}
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, &NetworkError{Err: err}
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	var tokenResp struct {
+		AccessToken      string          `json:"access_token"`
+		RefreshToken     string          `json:"refresh_token"`
+		TokenType        string          `json:"token_type"`
+		ExpiresIn        int64           `json:"expires_in"`
+		Scope            json.RawMessage `json:"scope"`
+		Error            string          `json:"error"`
+		ErrorDescription string          `json:"error_description"`
+	}
+	if err := json.Unmarshal(body, &tokenResp); err != nil && resp.StatusCode == http.StatusOK {
+		return nil, fmt.Errorf("failed to parse token response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK || tokenResp.Error != "" {
+		message := tokenResp.ErrorDescription
+		if message == "" {
+			message = tokenResp.Error
+		}
+		if message == "" {
+			message = strings.TrimSpace(string(body))
+		}
+		apiErr := &Error{StatusCode: resp.StatusCode, Message: message}
+		switch {
+		case tokenResp.Error == "invalid_grant", tokenResp.Error == "invalid_client", resp.StatusCode == http.StatusUnauthorized:
+			apiErr.Code = CodeAuthentication
+		case resp.StatusCode == http.StatusTooManyRequests:
+			apiErr.Code = CodeRateLimited
+		case resp.StatusCode >= 500:
+			apiErr.Code = CodeInternal
+		}
+		return nil, apiErr
+	}
+
+	if tokenResp.AccessToken == "" {
+		return nil, fmt.Errorf("token response did not include an access token")
+	}
+
+	token := &OAuthToken{
+		AccessToken:  tokenResp.AccessToken,
+		RefreshToken: tokenResp.RefreshToken,
+		TokenType:    tokenResp.TokenType,
+		Scope:        parseScope(tokenResp.Scope),
+	}
+	if tokenResp.ExpiresIn > 0 {
+		token.ExpiresAt = time.Now().Add(time.Duration(tokenResp.ExpiresIn) * time.Second)
+	}
+	return token, nil
+}
+
+// parseScope accepts a scope given as a string or a list of strings
+func parseScope(raw json.RawMessage) string {
+	var list []string
+	if err := json.Unmarshal(raw, &list); err == nil {
+		return strings.Join(list, ",")
+	}
+	var scope string
+	if err := json.Unmarshal(raw, &scope); err == nil {
+		return scope
+	}
+	return ""
+}
+
+// NewOAuthClient creates a Linear API client that authenticates with an OAuth
+// token, refreshing it when it expires or is rejected. onRefresh, if not nil,
+// is called with every new token so it can be persisted.
+func NewOAuthClient(config *OAuthConfig, token *OAuthToken, onRefresh func(*OAuthToken)) *Client {
+	return NewOAuthClientWithURL(BaseURL, config, token, onRefresh)
+}
+
+// NewOAuthClientWithURL creates an OAuth-authenticated client with custom URL
+func NewOAuthClientWithURL(baseURL string, config *OAuthConfig, token *OAuthToken, onRefresh func(*OAuthToken)) *Client {
+	client := NewClientWithURL(baseURL, "")
+	client.UseOAuth(config, token, onRefresh)
+	return client
+}
+
+// UseOAuth makes the client authenticate with an OAuth token, refreshing it
+// as NewOAuthClient does. Token requests go through the client's HTTP client
+// unless config sets its own.
+func (c *Client) UseOAuth(config *OAuthConfig, token *OAuthToken, onRefresh func(*OAuthToken)) {
+	if config.HTTPClient == nil {
+		config.HTTPClient = c.httpClient
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.oauth = config
+	c.token = token
+	c.onRefresh = onRefresh
+	c.authHeader = token.AuthHeader()
+}
+
+// authorization returns the Authorization header for the next request,
+// refreshing the OAuth token first when it has expired or force is set
+func (c *Client) authorization(ctx context.Context, force bool) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.oauth == nil || c.token == nil {
+		return c.authHeader, nil
+	}
+	if !force && !c.token.Expired() {
+		return c.authHeader, nil
+	}
+
+	token, err := c.oauth.Refresh(ctx, c.token.RefreshToken)
+	if err != nil {
+		return "", fmt.Errorf("failed to refresh access token: %w", err)
+	}
+	c.token = token
+	c.authHeader = token.AuthHeader()
+	if c.onRefresh != nil {
+		c.onRefresh(token)
+	}
+	return c.authHeader, nil
+}
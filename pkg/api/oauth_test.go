@@ -0,0 +1,292 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// tokenServer serves Linear's token endpoint, handing each request's form to
+// respond and counting the requests it receives
+func tokenServer(t *testing.T, respond func(w http.ResponseWriter, form url.Values)) (*OAuthConfig, *int32) {
+	t.Helper()
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("failed to parse token request: %v", err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		respond(w, r.PostForm)
+	}))
+	t.Cleanup(server.Close)
+
+	config := &OAuthConfig{
+		ClientID:    "client-1",
+		TokenURL:    server.URL,
+		RedirectURL: "http://127.0.0.1:8123/callback",
+	}
+	return config, &requests
+}
+
+func TestCodeChallenge(t *testing.T) {
+	// Example from RFC 7636, appendix B
+	verifier := "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"
+	if got, want := CodeChallenge(verifier), "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM"; got != want {
+		t.Errorf("CodeChallenge() = %q, want %q", got, want)
+	}
+}
+
+func TestExchangeSendsVerifierForChallenge(t *testing.T) {
+	verifier, err := NewCodeVerifier()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The token endpoint checks the verifier against the challenge the
+	// authorize URL carried, as Linear does
+	var challenge string
+	var form url.Values
+	config, _ := tokenServer(t, func(w http.ResponseWriter, f url.Values) {
+		form = f
+		if CodeChallenge(f.Get("code_verifier")) != challenge {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"error": "invalid_grant", "error_description": "code verifier does not match the challenge"}`)
+			return
+		}
+		fmt.Fprint(w, `{"access_token": "access-1", "refresh_token": "refresh-1", "token_type": "Bearer", "expires_in": 3600, "scope": ["read", "write"]}`)
+	})
+	config.Scopes = []string{"read", "write"}
+
+	authURL, err := url.Parse(config.AuthCodeURL("state-1", CodeChallenge(verifier)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	params := authURL.Query()
+	for name, want := range map[string]string{
+		"response_type":         "code",
+		"client_id":             "client-1",
+		"redirect_uri":          config.RedirectURL,
+		"scope":                 "read,write",
+		"state":                 "state-1",
+		"code_challenge_method": "S256",
+	} {
+		if got := params.Get(name); got != want {
+			t.Errorf("authorize URL %s = %q, want %q", name, got, want)
+		}
+	}
+	challenge = params.Get("code_challenge")
+
+	token, err := config.Exchange(context.Background(), "code-1", verifier)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, want := range map[string]string{
+		"grant_type":    "authorization_code",
+		"code":          "code-1",
+		"redirect_uri":  config.RedirectURL,
+		"client_id":     "client-1",
+		"code_verifier": verifier,
+	} {
+		if got := form.Get(name); got != want {
+			t.Errorf("token request %s = %q, want %q", name, got, want)
+		}
+	}
+	if form.Has("client_secret") {
+		t.Error("token request sent a client secret the config does not have")
+	}
+
+	if token.AccessToken != "access-1" || token.RefreshToken != "refresh-1" || token.Scope != "read,write" {
+		t.Errorf("Exchange() = %+v", token)
+	}
+	if remaining := time.Until(token.ExpiresAt); remaining < 59*time.Minute || remaining > time.Hour {
+		t.Errorf("token expires in %v, want an hour", remaining)
+	}
+
+	other, err := NewCodeVerifier()
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = config.Exchange(context.Background(), "code-1", other)
+	if !IsAuthError(err) {
+		t.Errorf("Exchange() with another verifier error = %v, want an authentication error", err)
+	}
+}
+
+func TestRefresh(t *testing.T) {
+	tests := []struct {
+		name        string
+		response    string
+		wantRefresh string
+	}{
+		{"rotated refresh token", `{"access_token": "access-2", "refresh_token": "refresh-2", "expires_in": 3600}`, "refresh-2"},
+		{"refresh token not rotated", `{"access_token": "access-2", "expires_in": 3600}`, "refresh-1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var form url.Values
+			config, _ := tokenServer(t, func(w http.ResponseWriter, f url.Values) {
+				form = f
+				fmt.Fprint(w, tt.response)
+			})
+
+			token, err := config.Refresh(context.Background(), "refresh-1")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if form.Get("grant_type") != "refresh_token" || form.Get("refresh_token") != "refresh-1" {
+				t.Errorf("token request = %v, want a refresh_token grant for refresh-1", form)
+			}
+			if token.AccessToken != "access-2" {
+				t.Errorf("access token = %q, want access-2", token.AccessToken)
+			}
+			if token.RefreshToken != tt.wantRefresh {
+				t.Errorf("refresh token = %q, want %q", token.RefreshToken, tt.wantRefresh)
+			}
+		})
+	}
+}
+
+func TestRefreshErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   int
+		response string
+		code     string
+	}{
+		{"revoked refresh token", http.StatusBadRequest, `{"error": "invalid_grant", "error_description": "refresh token revoked"}`, CodeAuthentication},
+		{"rate limited", http.StatusTooManyRequests, `{"error": "rate_limited"}`, CodeRateLimited},
+		{"server error", http.StatusBadGateway, `bad gateway`, CodeInternal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config, _ := tokenServer(t, func(w http.ResponseWriter, form url.Values) {
+				w.WriteHeader(tt.status)
+				fmt.Fprint(w, tt.response)
+			})
+
+			_, err := config.Refresh(context.Background(), "refresh-1")
+			var apiErr *Error
+			if !errors.As(err, &apiErr) || apiErr.Code != tt.code {
+				t.Errorf("Refresh() error = %v, want code %s", err, tt.code)
+			}
+		})
+	}
+
+	config, requests := tokenServer(t, func(w http.ResponseWriter, form url.Values) {})
+	if _, err := config.Refresh(context.Background(), ""); !IsAuthError(err) {
+		t.Errorf("Refresh() without a refresh token error = %v, want an authentication error", err)
+	}
+	if got := atomic.LoadInt32(requests); got != 0 {
+		t.Errorf("sent %d token requests without a refresh token, want 0", got)
+	}
+}
+
+// oauthAPIServer serves the GraphQL API, accepting only the given access token
+func oauthAPIServer(t *testing.T, accessToken string) (string, *int32) {
+	t.Helper()
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		if r.Header.Get("Authorization") != "Bearer "+accessToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"errors": [{"message": "Authentication required", "extensions": {"code": "AUTHENTICATION_ERROR"}}]}`)
+			return
+		}
+		fmt.Fprint(w, `{"data": {"viewer": {"id": "user-1"}}}`)
+	}))
+	t.Cleanup(server.Close)
+	return server.URL, &requests
+}
+
+func TestExecuteRefreshesExpiredToken(t *testing.T) {
+	config, tokenRequests := tokenServer(t, func(w http.ResponseWriter, form url.Values) {
+		fmt.Fprint(w, `{"access_token": "access-2", "expires_in": 3600}`)
+	})
+	apiURL, apiRequests := oauthAPIServer(t, "access-2")
+
+	var saved []*OAuthToken
+	expired := &OAuthToken{AccessToken: "access-1", RefreshToken: "refresh-1", ExpiresAt: time.Now().Add(-time.Minute)}
+	client := NewOAuthClientWithURL(apiURL, config, expired, func(token *OAuthToken) {
+		saved = append(saved, token)
+	})
+
+	if err := client.Execute(context.Background(), `query Me { viewer { id } }`, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := atomic.LoadInt32(tokenRequests); got != 1 {
+		t.Errorf("sent %d token requests, want 1", got)
+	}
+	if got := atomic.LoadInt32(apiRequests); got != 1 {
+		t.Errorf("sent %d API requests, want 1 with the refreshed token", got)
+	}
+	if len(saved) != 1 || saved[0].AccessToken != "access-2" || saved[0].RefreshToken != "refresh-1" {
+		t.Errorf("saved tokens = %+v, want access-2 keeping refresh-1", saved)
+	}
+
+	// The refreshed token is still valid, so the next request uses it as is
+	if err := client.Execute(context.Background(), `query Me { viewer { id } }`, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := atomic.LoadInt32(tokenRequests); got != 1 {
+		t.Errorf("sent %d token requests, want 1", got)
+	}
+}
+
+func TestExecuteRefreshesRejectedToken(t *testing.T) {
+	tests := []struct {
+		name          string
+		issued        string
+		accepted      string
+		wantErr       bool
+		tokenRequests int32
+		apiRequests   int32
+	}{
+		{"refreshed token accepted", "access-2", "access-2", false, 1, 2},
+		{"refreshed token rejected too", "access-2", "access-3", true, 1, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config, tokenRequests := tokenServer(t, func(w http.ResponseWriter, form url.Values) {
+				fmt.Fprintf(w, `{"access_token": %q, "refresh_token": "refresh-2", "expires_in": 3600}`, tt.issued)
+			})
+			apiURL, apiRequests := oauthAPIServer(t, tt.accepted)
+
+			// Not expired yet, but revoked on Linear's side
+			token := &OAuthToken{AccessToken: "access-1", RefreshToken: "refresh-1", ExpiresAt: time.Now().Add(time.Hour)}
+			client := NewOAuthClientWithURL(apiURL, config, token, nil)
+
+			err := client.Execute(context.Background(), `query Me { viewer { id } }`, nil, nil)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Execute() error = %v, want error %v", err, tt.wantErr)
+			}
+			if tt.wantErr && !IsAuthError(err) {
+				t.Errorf("Execute() error = %v, want an authentication error", err)
+			}
+			if got := atomic.LoadInt32(tokenRequests); got != tt.tokenRequests {
+				t.Errorf("sent %d token requests, want %d", got, tt.tokenRequests)
+			}
+			if got := atomic.LoadInt32(apiRequests); got != tt.apiRequests {
+				t.Errorf("sent %d API requests, want %d", got, tt.apiRequests)
+			}
+		})
+	}
+}
+
+func TestAPIKeyClientDoesNotRefreshOnUnauthorized(t *testing.T) {
+	apiURL, apiRequests := oauthAPIServer(t, "access-1")
+	client := NewClientWithURL(apiURL, "lin_api_test")
+
+	if err := client.Execute(context.Background(), `query Me { viewer { id } }`, nil, nil); !IsAuthError(err) {
+		t.Errorf("Execute() error = %v, want an authentication error", err)
+	}
+	if got := atomic.LoadInt32(apiRequests); got != 1 {
+		t.Errorf("sent %d API requests, want 1", got)
+	}
+}
@@ -0,0 +1,130 @@
+package api
+
+import (
+	"strings"
+)
+
+// operationDefinition is a top-level operation of a GraphQL document
+type operationDefinition struct {
+	kind string // query, mutation or subscription
+	name string
+}
+
+// isMutation reports whether executing document runs a mutation. The
+// operation is chosen like the server does: by operationName, or the only
+// operation in the document. Without a name, a document with several
+// operations counts as a mutation if any of them is one, so an ambiguous
+// write is never retried.
+func isMutation(document, operationName string) bool {
+	operations := parseOperations(document)
+
+	if operationName != "" {
+		for _, op := range operations {
+			if op.name == operationName {
+				return op.kind == "mutation"
+			}
+		}
+	}
+	for _, op := range operations {
+		if op.kind == "mutation" {
+			return true
+		}
+	}
+	return false
+}
+
+// parseOperations lists the operation definitions of a GraphQL document,
+// skipping comments, strings, fragment definitions and selection sets
+func parseOperations(document string) []operationDefinition {
+	var operations []operationDefinition
+	var current *operationDefinition
+	inHeader := false // between a definition's keyword and its selection set
+	braces, parens := 0, 0
+
+	for i := 0; i < len(document); {
+		c := document[i]
+		switch {
+		case c == '#':
+			for i < len(document) && document[i] != '\n' && document[i] != '\r' {
+				i++
+			}
+		case c == '"':
+			i = skipString(document, i)
+		case c == '(':
+			parens++
+			i++
+		case c == ')':
+			parens--
+			i++
+		case c == '{':
+			if braces == 0 && parens == 0 {
+				if !inHeader {
+					// A shorthand query: { viewer { id } }
+					operations = append(operations, operationDefinition{kind: "query"})
+				}
+				inHeader = false
+				current = nil
+			}
+			braces++
+			i++
+		case c == '}':
+			braces--
+			i++
+		case isNameStart(c):
+			start := i
+			for i < len(document) && isNameContinue(document[i]) {
+				i++
+			}
+			if braces != 0 || parens != 0 {
+				continue
+			}
+			word := document[start:i]
+			// Directive and variable names are not definition names
+			if start > 0 && (document[start-1] == '@' || document[start-1] == '$') {
+				continue
+			}
+			switch {
+			case !inHeader:
+				inHeader = true
+				if word == "query" || word == "mutation" || word == "subscription" {
+					operations = append(operations, operationDefinition{kind: word})
+					current = &operations[len(operations)-1]
+				}
+			case current != nil && current.name == "":
+				current.name = word
+			}
+		default:
+			i++
+		}
+	}
+	return operations
+}
+
+// skipString returns the index just past the string literal starting at i,
+// handling block strings
+func skipString(document string, i int) int {
+	if strings.HasPrefix(document[i:], `"""`) {
+		end := strings.Index(document[i+3:], `"""`)
+		if end < 0 {
+			return len(document)
+		}
+		return i + 3 + end + 3
+	}
+	for i++; i < len(document); i++ {
+		switch document[i] {
+		case '\\':
+			i++
+		case '"', '\n':
+			return i + 1
+		}
+	}
+	return len(document)
+}
+
+func isNameStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isNameContinue(c byte) bool {
+	return isNameStart(c) || (c >= '0' && c <= '9')
+}
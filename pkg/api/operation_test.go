@@ -0,0 +1,35 @@
+package api
+
+import (
+	"testing"
+)
+
+func TestIsMutation(t *testing.T) {
+	tests := []struct {
+		name          string
+		document      string
+		operationName string
+		want          bool
+	}{
+		{"shorthand query", `{ viewer { id } }`, "", false},
+		{"named query", `query Viewer { viewer { id } }`, "", false},
+		{"mutation", `mutation { issueArchive(id: "x") { success } }`, "", true},
+		{"leading comment", "# mutation in a comment\nquery Viewer { viewer { id } }", "", false},
+		{"mutation after a comment", "# archive it\nmutation { issueArchive(id: \"x\") { success } }", "", true},
+		{"mutation after a fragment", `fragment F on Issue { id } mutation M { issueCreate(input: {}) { issue { ...F } } }`, "", true},
+		{"fragment named like a keyword", `fragment mutation on Issue { id } query Q { issue(id: "x") { ...mutation } }`, "", false},
+		{"keyword inside a string", `query Q { issues(filter: {title: {eq: "mutation {"}}) { nodes { id } } }`, "", false},
+		{"keyword inside a block string", "query Q { search(term: \"\"\"mutation\"\"\") { id } }", "", false},
+		{"variables with object defaults", `query Q($f: IssueFilter = {title: {eq: "x"}}) { issues(filter: $f) { nodes { id } } }`, "", false},
+		{"operation name selects the query", `query Q { viewer { id } } mutation M { issueArchive(id: "x") { success } }`, "Q", false},
+		{"operation name selects the mutation", `query Q { viewer { id } } mutation M { issueArchive(id: "x") { success } }`, "M", true},
+		{"ambiguous document counts as a mutation", `query Q { viewer { id } } mutation M { issueArchive(id: "x") { success } }`, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isMutation(tt.document, tt.operationName); got != tt.want {
+				t.Errorf("isMutation() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,161 @@
+package api
+
+import (
+	"context"
+)
+
+// MaxPageSize is the largest page Linear will return for a connection query
+const MaxPageSize = 250
+
+// PageFetcher fetches a single page of nodes starting after the given cursor
+type PageFetcher[T any] func(ctx context.Context, first int, after string) ([]T, PageInfo, error)
+
+// Paginator walks a connection page by page, following PageInfo.EndCursor
+type Paginator[T any] struct {
+	fetch    PageFetcher[T]
+	limit    int
+	fetched  int
+	cursor   string
+	pageInfo PageInfo
+	started  bool
+}
+
+// NewPaginator creates a paginator that stops after limit nodes.
+// A limit of zero or less follows every page until the connection is exhausted.
+func NewPaginator[T any](fetch PageFetcher[T], limit int) *Paginator[T] {
+	return &Paginator[T]{
+		fetch: fetch,
+		limit: limit,
+	}
+}
+
+// HasNext reports whether another page can be fetched
+func (p *Paginator[T]) HasNext() bool {
+	if !p.started {
+		return true
+	}
+	if p.limit > 0 && p.fetched >= p.limit {
+		return false
+	}
+	return p.pageInfo.HasNextPage && p.pageInfo.EndCursor != ""
+}
+
+// Next fetches the next page of nodes
+func (p *Paginator[T]) Next(ctx context.Context) ([]T, error) {
+	first := MaxPageSize
+	if p.limit > 0 && p.limit-p.fetched < first {
+		first = p.limit - p.fetched
+	}
+
+	nodes, pageInfo, err := p.fetch(ctx, first, p.cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	p.started = true
+	p.pageInfo = pageInfo
+	p.cursor = pageInfo.EndCursor
+	p.fetched += len(nodes)
+
+	// Guard against servers that keep reporting more pages without returning nodes
+	if len(nodes) == 0 {
+		p.pageInfo.HasNextPage = false
+	}
+
+	return nodes, nil
+}
+
+// PageInfo returns the page info of the most recently fetched page
+func (p *Paginator[T]) PageInfo() PageInfo {
+	return p.pageInfo
+}
+
+// All fetches every remaining page and returns the combined nodes
+func (p *Paginator[T]) All(ctx context.Context) ([]T, error) {
+	all := []T{}
+	for p.HasNext() {
+		nodes, err := p.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, nodes...)
+	}
+	return all, nil
+}
+
+// resumePages continues a connection after a page that was fetched elsewhere,
+// e.g. nested in a larger query
+func resumePages[T any](fetch PageFetcher[T], cursor string) PageFetcher[T] {
+	return func(ctx context.Context, first int, after string) ([]T, PageInfo, error) {
+		if after == "" {
+			after = cursor
+		}
+		return fetch(ctx, first, after)
+	}
+}
+
+// IssuePages returns a PageFetcher over GetIssues
+func (c *Client) IssuePages(filter map[string]interface{}, orderBy string, includeArchived bool) PageFetcher[Issue] {
+	return func(ctx context.Context, first int, after string) ([]Issue, PageInfo, error) {
+		issues, err := c.GetIssues(ctx, filter, first, after, orderBy, includeArchived)
+		if err != nil {
+			return nil, PageInfo{}, err
+		}
+		return issues.Nodes, issues.PageInfo, nil
+	}
+}
+
+// IssueSearchPages returns a PageFetcher over IssueSearch
+func (c *Client) IssueSearchPages(term string, filter map[string]interface{}, orderBy string, includeArchived bool) PageFetcher[Issue] {
+	return func(ctx context.Context, first int, after string) ([]Issue, PageInfo, error) {
+		issues, err := c.IssueSearch(ctx, term, filter, first, after, orderBy, includeArchived)
+		if err != nil {
+			return nil, PageInfo{}, err
+		}
+		return issues.Nodes, issues.PageInfo, nil
+	}
+}
+
+// ProjectPages returns a PageFetcher over GetProjects
+func (c *Client) ProjectPages(filter map[string]interface{}, orderBy string) PageFetcher[Project] {
+	return func(ctx context.Context, first int, after string) ([]Project, PageInfo, error) {
+		projects, err := c.GetProjects(ctx, filter, first, after, orderBy)
+		if err != nil {
+			return nil, PageInfo{}, err
+		}
+		return projects.Nodes, projects.PageInfo, nil
+	}
+}
+
+// TeamPages returns a PageFetcher over GetTeams
+func (c *Client) TeamPages(orderBy string) PageFetcher[Team] {
+	return func(ctx context.Context, first int, after string) ([]Team, PageInfo, error) {
+		teams, err := c.GetTeams(ctx, first, after, orderBy)
+		if err != nil {
+			return nil, PageInfo{}, err
+		}
+		return teams.Nodes, teams.PageInfo, nil
+	}
+}
+
+// UserPages returns a PageFetcher over GetUsers
+func (c *Client) UserPages(orderBy string) PageFetcher[User] {
+	return func(ctx context.Context, first int, after string) ([]User, PageInfo, error) {
+		users, err := c.GetUsers(ctx, first, after, orderBy)
+		if err != nil {
+			return nil, PageInfo{}, err
+		}
+		return users.Nodes, users.PageInfo, nil
+	}
+}
+
+// IssueCommentPages returns a PageFetcher over GetIssueComments
+func (c *Client) IssueCommentPages(issueID string, orderBy string) PageFetcher[Comment] {
+	return func(ctx context.Context, first int, after string) ([]Comment, PageInfo, error) {
+		comments, err := c.GetIssueComments(ctx, issueID, first, after, orderBy)
+		if err != nil {
+			return nil, PageInfo{}, err
+		}
+		return comments.Nodes, comments.PageInfo, nil
+	}
+}
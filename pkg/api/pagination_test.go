@@ -0,0 +1,173 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+// page is one response of a fakePages connection
+type page struct {
+	nodes []int
+	info  PageInfo
+}
+
+// fakePages serves pages in order, recording the first and after arguments
+// of every request
+type fakePages struct {
+	pages []page
+	calls []string
+	err   error
+}
+
+func (f *fakePages) fetch(ctx context.Context, first int, after string) ([]int, PageInfo, error) {
+	f.calls = append(f.calls, fmt.Sprintf("first=%d after=%q", first, after))
+	if f.err != nil {
+		return nil, PageInfo{}, f.err
+	}
+	if len(f.calls) > len(f.pages) {
+		return nil, PageInfo{}, errors.New("fetched past the last page")
+	}
+	p := f.pages[len(f.calls)-1]
+	nodes := p.nodes
+	if len(nodes) > first {
+		nodes = nodes[:first]
+	}
+	return nodes, p.info, nil
+}
+
+// numbers returns the integers from start up to, but not including, end
+func numbers(start, end int) []int {
+	out := make([]int, 0, end-start)
+	for i := start; i < end; i++ {
+		out = append(out, i)
+	}
+	return out
+}
+
+func TestPaginatorAll(t *testing.T) {
+	tests := []struct {
+		name  string
+		limit int
+		pages []page
+		want  []int
+		calls []string
+	}{
+		{
+			name:  "single page",
+			pages: []page{{nodes: numbers(0, 3)}},
+			want:  numbers(0, 3),
+			calls: []string{`first=250 after=""`},
+		},
+		{
+			name: "follows cursors",
+			pages: []page{
+				{nodes: numbers(0, 2), info: PageInfo{HasNextPage: true, EndCursor: "c1"}},
+				{nodes: numbers(2, 4), info: PageInfo{HasNextPage: true, EndCursor: "c2"}},
+				{nodes: numbers(4, 5)},
+			},
+			want:  numbers(0, 5),
+			calls: []string{`first=250 after=""`, `first=250 after="c1"`, `first=250 after="c2"`},
+		},
+		{
+			name:  "limit shrinks the page",
+			limit: 3,
+			pages: []page{{nodes: numbers(0, 10), info: PageInfo{HasNextPage: true, EndCursor: "c1"}}},
+			want:  numbers(0, 3),
+			calls: []string{`first=3 after=""`},
+		},
+		{
+			name:  "limit spans pages",
+			limit: 300,
+			pages: []page{
+				{nodes: numbers(0, 250), info: PageInfo{HasNextPage: true, EndCursor: "c1"}},
+				{nodes: numbers(250, 400), info: PageInfo{HasNextPage: true, EndCursor: "c2"}},
+			},
+			want:  numbers(0, 300),
+			calls: []string{`first=250 after=""`, `first=50 after="c1"`},
+		},
+		{
+			name:  "limit equal to a full page",
+			limit: 250,
+			pages: []page{{nodes: numbers(0, 250), info: PageInfo{HasNextPage: true, EndCursor: "c1"}}},
+			want:  numbers(0, 250),
+			calls: []string{`first=250 after=""`},
+		},
+		{
+			name:  "empty connection",
+			pages: []page{{}},
+			want:  []int{},
+			calls: []string{`first=250 after=""`},
+		},
+		{
+			name: "empty page claiming more stops",
+			pages: []page{
+				{nodes: numbers(0, 2), info: PageInfo{HasNextPage: true, EndCursor: "c1"}},
+				{info: PageInfo{HasNextPage: true, EndCursor: "c2"}},
+			},
+			want:  numbers(0, 2),
+			calls: []string{`first=250 after=""`, `first=250 after="c1"`},
+		},
+		{
+			name:  "more pages without a cursor stops",
+			pages: []page{{nodes: numbers(0, 2), info: PageInfo{HasNextPage: true}}},
+			want:  numbers(0, 2),
+			calls: []string{`first=250 after=""`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pages := &fakePages{pages: tt.pages}
+			got, err := NewPaginator(pages.fetch, tt.limit).All(context.Background())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("All() returned %d nodes %v, want %d", len(got), got, len(tt.want))
+			}
+			if !reflect.DeepEqual(pages.calls, tt.calls) {
+				t.Errorf("requests = %v, want %v", pages.calls, tt.calls)
+			}
+		})
+	}
+}
+
+func TestPaginatorAllReturnsFetchError(t *testing.T) {
+	pages := &fakePages{err: &Error{Code: CodeRateLimited, Message: "Rate limit exceeded"}}
+	nodes, err := NewPaginator(pages.fetch, 0).All(context.Background())
+	if !IsRateLimited(err) {
+		t.Errorf("All() error = %v, want the fetch error", err)
+	}
+	if nodes != nil {
+		t.Errorf("All() = %v, want no nodes on error", nodes)
+	}
+}
+
+func TestPaginatorNext(t *testing.T) {
+	pages := &fakePages{pages: []page{
+		{nodes: numbers(0, 2), info: PageInfo{HasNextPage: true, EndCursor: "c1"}},
+		{nodes: numbers(2, 3), info: PageInfo{EndCursor: "c2"}},
+	}}
+	paginator := NewPaginator(pages.fetch, 0)
+
+	if !paginator.HasNext() {
+		t.Fatal("HasNext() = false before the first page")
+	}
+	if _, err := paginator.Next(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if info := paginator.PageInfo(); !info.HasNextPage || info.EndCursor != "c1" {
+		t.Errorf("PageInfo() = %+v after the first page", info)
+	}
+	if !paginator.HasNext() {
+		t.Fatal("HasNext() = false with another page")
+	}
+	if _, err := paginator.Next(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if paginator.HasNext() {
+		t.Error("HasNext() = true after the last page")
+	}
+}
@@ -0,0 +1,298 @@
+package api
+
+import (
+	"context"
+	"fmt"
+)
+
+const projectMutationFields = `
+	id
+	slugId
+	name
+	description
+	state
+	progress
+	startDate
+	targetDate
+	url
+	icon
+	color
+	createdAt
+	updatedAt
+	archivedAt
+	lead {
+		id
+		name
+		email
+	}
+	teams {
+		nodes {
+			id
+			key
+			name
+		}
+	}
+	members {
+		nodes {
+			id
+			name
+			email
+		}
+	}
+`
+
+// CreateProject creates a new project
+func (c *Client) CreateProject(ctx context.Context, input map[string]interface{}) (*Project, error) {
+	query := `
+		mutation CreateProject($input: ProjectCreateInput!) {
+			projectCreate(input: $input) {
+				success
+				project {` + projectMutationFields + `}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	var response struct {
+		ProjectCreate struct {
+			Success bool     `json:"success"`
+			Project *Project `json:"project"`
+		} `json:"projectCreate"`
+	}
+
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return nil, err
+	}
+
+	if !response.ProjectCreate.Success || response.ProjectCreate.Project == nil {
+		return nil, fmt.Errorf("projectCreate failed")
+	}
+
+	return response.ProjectCreate.Project, nil
+}
+
+// UpdateProject updates a project's fields
+func (c *Client) UpdateProject(ctx context.Context, id string, input map[string]interface{}) (*Project, error) {
+	query := `
+		mutation UpdateProject($id: String!, $input: ProjectUpdateInput!) {
+			projectUpdate(id: $id, input: $input) {
+				success
+				project {` + projectMutationFields + `}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id":    id,
+		"input": input,
+	}
+
+	var response struct {
+		ProjectUpdate struct {
+			Success bool     `json:"success"`
+			Project *Project `json:"project"`
+		} `json:"projectUpdate"`
+	}
+
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return nil, err
+	}
+
+	if !response.ProjectUpdate.Success || response.ProjectUpdate.Project == nil {
+		return nil, fmt.Errorf("projectUpdate failed")
+	}
+
+	return response.ProjectUpdate.Project, nil
+}
+
+// ArchiveProject archives a project
+func (c *Client) ArchiveProject(ctx context.Context, id string) error {
+	query := `
+		mutation ArchiveProject($id: String!) {
+			projectArchive(id: $id) {
+				success
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": id,
+	}
+
+	var response struct {
+		ProjectArchive struct {
+			Success bool `json:"success"`
+		} `json:"projectArchive"`
+	}
+
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return err
+	}
+
+	if !response.ProjectArchive.Success {
+		return fmt.Errorf("projectArchive failed")
+	}
+
+	return nil
+}
+
+// UnarchiveProject restores an archived project
+func (c *Client) UnarchiveProject(ctx context.Context, id string) error {
+	query := `
+		mutation UnarchiveProject($id: String!) {
+			projectUnarchive(id: $id) {
+				success
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": id,
+	}
+
+	var response struct {
+		ProjectUnarchive struct {
+			Success bool `json:"success"`
+		} `json:"projectUnarchive"`
+	}
+
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return err
+	}
+
+	if !response.ProjectUnarchive.Success {
+		return fmt.Errorf("projectUnarchive failed")
+	}
+
+	return nil
+}
+
+// Project update health values accepted by Linear
+const (
+	HealthOnTrack  = "onTrack"
+	HealthAtRisk   = "atRisk"
+	HealthOffTrack = "offTrack"
+)
+
+// GetProjectUpdates returns one page of a project's status updates, newest first
+func (c *Client) GetProjectUpdates(ctx context.Context, projectID string, first int, after string) (*ProjectUpdates, error) {
+	query := `
+		query ProjectUpdates($id: String!, $first: Int, $after: String) {
+			project(id: $id) {
+				id
+				projectUpdates(first: $first, after: $after) {
+					nodes {
+						id
+						body
+						health
+						url
+						createdAt
+						updatedAt
+						editedAt
+						user {
+							id
+							name
+							email
+						}
+					}
+					pageInfo {
+						hasNextPage
+						endCursor
+					}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id":    projectID,
+		"first": first,
+	}
+	if after != "" {
+		variables["after"] = after
+	}
+
+	var response struct {
+		Project *struct {
+			ID             string         `json:"id"`
+			ProjectUpdates ProjectUpdates `json:"projectUpdates"`
+		} `json:"project"`
+	}
+
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return nil, err
+	}
+
+	if response.Project == nil || response.Project.ID == "" {
+		return nil, newNotFoundError("project", projectID)
+	}
+
+	return &response.Project.ProjectUpdates, nil
+}
+
+// ProjectUpdatePages returns a PageFetcher over GetProjectUpdates
+func (c *Client) ProjectUpdatePages(projectID string) PageFetcher[ProjectUpdate] {
+	return func(ctx context.Context, first int, after string) ([]ProjectUpdate, PageInfo, error) {
+		updates, err := c.GetProjectUpdates(ctx, projectID, first, after)
+		if err != nil {
+			return nil, PageInfo{}, err
+		}
+		return updates.Nodes, updates.PageInfo, nil
+	}
+}
+
+// CreateProjectUpdate posts a status update to a project
+func (c *Client) CreateProjectUpdate(ctx context.Context, projectID, body, health string) (*ProjectUpdate, error) {
+	query := `
+		mutation CreateProjectUpdate($input: ProjectUpdateCreateInput!) {
+			projectUpdateCreate(input: $input) {
+				success
+				projectUpdate {
+					id
+					body
+					health
+					url
+					createdAt
+					updatedAt
+					user {
+						id
+						name
+						email
+					}
+				}
+			}
+		}
+	`
+
+	input := map[string]interface{}{
+		"projectId": projectID,
+		"body":      body,
+	}
+	if health != "" {
+		input["health"] = health
+	}
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	var response struct {
+		ProjectUpdateCreate struct {
+			Success       bool           `json:"success"`
+			ProjectUpdate *ProjectUpdate `json:"projectUpdate"`
+		} `json:"projectUpdateCreate"`
+	}
+
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return nil, err
+	}
+
+	if !response.ProjectUpdateCreate.Success || response.ProjectUpdateCreate.ProjectUpdate == nil {
+		return nil, fmt.Errorf("projectUpdateCreate failed")
+	}
+
+	return response.ProjectUpdateCreate.ProjectUpdate, nil
+}
@@ -3,6 +3,7 @@ package api
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"time"
 )
 
@@ -55,6 +56,7 @@ type Issue struct {
 	URL                 string       `json:"url"`
 	BranchName          string       `json:"branchName"`
 	Cycle               *Cycle       `json:"cycle"`
+	ProjectMilestone    *Milestone   `json:"projectMilestone,omitempty"`
 	Project             *Project     `json:"project"`
 	Attachments         *Attachments `json:"attachments"`
 	Comments            *Comments    `json:"comments"`
@@ -74,6 +76,7 @@ type Issue struct {
 	Creator               *User            `json:"creator"`
 	Subscribers           *Users           `json:"subscribers"`
 	Relations             *IssueRelations  `json:"relations"`
+	InverseRelations      *IssueRelations  `json:"inverseRelations,omitempty"`
 	History               *IssueHistory    `json:"history"`
 	Reactions             []Reaction       `json:"reactions"`
 	SlackIssueComments    []SlackComment   `json:"slackIssueComments"`
@@ -149,7 +152,8 @@ type Users struct {
 }
 
 type Labels struct {
-	Nodes []Label `json:"nodes"`
+	Nodes    []Label  `json:"nodes"`
+	PageInfo PageInfo `json:"pageInfo"`
 }
 
 type Label struct {
@@ -158,6 +162,8 @@ type Label struct {
 	Color       string  `json:"color"`
 	Description *string `json:"description"`
 	Parent      *Label  `json:"parent"`
+	IsGroup     bool    `json:"isGroup,omitempty"`
+	Team        *Team   `json:"team,omitempty"`
 }
 
 // Cycle represents a Linear cycle (sprint)
@@ -171,6 +177,13 @@ type Cycle struct {
 	Progress     float64    `json:"progress"`
 	CompletedAt  *time.Time `json:"completedAt"`
 	ScopeHistory []float64  `json:"scopeHistory"`
+	IsActive     bool       `json:"isActive,omitempty"`
+	IsNext       bool       `json:"isNext,omitempty"`
+	IsPrevious   bool       `json:"isPrevious,omitempty"`
+	IsFuture     bool       `json:"isFuture,omitempty"`
+	IsPast       bool       `json:"isPast,omitempty"`
+	Team         *Team      `json:"team,omitempty"`
+	Issues       *Issues    `json:"issues,omitempty"`
 }
 
 // Attachment represents a file attachment or link
@@ -225,9 +238,23 @@ type Attachments struct {
 
 // Initiative represents a Linear initiative
 type Initiative struct {
-	ID          string `json:"id"`
-	Name        string `json:"name"`
-	Description string `json:"description"`
+	ID          string     `json:"id"`
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	SlugId      string     `json:"slugId,omitempty"`
+	Status      string     `json:"status,omitempty"`
+	Health      string     `json:"health,omitempty"`
+	TargetDate  *string    `json:"targetDate,omitempty"`
+	URL         string     `json:"url,omitempty"`
+	Owner       *User      `json:"owner,omitempty"`
+	CreatedAt   *time.Time `json:"createdAt,omitempty"`
+	UpdatedAt   *time.Time `json:"updatedAt,omitempty"`
+	Projects    *Projects  `json:"projects,omitempty"`
+}
+
+type Initiatives struct {
+	Nodes    []Initiative `json:"nodes"`
+	PageInfo PageInfo     `json:"pageInfo"`
 }
 
 type PageInfo struct {
@@ -237,7 +264,8 @@ type PageInfo struct {
 
 // Additional types for expanded fields
 type IssueRelations struct {
-	Nodes []IssueRelation `json:"nodes"`
+	Nodes    []IssueRelation `json:"nodes"`
+	PageInfo PageInfo        `json:"pageInfo"`
 }
 
 type IssueRelation struct {
@@ -248,7 +276,8 @@ type IssueRelation struct {
 }
 
 type IssueHistory struct {
-	Nodes []IssueHistoryEntry `json:"nodes"`
+	Nodes    []IssueHistoryEntry `json:"nodes"`
+	PageInfo PageInfo            `json:"pageInfo"`
 }
 
 type IssueHistoryEntry struct {
@@ -299,9 +328,12 @@ type CustomerTicket struct {
 }
 
 type Template struct {
-	ID          string `json:"id"`
-	Name        string `json:"name"`
-	Description string `json:"description"`
+	ID           string          `json:"id"`
+	Name         string          `json:"name"`
+	Description  string          `json:"description"`
+	Type         string          `json:"type,omitempty"`
+	TemplateData json.RawMessage `json:"templateData,omitempty"`
+	Team         *Team           `json:"team,omitempty"`
 }
 
 type Milestone struct {
@@ -310,10 +342,19 @@ type Milestone struct {
 	Description string    `json:"description"`
 	TargetDate  *string   `json:"targetDate"`
 	Projects    *Projects `json:"projects"`
+	SortOrder   float64   `json:"sortOrder,omitempty"`
+	Project     *Project  `json:"project,omitempty"`
+	Issues      *Issues   `json:"issues,omitempty"`
+}
+
+type Milestones struct {
+	Nodes    []Milestone `json:"nodes"`
+	PageInfo PageInfo    `json:"pageInfo"`
 }
 
 type Roadmaps struct {
-	Nodes []Roadmap `json:"nodes"`
+	Nodes    []Roadmap `json:"nodes"`
+	PageInfo PageInfo  `json:"pageInfo"`
 }
 
 type Roadmap struct {
@@ -323,10 +364,15 @@ type Roadmap struct {
 	Creator     *User     `json:"creator"`
 	CreatedAt   time.Time `json:"createdAt"`
 	UpdatedAt   time.Time `json:"updatedAt"`
+	SlugId      string    `json:"slugId,omitempty"`
+	URL         string    `json:"url,omitempty"`
+	Owner       *User     `json:"owner,omitempty"`
+	Projects    *Projects `json:"projects,omitempty"`
 }
 
 type ProjectUpdates struct {
-	Nodes []ProjectUpdate `json:"nodes"`
+	Nodes    []ProjectUpdate `json:"nodes"`
+	PageInfo PageInfo        `json:"pageInfo"`
 }
 
 type ProjectUpdate struct {
@@ -337,10 +383,12 @@ type ProjectUpdate struct {
 	UpdatedAt time.Time  `json:"updatedAt"`
 	EditedAt  *time.Time `json:"editedAt"`
 	Health    string     `json:"health"`
+	URL       string     `json:"url,omitempty"`
 }
 
 type Documents struct {
-	Nodes []Document `json:"nodes"`
+	Nodes    []Document `json:"nodes"`
+	PageInfo PageInfo   `json:"pageInfo"`
 }
 
 type Document struct {
@@ -353,6 +401,9 @@ type Document struct {
 	UpdatedBy *User     `json:"updatedBy"`
 	CreatedAt time.Time `json:"createdAt"`
 	UpdatedAt time.Time `json:"updatedAt"`
+	SlugId    string    `json:"slugId,omitempty"`
+	URL       string    `json:"url,omitempty"`
+	Project   *Project  `json:"project,omitempty"`
 }
 
 type ProjectLinks struct {
@@ -397,10 +448,10 @@ func (c *Client) GetViewer(ctx context.Context) (*User, error) {
 }
 
 // GetIssues returns a list of issues with optional filtering
-func (c *Client) GetIssues(ctx context.Context, filter map[string]interface{}, first int, after string, orderBy string) (*Issues, error) {
+func (c *Client) GetIssues(ctx context.Context, filter map[string]interface{}, first int, after string, orderBy string, includeArchived bool) (*Issues, error) {
 	query := `
-		query Issues($filter: IssueFilter, $first: Int, $after: String, $orderBy: PaginationOrderBy) {
-			issues(filter: $filter, first: $first, after: $after, orderBy: $orderBy) {
+		query Issues($filter: IssueFilter, $first: Int, $after: String, $orderBy: PaginationOrderBy, $includeArchived: Boolean) {
+			issues(filter: $filter, first: $first, after: $after, orderBy: $orderBy, includeArchived: $includeArchived) {
 				nodes {
 					id
 					identifier
@@ -428,6 +479,10 @@ func (c *Client) GetIssues(ctx context.Context, filter map[string]interface{}, f
 						key
 						name
 					}
+					project {
+						id
+						name
+					}
 					labels {
 						nodes {
 							id
@@ -456,6 +511,9 @@ func (c *Client) GetIssues(ctx context.Context, filter map[string]interface{}, f
 	if orderBy != "" {
 		variables["orderBy"] = orderBy
 	}
+	if includeArchived {
+		variables["includeArchived"] = true
+	}
 
 	var response struct {
 		Issues Issues `json:"issues"`
@@ -680,6 +738,11 @@ func (c *Client) GetIssue(ctx context.Context, id string) (*Issue, error) {
 						email
 					}
 				}
+				projectMilestone {
+					id
+					name
+					targetDate
+				}
 				attachments(first: 20) {
 					nodes {
 						id
@@ -1162,6 +1225,104 @@ func (c *Client) UpdateIssue(ctx context.Context, id string, input map[string]in
 	return &response.IssueUpdate.Issue, nil
 }
 
+// ArchiveIssue archives an issue. With trash set, the issue is moved to the trash instead.
+func (c *Client) ArchiveIssue(ctx context.Context, id string, trash bool) error {
+	query := `
+		mutation ArchiveIssue($id: String!, $trash: Boolean) {
+			issueArchive(id: $id, trash: $trash) {
+				success
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id":    id,
+		"trash": trash,
+	}
+
+	var response struct {
+		IssueArchive struct {
+			Success bool `json:"success"`
+		} `json:"issueArchive"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return err
+	}
+
+	if !response.IssueArchive.Success {
+		return fmt.Errorf("issueArchive failed")
+	}
+
+	return nil
+}
+
+// UnarchiveIssue restores an archived or trashed issue
+func (c *Client) UnarchiveIssue(ctx context.Context, id string) error {
+	query := `
+		mutation UnarchiveIssue($id: String!) {
+			issueUnarchive(id: $id) {
+				success
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": id,
+	}
+
+	var response struct {
+		IssueUnarchive struct {
+			Success bool `json:"success"`
+		} `json:"issueUnarchive"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return err
+	}
+
+	if !response.IssueUnarchive.Success {
+		return fmt.Errorf("issueUnarchive failed")
+	}
+
+	return nil
+}
+
+// DeleteIssue moves an issue to the trash, or deletes it permanently when permanent is set
+func (c *Client) DeleteIssue(ctx context.Context, id string, permanent bool) error {
+	query := `
+		mutation DeleteIssue($id: String!, $permanentlyDelete: Boolean) {
+			issueDelete(id: $id, permanentlyDelete: $permanentlyDelete) {
+				success
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id":                id,
+		"permanentlyDelete": permanent,
+	}
+
+	var response struct {
+		IssueDelete struct {
+			Success bool `json:"success"`
+		} `json:"issueDelete"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return err
+	}
+
+	if !response.IssueDelete.Success {
+		return fmt.Errorf("issueDelete failed")
+	}
+
+	return nil
+}
+
 // CreateIssue creates a new issue
 func (c *Client) CreateIssue(ctx context.Context, input map[string]interface{}) (*Issue, error) {
 	query := `
@@ -1299,6 +1460,12 @@ type Comment struct {
 	User      *User      `json:"user"`
 	Parent    *Comment   `json:"parent"`
 	Children  *Comments  `json:"children"`
+
+	ResolvedAt    *time.Time `json:"resolvedAt,omitempty"`
+	ResolvingUser *User      `json:"resolvingUser,omitempty"`
+	Reactions     []Reaction `json:"reactions,omitempty"`
+	URL           string     `json:"url,omitempty"`
+	Issue         *Issue     `json:"issue,omitempty"`
 }
 
 // Comments represents a paginated list of comments
@@ -1480,17 +1647,7 @@ func (c *Client) GetIssueComments(ctx context.Context, issueID string, first int
 		query IssueComments($id: String!, $first: Int, $after: String, $orderBy: PaginationOrderBy) {
 			issue(id: $id) {
 				comments(first: $first, after: $after, orderBy: $orderBy) {
-					nodes {
-						id
-						body
-						createdAt
-						updatedAt
-						user {
-							id
-							name
-							email
-						}
-					}
+					nodes {` + commentFields + `}
 					pageInfo {
 						hasNextPage
 						endCursor
@@ -1527,43 +1684,8 @@ func (c *Client) GetIssueComments(ctx context.Context, issueID string, first int
 
 // CreateComment creates a new comment on an issue
 func (c *Client) CreateComment(ctx context.Context, issueID string, body string) (*Comment, error) {
-	query := `
-		mutation CreateComment($input: CommentCreateInput!) {
-			commentCreate(input: $input) {
-				comment {
-					id
-					body
-					createdAt
-					updatedAt
-					user {
-						id
-						name
-						email
-					}
-				}
-			}
-		}
-	`
-
-	input := map[string]interface{}{
+	return c.createComment(ctx, map[string]interface{}{
 		"issueId": issueID,
 		"body":    body,
-	}
-
-	variables := map[string]interface{}{
-		"input": input,
-	}
-
-	var response struct {
-		CommentCreate struct {
-			Comment Comment `json:"comment"`
-		} `json:"commentCreate"`
-	}
-
-	err := c.Execute(ctx, query, variables, &response)
-	if err != nil {
-		return nil, err
-	}
-
-	return &response.CommentCreate.Comment, nil
+	})
 }
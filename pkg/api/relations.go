@@ -0,0 +1,266 @@
+package api
+
+import (
+	"context"
+	"fmt"
+)
+
+// Issue relation types accepted by Linear
+const (
+	RelationBlocks    = "blocks"
+	RelationDuplicate = "duplicate"
+	RelationRelated   = "related"
+	RelationSimilar   = "similar"
+)
+
+// relationPageSize is the page size of relations nested in an issue query
+const relationPageSize = 100
+
+// relatedIssueFields is selected for the issue on the other end of a relation
+const relatedIssueFields = `
+	id
+	identifier
+	title
+	state {
+		name
+		type
+		color
+	}
+	assignee {
+		name
+		email
+	}
+`
+
+// GetIssueRelations returns an issue with both its outgoing relations and the
+// relations other issues hold against it (inverseRelations). Both are paged
+// to the end, so an issue with many relations is returned complete.
+func (c *Client) GetIssueRelations(ctx context.Context, id string) (*Issue, error) {
+	query := `
+		query IssueRelations($id: String!, $first: Int) {
+			issue(id: $id) {
+				id
+				identifier
+				title
+				state {
+					name
+					type
+					color
+				}
+				relations(first: $first) {
+					nodes {
+						id
+						type
+						relatedIssue {` + relatedIssueFields + `}
+					}
+					pageInfo {
+						hasNextPage
+						endCursor
+					}
+				}
+				inverseRelations(first: $first) {
+					nodes {
+						id
+						type
+						issue {` + relatedIssueFields + `}
+					}
+					pageInfo {
+						hasNextPage
+						endCursor
+					}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id":    id,
+		"first": relationPageSize,
+	}
+
+	var response struct {
+		Issue *Issue `json:"issue"`
+	}
+
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return nil, err
+	}
+
+	issue := response.Issue
+	if issue == nil || issue.ID == "" {
+		return nil, newNotFoundError("issue", id)
+	}
+
+	if err := c.fetchRemainingRelations(ctx, issue.Relations, c.RelationPages(issue.ID, false)); err != nil {
+		return nil, err
+	}
+	if err := c.fetchRemainingRelations(ctx, issue.InverseRelations, c.RelationPages(issue.ID, true)); err != nil {
+		return nil, err
+	}
+
+	return issue, nil
+}
+
+// fetchRemainingRelations appends the pages after the first to relations
+func (c *Client) fetchRemainingRelations(ctx context.Context, relations *IssueRelations, fetch PageFetcher[IssueRelation]) error {
+	if relations == nil || !relations.PageInfo.HasNextPage {
+		return nil
+	}
+
+	rest, err := NewPaginator(resumePages(fetch, relations.PageInfo.EndCursor), 0).All(ctx)
+	if err != nil {
+		return err
+	}
+	relations.Nodes = append(relations.Nodes, rest...)
+	relations.PageInfo = PageInfo{}
+	return nil
+}
+
+// GetRelations returns one page of an issue's outgoing relations, or of the
+// relations other issues hold against it when inverse is set
+func (c *Client) GetRelations(ctx context.Context, issueID string, inverse bool, first int, after string) (*IssueRelations, error) {
+	operation, connection, otherIssue := "RelationPage", "relations", "relatedIssue"
+	if inverse {
+		operation, connection, otherIssue = "InverseRelationPage", "inverseRelations", "issue"
+	}
+
+	query := `
+		query ` + operation + `($id: String!, $first: Int, $after: String) {
+			issue(id: $id) {
+				id
+				` + connection + `(first: $first, after: $after) {
+					nodes {
+						id
+						type
+						` + otherIssue + ` {` + relatedIssueFields + `}
+					}
+					pageInfo {
+						hasNextPage
+						endCursor
+					}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id":    issueID,
+		"first": first,
+	}
+	if after != "" {
+		variables["after"] = after
+	}
+
+	var response struct {
+		Issue *Issue `json:"issue"`
+	}
+
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return nil, err
+	}
+
+	if response.Issue == nil || response.Issue.ID == "" {
+		return nil, newNotFoundError("issue", issueID)
+	}
+
+	relations := response.Issue.Relations
+	if inverse {
+		relations = response.Issue.InverseRelations
+	}
+	if relations == nil {
+		relations = &IssueRelations{}
+	}
+	return relations, nil
+}
+
+// RelationPages returns a PageFetcher over GetRelations
+func (c *Client) RelationPages(issueID string, inverse bool) PageFetcher[IssueRelation] {
+	return func(ctx context.Context, first int, after string) ([]IssueRelation, PageInfo, error) {
+		relations, err := c.GetRelations(ctx, issueID, inverse, first, after)
+		if err != nil {
+			return nil, PageInfo{}, err
+		}
+		return relations.Nodes, relations.PageInfo, nil
+	}
+}
+
+// CreateIssueRelation creates a relation of the given type from issueID to relatedIssueID
+func (c *Client) CreateIssueRelation(ctx context.Context, issueID, relatedIssueID, relationType string) (*IssueRelation, error) {
+	query := `
+		mutation CreateIssueRelation($input: IssueRelationCreateInput!) {
+			issueRelationCreate(input: $input) {
+				success
+				issueRelation {
+					id
+					type
+					issue {
+						id
+						identifier
+						title
+					}
+					relatedIssue {
+						id
+						identifier
+						title
+					}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": map[string]interface{}{
+			"issueId":        issueID,
+			"relatedIssueId": relatedIssueID,
+			"type":           relationType,
+		},
+	}
+
+	var response struct {
+		IssueRelationCreate struct {
+			Success       bool           `json:"success"`
+			IssueRelation *IssueRelation `json:"issueRelation"`
+		} `json:"issueRelationCreate"`
+	}
+
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return nil, err
+	}
+
+	if !response.IssueRelationCreate.Success || response.IssueRelationCreate.IssueRelation == nil {
+		return nil, fmt.Errorf("issueRelationCreate failed")
+	}
+
+	return response.IssueRelationCreate.IssueRelation, nil
+}
+
+// DeleteIssueRelation removes an issue relation by ID
+func (c *Client) DeleteIssueRelation(ctx context.Context, id string) error {
+	query := `
+		mutation DeleteIssueRelation($id: String!) {
+			issueRelationDelete(id: $id) {
+				success
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": id,
+	}
+
+	var response struct {
+		IssueRelationDelete struct {
+			Success bool `json:"success"`
+		} `json:"issueRelationDelete"`
+	}
+
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return err
+	}
+
+	if !response.IssueRelationDelete.Success {
+		return fmt.Errorf("issueRelationDelete failed")
+	}
+
+	return nil
+}
@@ -0,0 +1,382 @@
+package api
+
+import (
+	"context"
+	"fmt"
+)
+
+// GetRoadmaps returns one page of roadmaps with their project IDs
+func (c *Client) GetRoadmaps(ctx context.Context, first int, after string) (*Roadmaps, error) {
+	query := `
+		query Roadmaps($first: Int, $after: String) {
+			roadmaps(first: $first, after: $after) {
+				nodes {
+					id
+					slugId
+					name
+					description
+					url
+					createdAt
+					updatedAt
+					owner {
+						id
+						name
+						email
+					}
+					creator {
+						id
+						name
+						email
+					}
+					projects(first: 100) {
+						nodes {
+							id
+							progress
+						}
+					}
+				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"first": first,
+	}
+	if after != "" {
+		variables["after"] = after
+	}
+
+	var response struct {
+		Roadmaps Roadmaps `json:"roadmaps"`
+	}
+
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return nil, err
+	}
+
+	return &response.Roadmaps, nil
+}
+
+// RoadmapPages returns a PageFetcher over GetRoadmaps, capped at
+// rollupPageSize roadmaps a page
+func (c *Client) RoadmapPages() PageFetcher[Roadmap] {
+	return func(ctx context.Context, first int, after string) ([]Roadmap, PageInfo, error) {
+		if first > rollupPageSize {
+			first = rollupPageSize
+		}
+		roadmaps, err := c.GetRoadmaps(ctx, first, after)
+		if err != nil {
+			return nil, PageInfo{}, err
+		}
+		return roadmaps.Nodes, roadmaps.PageInfo, nil
+	}
+}
+
+// GetRoadmapNames returns one page of roadmaps with only their IDs, slugs
+// and names, for resolving a roadmap by name
+func (c *Client) GetRoadmapNames(ctx context.Context, first int, after string) (*Roadmaps, error) {
+	query := `
+		query RoadmapNames($first: Int, $after: String) {
+			roadmaps(first: $first, after: $after) {
+				nodes {
+					id
+					slugId
+					name
+				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"first": first,
+	}
+	if after != "" {
+		variables["after"] = after
+	}
+
+	var response struct {
+		Roadmaps Roadmaps `json:"roadmaps"`
+	}
+
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return nil, err
+	}
+
+	return &response.Roadmaps, nil
+}
+
+// RoadmapNamePages returns a PageFetcher over GetRoadmapNames
+func (c *Client) RoadmapNamePages() PageFetcher[Roadmap] {
+	return func(ctx context.Context, first int, after string) ([]Roadmap, PageInfo, error) {
+		roadmaps, err := c.GetRoadmapNames(ctx, first, after)
+		if err != nil {
+			return nil, PageInfo{}, err
+		}
+		return roadmaps.Nodes, roadmaps.PageInfo, nil
+	}
+}
+
+// GetRoadmap returns a roadmap, by ID or slug, with its projects
+func (c *Client) GetRoadmap(ctx context.Context, id string) (*Roadmap, error) {
+	query := `
+		query Roadmap($id: String!, $first: Int) {
+			roadmap(id: $id) {
+				id
+				slugId
+				name
+				description
+				url
+				createdAt
+				updatedAt
+				owner {
+					id
+					name
+					email
+				}
+				creator {
+					id
+					name
+					email
+				}
+				projects(first: $first) {
+					nodes {` + rollupProjectFields + `}
+					pageInfo {
+						hasNextPage
+						endCursor
+					}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id":    id,
+		"first": MaxPageSize,
+	}
+
+	var response struct {
+		Roadmap *Roadmap `json:"roadmap"`
+	}
+
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return nil, err
+	}
+
+	roadmap := response.Roadmap
+	if roadmap == nil || roadmap.ID == "" {
+		return nil, newNotFoundError("roadmap", id)
+	}
+
+	if roadmap.Projects != nil && roadmap.Projects.PageInfo.HasNextPage {
+		rest, err := NewPaginator(resumePages(c.RoadmapProjectPages(roadmap.ID), roadmap.Projects.PageInfo.EndCursor), 0).All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		roadmap.Projects.Nodes = append(roadmap.Projects.Nodes, rest...)
+		roadmap.Projects.PageInfo = PageInfo{}
+	}
+
+	return roadmap, nil
+}
+
+// GetRoadmapProjects returns one page of the projects in a roadmap
+func (c *Client) GetRoadmapProjects(ctx context.Context, roadmapID string, first int, after string) (*Projects, error) {
+	query := `
+		query RoadmapProjects($id: String!, $first: Int, $after: String) {
+			roadmap(id: $id) {
+				id
+				projects(first: $first, after: $after) {
+					nodes {` + rollupProjectFields + `}
+					pageInfo {
+						hasNextPage
+						endCursor
+					}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id":    roadmapID,
+		"first": first,
+	}
+	if after != "" {
+		variables["after"] = after
+	}
+
+	var response struct {
+		Roadmap *struct {
+			ID       string   `json:"id"`
+			Projects Projects `json:"projects"`
+		} `json:"roadmap"`
+	}
+
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return nil, err
+	}
+
+	if response.Roadmap == nil || response.Roadmap.ID == "" {
+		return nil, newNotFoundError("roadmap", roadmapID)
+	}
+
+	return &response.Roadmap.Projects, nil
+}
+
+// RoadmapProjectPages returns a PageFetcher over GetRoadmapProjects
+func (c *Client) RoadmapProjectPages(roadmapID string) PageFetcher[Project] {
+	return func(ctx context.Context, first int, after string) ([]Project, PageInfo, error) {
+		projects, err := c.GetRoadmapProjects(ctx, roadmapID, first, after)
+		if err != nil {
+			return nil, PageInfo{}, err
+		}
+		return projects.Nodes, projects.PageInfo, nil
+	}
+}
+
+// LinkRoadmapProject adds a project to a roadmap
+func (c *Client) LinkRoadmapProject(ctx context.Context, roadmapID, projectID string) error {
+	query := `
+		mutation LinkRoadmapProject($input: RoadmapToProjectCreateInput!) {
+			roadmapToProjectCreate(input: $input) {
+				success
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": map[string]interface{}{
+			"roadmapId": roadmapID,
+			"projectId": projectID,
+		},
+	}
+
+	var response struct {
+		RoadmapToProjectCreate struct {
+			Success bool `json:"success"`
+		} `json:"roadmapToProjectCreate"`
+	}
+
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return err
+	}
+
+	if !response.RoadmapToProjectCreate.Success {
+		return fmt.Errorf("roadmapToProjectCreate failed")
+	}
+
+	return nil
+}
+
+// UnlinkRoadmapProject removes a project from a roadmap
+func (c *Client) UnlinkRoadmapProject(ctx context.Context, roadmapID, projectID string) error {
+	linkID, err := c.findRoadmapToProject(ctx, roadmapID, projectID)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		mutation UnlinkRoadmapProject($id: String!) {
+			roadmapToProjectDelete(id: $id) {
+				success
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": linkID,
+	}
+
+	var response struct {
+		RoadmapToProjectDelete struct {
+			Success bool `json:"success"`
+		} `json:"roadmapToProjectDelete"`
+	}
+
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return err
+	}
+
+	if !response.RoadmapToProjectDelete.Success {
+		return fmt.Errorf("roadmapToProjectDelete failed")
+	}
+
+	return nil
+}
+
+// findRoadmapToProject pages through roadmap/project links to find the
+// one joining the given roadmap and project
+func (c *Client) findRoadmapToProject(ctx context.Context, roadmapID, projectID string) (string, error) {
+	query := `
+		query RoadmapToProjects($first: Int, $after: String) {
+			roadmapToProjects(first: $first, after: $after) {
+				nodes {
+					id
+					roadmap {
+						id
+					}
+					project {
+						id
+					}
+				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+			}
+		}
+	`
+
+	type link struct {
+		ID      string `json:"id"`
+		Roadmap struct {
+			ID string `json:"id"`
+		} `json:"roadmap"`
+		Project struct {
+			ID string `json:"id"`
+		} `json:"project"`
+	}
+
+	fetch := func(ctx context.Context, first int, after string) ([]link, PageInfo, error) {
+		variables := map[string]interface{}{
+			"first": first,
+		}
+		if after != "" {
+			variables["after"] = after
+		}
+
+		var response struct {
+			RoadmapToProjects struct {
+				Nodes    []link   `json:"nodes"`
+				PageInfo PageInfo `json:"pageInfo"`
+			} `json:"roadmapToProjects"`
+		}
+
+		if err := c.Execute(ctx, query, variables, &response); err != nil {
+			return nil, PageInfo{}, err
+		}
+		return response.RoadmapToProjects.Nodes, response.RoadmapToProjects.PageInfo, nil
+	}
+
+	paginator := NewPaginator(fetch, 0)
+	for paginator.HasNext() {
+		links, err := paginator.Next(ctx)
+		if err != nil {
+			return "", err
+		}
+		for _, l := range links {
+			if l.Roadmap.ID == roadmapID && l.Project.ID == projectID {
+				return l.ID, nil
+			}
+		}
+	}
+
+	return "", newNotFoundError("roadmap project link", projectID)
+}
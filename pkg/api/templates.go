@@ -0,0 +1,59 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// TemplateTypeIssue is the Template.Type of issue templates
+const TemplateTypeIssue = "issue"
+
+// GetTemplates returns the workspace's templates, including their data
+func (c *Client) GetTemplates(ctx context.Context) ([]Template, error) {
+	query := `
+		query Templates {
+			templates {
+				id
+				name
+				description
+				type
+				templateData
+				team {
+					id
+					key
+					name
+				}
+			}
+		}
+	`
+
+	var response struct {
+		Templates []Template `json:"templates"`
+	}
+
+	if err := c.Execute(ctx, query, nil, &response); err != nil {
+		return nil, err
+	}
+
+	return response.Templates, nil
+}
+
+// Data decodes the template's data, the issue fields it presets. Linear
+// returns it either as a JSON object or as a string holding one.
+func (t *Template) Data() (map[string]interface{}, error) {
+	data := map[string]interface{}{}
+	if len(t.TemplateData) == 0 || string(t.TemplateData) == "null" {
+		return data, nil
+	}
+
+	raw := []byte(t.TemplateData)
+	var encoded string
+	if err := json.Unmarshal(raw, &encoded); err == nil {
+		raw = []byte(encoded)
+	}
+
+	if err := json.Unmarshal(raw, &data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
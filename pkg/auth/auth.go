@@ -4,9 +4,10 @@ import (
 	"bufio"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
-	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/yjiky/linctl/pkg/api"
@@ -20,22 +21,57 @@ type User struct {
 	AvatarURL string `json:"avatarUrl,omitempty"`
 }
 
+// ErrNotAuthenticated is returned when no credentials have been stored
+var ErrNotAuthenticated = errors.New("not authenticated")
+
+// DefaultProfile is the profile used when none has been selected
+const DefaultProfile = "default"
+
+// activeProfile is the profile selected with --profile or LINCTL_PROFILE;
+// empty means the file's current profile
+var activeProfile string
+
+// Profile holds the credentials and cached viewer for one Linear workspace
+type Profile struct {
+	APIKey string            `json:"api_key,omitempty"`
+	OAuth  *OAuthCredentials `json:"oauth,omitempty"`
+	User   *User             `json:"user,omitempty"`
+}
+
+// AuthConfig is the on-disk auth file. Files written before profiles existed
+// hold a bare api_key, which is read as the default profile.
 type AuthConfig struct {
-	APIKey string `json:"api_key,omitempty"`
+	CurrentProfile string              `json:"current_profile,omitempty"`
+	Profiles       map[string]*Profile `json:"profiles,omitempty"`
+	APIKey         string              `json:"api_key,omitempty"`
 }
 
-// getConfigPath returns the path to the auth config file
-func getConfigPath() (string, error) {
-	homeDir, err := os.UserHomeDir()
-	if err != nil {
-		return "", err
+// ProfileInfo describes a stored profile for listing
+type ProfileInfo struct {
+	Name    string `json:"name"`
+	Current bool   `json:"current"`
+	Active  bool   `json:"active"`
+	Method  string `json:"method"`
+	User    *User  `json:"user,omitempty"`
+}
+
+// method names how a profile authenticates
+func (p *Profile) method() string {
+	if p.OAuth != nil {
+		return "oauth"
 	}
-	return filepath.Join(homeDir, ".linctl-auth.json"), nil
+	return "api_key"
+}
+
+// SetProfile selects the profile used by subsequent calls; empty selects the
+// current profile from the auth file
+func SetProfile(name string) {
+	activeProfile = strings.TrimSpace(name)
 }
 
-// saveAuth saves authentication credentials
-func saveAuth(config AuthConfig) error {
-	configPath, err := getConfigPath()
+// saveAuth saves authentication credentials to the selected store
+func saveAuth(config *AuthConfig) error {
+	credentials, err := currentStore()
 	if err != nil {
 		return err
 	}
@@ -45,45 +81,138 @@ func saveAuth(config AuthConfig) error {
 		return err
 	}
 
-	return os.WriteFile(configPath, data, 0600)
+	return credentials.Save(data)
 }
 
-// loadAuth loads authentication credentials
+// loadAuth loads authentication credentials, returning an empty config when
+// none have been stored yet
 func loadAuth() (*AuthConfig, error) {
-	configPath, err := getConfigPath()
+	credentials, err := currentStore()
 	if err != nil {
 		return nil, err
 	}
 
-	data, err := os.ReadFile(configPath)
+	config := &AuthConfig{Profiles: map[string]*Profile{}}
+	data, err := credentials.Load()
 	if err != nil {
-		if os.IsNotExist(err) {
-			return nil, fmt.Errorf("not authenticated")
+		if errors.Is(err, os.ErrNotExist) {
+			return config, nil
 		}
 		return nil, err
 	}
 
-	var config AuthConfig
-	err = json.Unmarshal(data, &config)
+	err = json.Unmarshal(data, config)
 	if err != nil {
 		return nil, err
 	}
+	if config.Profiles == nil {
+		config.Profiles = map[string]*Profile{}
+	}
+
+	// Migrate the single-key format to a default profile
+	if config.APIKey != "" {
+		if _, ok := config.Profiles[DefaultProfile]; !ok {
+			config.Profiles[DefaultProfile] = &Profile{APIKey: config.APIKey}
+		}
+		if config.CurrentProfile == "" {
+			config.CurrentProfile = DefaultProfile
+		}
+		config.APIKey = ""
+	}
+
+	return config, nil
+}
+
+// profileName returns the profile commands should run against
+func (c *AuthConfig) profileName() string {
+	if activeProfile != "" {
+		return activeProfile
+	}
+	if c.CurrentProfile != "" {
+		return c.CurrentProfile
+	}
+	return DefaultProfile
+}
 
-	return &config, nil
+// ActiveProfile returns the name of the profile commands run against
+func ActiveProfile() string {
+	config, err := loadAuth()
+	if err != nil {
+		if activeProfile != "" {
+			return activeProfile
+		}
+		return DefaultProfile
+	}
+	return config.profileName()
 }
 
-// GetAuthHeader returns the authorization header value
+// APIKeyEnv overrides stored credentials, e.g. in CI
+const APIKeyEnv = "LINEAR_API_KEY"
+
+// EnvAPIKey returns the API key set in LINEAR_API_KEY, if any
+func EnvAPIKey() string {
+	return strings.TrimSpace(os.Getenv(APIKeyEnv))
+}
+
+// GetAuthHeader returns the authorization header value. LINEAR_API_KEY takes
+// precedence over every stored profile and never touches the store.
 func GetAuthHeader() (string, error) {
+	if apiKey := EnvAPIKey(); apiKey != "" {
+		return apiKey, nil
+	}
+
 	config, err := loadAuth()
 	if err != nil {
 		return "", err
 	}
 
-	if config.APIKey != "" {
-		return config.APIKey, nil
+	name := config.profileName()
+	profile, ok := config.Profiles[name]
+	if !ok {
+		if len(config.Profiles) == 0 {
+			return "", ErrNotAuthenticated
+		}
+		return "", fmt.Errorf("profile %q has no credentials (run 'linctl auth login --profile %s'): %w", name, name, ErrNotAuthenticated)
+	}
+
+	if profile.APIKey != "" {
+		return profile.APIKey, nil
+	}
+
+	if profile.OAuth != nil && profile.OAuth.Token != nil {
+		client, err := newOAuthClient(name, profile.OAuth)
+		if err != nil {
+			return "", err
+		}
+		return client.AuthHeader(context.Background())
 	}
 
-	return "", fmt.Errorf("no valid authentication found")
+	return "", fmt.Errorf("no valid authentication found: %w", ErrNotAuthenticated)
+}
+
+// NewClient returns an API client for the selected profile, configured with
+// the options given to SetClientOptions. OAuth clients refresh their token as
+// needed and store the new one.
+func NewClient() (*api.Client, error) {
+	if apiKey := EnvAPIKey(); apiKey != "" {
+		return newClient(apiKey)
+	}
+
+	config, err := loadAuth()
+	if err != nil {
+		return nil, err
+	}
+
+	name := config.profileName()
+	if profile, ok := config.Profiles[name]; ok && profile.OAuth != nil && profile.OAuth.Token != nil {
+		return newOAuthClient(name, profile.OAuth)
+	}
+
+	authHeader, err := GetAuthHeader()
+	if err != nil {
+		return nil, err
+	}
+	return newClient(authHeader)
 }
 
 // Login handles the authentication flow
@@ -97,9 +226,10 @@ func loginWithAPIKey(plaintext, jsonOut bool) error {
 		fmt.Println("\n" + color.New(color.FgYellow).Sprint("📝 Personal API Key Authentication"))
 		fmt.Println("Get your API key from: https://linear.app/settings/api")
 
-		// Get the config path to show to the user
-		configPath, _ := getConfigPath()
-		fmt.Printf("Your credentials will be stored in: %s\n", color.New(color.FgCyan).Sprint(configPath))
+		// Show the user where the key will be kept
+		fmt.Printf("Your credentials will be stored in: %s (profile %s)\n",
+			color.New(color.FgCyan).Sprint(StoreLocation()),
+			color.New(color.FgCyan).Sprint(ActiveProfile()))
 		fmt.Print("\nEnter your Personal API Key: ")
 	}
 
@@ -115,17 +245,20 @@ func loginWithAPIKey(plaintext, jsonOut bool) error {
 	}
 
 	// Test the API key
-	client := api.NewClient(apiKey)
+	client, err := newClient(apiKey)
+	if err != nil {
+		return err
+	}
 	user, err := client.GetViewer(context.Background())
 	if err != nil {
 		return fmt.Errorf("invalid API key: %v", err)
 	}
 
 	// Save the API key
-	config := AuthConfig{
+	err = storeProfile(&Profile{
 		APIKey: apiKey,
-	}
-	err = saveAuth(config)
+		User:   toUser(user),
+	})
 	if err != nil {
 		return err
 	}
@@ -140,39 +273,146 @@ func loginWithAPIKey(plaintext, jsonOut bool) error {
 	return nil
 }
 
+// storeProfile saves credentials under the selected profile, replacing any
+// stored before. The first profile stored becomes the current one.
+func storeProfile(profile *Profile) error {
+	config, err := loadAuth()
+	if err != nil {
+		return err
+	}
+
+	name := config.profileName()
+	config.Profiles[name] = profile
+	if config.CurrentProfile == "" || len(config.Profiles) == 1 {
+		config.CurrentProfile = name
+	}
+
+	return saveAuth(config)
+}
+
 // GetCurrentUser returns the current authenticated user
 func GetCurrentUser() (*User, error) {
-	authHeader, err := GetAuthHeader()
+	client, err := NewClient()
 	if err != nil {
 		return nil, err
 	}
 
-	client := api.NewClient(authHeader)
 	apiUser, err := client.GetViewer(context.Background())
 	if err != nil {
 		return nil, err
 	}
 
-	// Convert api.User to auth.User
+	user := toUser(apiUser)
+	cacheViewer(user)
+	return user, nil
+}
+
+// toUser converts an api.User to an auth.User
+func toUser(apiUser *api.User) *User {
 	return &User{
 		ID:        apiUser.ID,
 		Name:      apiUser.Name,
 		Email:     apiUser.Email,
 		AvatarURL: apiUser.AvatarURL,
-	}, nil
+	}
+}
+
+// cacheViewer refreshes the active profile's cached viewer. Failures are
+// ignored since the cache only feeds 'auth list'.
+func cacheViewer(user *User) {
+	if EnvAPIKey() != "" {
+		return
+	}
+
+	config, err := loadAuth()
+	if err != nil {
+		return
+	}
+	profile, ok := config.Profiles[config.profileName()]
+	if !ok || (profile.User != nil && *profile.User == *user) {
+		return
+	}
+	profile.User = user
+	_ = saveAuth(config)
 }
 
-// Logout clears stored credentials
+// ListProfiles returns the stored profiles sorted by name
+func ListProfiles() ([]ProfileInfo, error) {
+	config, err := loadAuth()
+	if err != nil {
+		return nil, err
+	}
+
+	active := config.profileName()
+	profiles := make([]ProfileInfo, 0, len(config.Profiles))
+	for name, profile := range config.Profiles {
+		profiles = append(profiles, ProfileInfo{
+			Name:    name,
+			Current: name == config.CurrentProfile,
+			Active:  name == active,
+			Method:  profile.method(),
+			User:    profile.User,
+		})
+	}
+	sort.Slice(profiles, func(i, j int) bool {
+		return profiles[i].Name < profiles[j].Name
+	})
+
+	return profiles, nil
+}
+
+// SwitchProfile makes name the current profile for future commands
+func SwitchProfile(name string) (*ProfileInfo, error) {
+	config, err := loadAuth()
+	if err != nil {
+		return nil, err
+	}
+
+	profile, ok := config.Profiles[name]
+	if !ok {
+		return nil, fmt.Errorf("profile %q not found (run 'linctl auth login --profile %s' to create it)", name, name)
+	}
+
+	config.CurrentProfile = name
+	if err := saveAuth(config); err != nil {
+		return nil, err
+	}
+
+	return &ProfileInfo{Name: name, Current: true, Active: true, Method: profile.method(), User: profile.User}, nil
+}
+
+// Logout clears the selected profile's credentials, removing them from the
+// store once no profiles remain
 func Logout() error {
-	configPath, err := getConfigPath()
+	credentials, err := currentStore()
 	if err != nil {
 		return err
 	}
 
-	err = os.Remove(configPath)
-	if err != nil && !os.IsNotExist(err) {
+	config, err := loadAuth()
+	if err != nil {
 		return err
 	}
 
-	return nil
+	name := config.profileName()
+	if _, ok := config.Profiles[name]; !ok && len(config.Profiles) > 0 {
+		return fmt.Errorf("profile %q not found", name)
+	}
+	delete(config.Profiles, name)
+
+	if len(config.Profiles) == 0 {
+		return credentials.Delete()
+	}
+
+	// Fall back to the alphabetically first remaining profile
+	if config.CurrentProfile == name {
+		names := make([]string, 0, len(config.Profiles))
+		for remaining := range config.Profiles {
+			names = append(names, remaining)
+		}
+		sort.Strings(names)
+		config.CurrentProfile = names[0]
+	}
+
+	return saveAuth(config)
 }
@@ -0,0 +1,38 @@
+package auth
+
+import (
+	"github.com/yjiky/linctl/pkg/api"
+)
+
+// clientOptions configure every API client linctl builds: the endpoint,
+// proxy, trusted CAs and extra headers
+var clientOptions = api.ClientOptions{MaxRetries: api.DefaultMaxRetries}
+
+// SetClientOptions applies opts to every API client and OAuth token request
+// made afterwards, and reports whether they are usable. Invalid options are
+// kept, so building a client fails rather than bypassing the configured
+// endpoint or proxy.
+func SetClientOptions(opts api.ClientOptions) error {
+	clientOptions = opts
+	_, err := opts.NewHTTPClient()
+	return err
+}
+
+// newClient builds an API client with the configured options. All clients,
+// including the ones used while logging in, are created here.
+func newClient(authHeader string) (*api.Client, error) {
+	return api.NewClientWithOptions(authHeader, clientOptions)
+}
+
+// newOAuthClient builds a client for a profile's OAuth credentials that
+// stores refreshed tokens under the profile
+func newOAuthClient(name string, credentials *OAuthCredentials) (*api.Client, error) {
+	client, err := newClient("")
+	if err != nil {
+		return nil, err
+	}
+	client.UseOAuth(credentials.config(), credentials.Token, func(token *api.OAuthToken) {
+		saveToken(name, token)
+	})
+	return client, nil
+}
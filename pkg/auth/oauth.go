@@ -0,0 +1,279 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"html"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"runtime"
+	"time"
+
+	"github.com/yjiky/linctl/pkg/api"
+	"github.com/fatih/color"
+)
+
+// DefaultOAuthScopes are requested when no scopes are given
+var DefaultOAuthScopes = []string{"read", "write"}
+
+// oauthLoginTimeout bounds how long login waits for the browser callback
+const oauthLoginTimeout = 5 * time.Minute
+
+// OAuthCredentials are the OAuth application and token stored for a profile
+type OAuthCredentials struct {
+	ClientID     string          `json:"client_id"`
+	ClientSecret string          `json:"client_secret,omitempty"`
+	TokenURL     string          `json:"token_url,omitempty"`
+	Token        *api.OAuthToken `json:"token"`
+}
+
+// config returns the OAuth application used to refresh the stored token
+func (c *OAuthCredentials) config() *api.OAuthConfig {
+	return &api.OAuthConfig{
+		ClientID:     c.ClientID,
+		ClientSecret: c.ClientSecret,
+		TokenURL:     c.TokenURL,
+	}
+}
+
+// OAuthLoginOptions configures 'auth login --oauth'. Empty fields fall back
+// to the LINCTL_OAUTH_* environment variables, then to Linear's defaults.
+type OAuthLoginOptions struct {
+	ClientID     string
+	ClientSecret string
+	Scopes       []string
+	Port         int
+	NoBrowser    bool
+
+	// AuthorizeURL and TokenURL override Linear's endpoints, e.g. to log in
+	// against a local fake token endpoint
+	AuthorizeURL string
+	TokenURL     string
+}
+
+// withDefaults fills empty options from the environment
+func (o OAuthLoginOptions) withDefaults() OAuthLoginOptions {
+	fallback := func(value *string, env string) {
+		if *value == "" {
+			*value = os.Getenv(env)
+		}
+	}
+	fallback(&o.ClientID, "LINCTL_OAUTH_CLIENT_ID")
+	fallback(&o.ClientSecret, "LINCTL_OAUTH_CLIENT_SECRET")
+	fallback(&o.AuthorizeURL, "LINCTL_OAUTH_AUTHORIZE_URL")
+	fallback(&o.TokenURL, "LINCTL_OAUTH_TOKEN_URL")
+	if len(o.Scopes) == 0 {
+		o.Scopes = DefaultOAuthScopes
+	}
+	return o
+}
+
+// LoginOAuth runs the OAuth 2.0 authorization code flow with PKCE: it starts
+// a loopback HTTP server, opens the authorize URL in the browser, exchanges
+// the returned code and stores the tokens under the selected profile.
+func LoginOAuth(opts OAuthLoginOptions, plaintext, jsonOut bool) error {
+	opts = opts.withDefaults()
+	if opts.ClientID == "" {
+		return fmt.Errorf("an OAuth client ID is required (--client-id or LINCTL_OAUTH_CLIENT_ID); create an application at https://linear.app/settings/api/applications/new")
+	}
+
+	listener, err := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%d", opts.Port))
+	if err != nil {
+		return fmt.Errorf("failed to start callback server: %v", err)
+	}
+	defer listener.Close()
+
+	// Token requests go through the same proxy and TLS settings as the API
+	client, err := newClient("")
+	if err != nil {
+		return err
+	}
+
+	port := listener.Addr().(*net.TCPAddr).Port
+	oauthConfig := &api.OAuthConfig{
+		ClientID:     opts.ClientID,
+		ClientSecret: opts.ClientSecret,
+		AuthorizeURL: opts.AuthorizeURL,
+		TokenURL:     opts.TokenURL,
+		RedirectURL:  fmt.Sprintf("http://127.0.0.1:%d/callback", port),
+		Scopes:       opts.Scopes,
+		HTTPClient:   client.HTTPClient(),
+	}
+
+	verifier, err := api.NewCodeVerifier()
+	if err != nil {
+		return err
+	}
+	state, err := randomState()
+	if err != nil {
+		return err
+	}
+	authURL := oauthConfig.AuthCodeURL(state, api.CodeChallenge(verifier))
+
+	ctx, cancel := context.WithTimeout(context.Background(), oauthLoginTimeout)
+	defer cancel()
+
+	codes := make(chan string, 1)
+	failures := make(chan error, 1)
+	server := &http.Server{
+		Handler:           callbackHandler(state, codes, failures),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() { _ = server.Serve(listener) }()
+	defer func() { _ = server.Shutdown(context.Background()) }()
+
+	// Keep stdout clean for scripts; prompts go to stderr outside the rich view
+	prompt := os.Stdout
+	if plaintext || jsonOut {
+		prompt = os.Stderr
+	}
+	if !plaintext && !jsonOut {
+		fmt.Println("\n" + color.New(color.FgYellow).Sprint("🌐 OAuth Authentication"))
+		fmt.Printf("Your credentials will be stored in: %s (profile %s)\n",
+			color.New(color.FgCyan).Sprint(StoreLocation()),
+			color.New(color.FgCyan).Sprint(ActiveProfile()))
+	}
+	fmt.Fprintf(prompt, "\nOpen this URL to authorize linctl:\n%s\n\n", authURL)
+	if !opts.NoBrowser {
+		if err := openBrowser(authURL); err != nil {
+			fmt.Fprintf(prompt, "Could not open a browser (%v); open the URL above manually.\n", err)
+		}
+	}
+	fmt.Fprintf(prompt, "Waiting for authorization on %s ...\n", oauthConfig.RedirectURL)
+
+	var code string
+	select {
+	case code = <-codes:
+	case err := <-failures:
+		return err
+	case <-ctx.Done():
+		return fmt.Errorf("timed out waiting for authorization")
+	}
+
+	token, err := oauthConfig.Exchange(ctx, code, verifier)
+	if err != nil {
+		return fmt.Errorf("failed to exchange authorization code: %w", err)
+	}
+
+	client.UseOAuth(oauthConfig, token, nil)
+	user, err := client.GetViewer(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to verify access token: %v", err)
+	}
+
+	err = storeProfile(&Profile{
+		OAuth: &OAuthCredentials{
+			ClientID:     opts.ClientID,
+			ClientSecret: opts.ClientSecret,
+			TokenURL:     opts.TokenURL,
+			Token:        token,
+		},
+		User: toUser(user),
+	})
+	if err != nil {
+		return err
+	}
+
+	if !plaintext && !jsonOut {
+		fmt.Printf("\n%s Authenticated as %s (%s)\n",
+			color.New(color.FgGreen).Sprint("✅"),
+			color.New(color.FgCyan).Sprint(user.Name),
+			color.New(color.FgCyan).Sprint(user.Email))
+	}
+
+	return nil
+}
+
+// callbackHandler receives the authorization redirect. Requests with another
+// state did not come from this login, so they are rejected without ending it:
+// any local process could otherwise abort the login with a forged callback.
+func callbackHandler(state string, codes chan<- string, failures chan<- error) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/callback", func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		if query.Get("state") != state {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, "<html><body><h1>linctl authorization failed</h1><p>authorization callback has an invalid state</p></body></html>")
+			return
+		}
+
+		var err error
+		switch {
+		case query.Get("error") != "":
+			message := query.Get("error_description")
+			if message == "" {
+				message = query.Get("error")
+			}
+			err = fmt.Errorf("authorization denied: %s", message)
+		case query.Get("code") == "":
+			err = errors.New("authorization callback is missing the code")
+		}
+
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, "<html><body><h1>linctl authorization failed</h1><p>%s</p></body></html>", html.EscapeString(err.Error()))
+			select {
+			case failures <- err:
+			default:
+			}
+			return
+		}
+
+		fmt.Fprint(w, "<html><body><h1>linctl is authorized</h1><p>You can close this window and return to the terminal.</p></body></html>")
+		select {
+		case codes <- query.Get("code"):
+		default:
+		}
+	})
+	return mux
+}
+
+// saveToken stores a refreshed token for the named profile
+func saveToken(name string, token *api.OAuthToken) {
+	config, err := loadAuth()
+	if err == nil {
+		profile, ok := config.Profiles[name]
+		if !ok || profile.OAuth == nil {
+			return
+		}
+		profile.OAuth.Token = token
+		err = saveAuth(config)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "warning: failed to save refreshed access token: %v\n", err)
+	}
+}
+
+// randomState returns an unguessable OAuth state value
+func randomState() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate state: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// openBrowser opens url in the user's default browser
+func openBrowser(url string) error {
+	var command *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		command = exec.Command("open", url)
+	case "windows":
+		command = exec.Command("rundll32", "url.dll,FileProtocolHandler", url)
+	default:
+		command = exec.Command("xdg-open", url)
+	}
+	if err := command.Start(); err != nil {
+		return err
+	}
+	go func() { _ = command.Wait() }()
+	return nil
+}
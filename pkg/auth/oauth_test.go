@@ -0,0 +1,98 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCallbackHandler(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   string
+		status  int
+		code    string
+		failure bool
+	}{
+		{"authorized", "?state=state-1&code=code-1", http.StatusOK, "code-1", false},
+		{"denied", "?state=state-1&error=access_denied&error_description=User+denied+access", http.StatusBadRequest, "", true},
+		{"missing code", "?state=state-1", http.StatusBadRequest, "", true},
+		{"state mismatch ignored", "?state=forged&code=code-2", http.StatusBadRequest, "", false},
+		{"denial with another state ignored", "?state=forged&error=access_denied", http.StatusBadRequest, "", false},
+		{"missing state ignored", "?code=code-2", http.StatusBadRequest, "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			codes := make(chan string, 1)
+			failures := make(chan error, 1)
+			handler := callbackHandler("state-1", codes, failures)
+
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, httptest.NewRequest("GET", "/callback"+tt.query, nil))
+			if recorder.Code != tt.status {
+				t.Errorf("status = %d, want %d", recorder.Code, tt.status)
+			}
+
+			select {
+			case code := <-codes:
+				if code != tt.code {
+					t.Errorf("code = %q, want %q", code, tt.code)
+				}
+			default:
+				if tt.code != "" {
+					t.Errorf("no code received, want %q", tt.code)
+				}
+			}
+
+			select {
+			case err := <-failures:
+				if !tt.failure {
+					t.Errorf("login failed with %v, want it to keep waiting", err)
+				}
+			default:
+				if tt.failure {
+					t.Error("login kept waiting, want it to fail")
+				}
+			}
+		})
+	}
+}
+
+func TestCallbackHandlerKeepsWaitingAfterForgedCallback(t *testing.T) {
+	codes := make(chan string, 1)
+	failures := make(chan error, 1)
+	server := httptest.NewServer(callbackHandler("state-1", codes, failures))
+	defer server.Close()
+
+	for _, query := range []string{"?state=forged&error=access_denied", "?state=state-1&code=code-1"} {
+		resp, err := http.Get(server.URL + "/callback" + query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_ = resp.Body.Close()
+	}
+
+	if len(failures) != 0 {
+		t.Fatalf("login failed with %v, want the later valid callback to complete it", <-failures)
+	}
+	if code := <-codes; code != "code-1" {
+		t.Errorf("code = %q, want code-1", code)
+	}
+}
+
+func TestOAuthLoginOptionsDefaults(t *testing.T) {
+	t.Setenv("LINCTL_OAUTH_CLIENT_ID", "env-client")
+	t.Setenv("LINCTL_OAUTH_CLIENT_SECRET", "env-secret")
+	t.Setenv("LINCTL_OAUTH_TOKEN_URL", "http://127.0.0.1:9/token")
+
+	opts := OAuthLoginOptions{ClientID: "flag-client"}.withDefaults()
+	if opts.ClientID != "flag-client" {
+		t.Errorf("ClientID = %q, want the flag to win over the environment", opts.ClientID)
+	}
+	if opts.ClientSecret != "env-secret" || opts.TokenURL != "http://127.0.0.1:9/token" {
+		t.Errorf("options = %+v, want the secret and token URL from the environment", opts)
+	}
+	if len(opts.Scopes) != len(DefaultOAuthScopes) {
+		t.Errorf("Scopes = %v, want %v", opts.Scopes, DefaultOAuthScopes)
+	}
+}
@@ -0,0 +1,352 @@
+package auth
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/zalando/go-keyring"
+	"golang.org/x/crypto/scrypt"
+	"golang.org/x/term"
+)
+
+// Credential store backends
+const (
+	StoreFile          = "file"
+	StoreKeyring       = "keyring"
+	StoreEncryptedFile = "encrypted-file"
+)
+
+// StoreNames lists the credential store backends
+var StoreNames = []string{StoreFile, StoreKeyring, StoreEncryptedFile}
+
+// PassphraseEnv supplies the encrypted-file passphrase without prompting
+const PassphraseEnv = "LINCTL_AUTH_PASSPHRASE"
+
+// CredentialStore persists the serialized auth config. Load returns an error
+// matching os.ErrNotExist when nothing has been stored.
+type CredentialStore interface {
+	// Name is the backend name, e.g. "file"
+	Name() string
+	// Location describes where credentials are kept, for messages
+	Location() string
+	Load() ([]byte, error)
+	Save(data []byte) error
+	Delete() error
+}
+
+// store is the backend selected with SetStore; nil means the file backend
+var store CredentialStore
+
+// NewStore returns the credential store backend with the given name
+func NewStore(name string) (CredentialStore, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return nil, err
+	}
+
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "", StoreFile:
+		return &fileStore{path: filepath.Join(homeDir, ".linctl-auth.json")}, nil
+	case StoreKeyring:
+		return &keyringStore{service: "linctl", user: "credentials"}, nil
+	case StoreEncryptedFile:
+		return &encryptedFileStore{path: filepath.Join(homeDir, ".linctl-auth.enc")}, nil
+	default:
+		return nil, fmt.Errorf("unknown credential store %q (use one of: %s)", name, strings.Join(StoreNames, ", "))
+	}
+}
+
+// SetStore selects the credential store backend used by subsequent calls
+func SetStore(name string) error {
+	selected, err := NewStore(name)
+	if err != nil {
+		return err
+	}
+	store = selected
+	return nil
+}
+
+// currentStore returns the selected credential store backend
+func currentStore() (CredentialStore, error) {
+	if store != nil {
+		return store, nil
+	}
+	selected, err := NewStore(StoreFile)
+	if err != nil {
+		return nil, err
+	}
+	store = selected
+	return store, nil
+}
+
+// StoreLocation describes where the selected backend keeps credentials
+func StoreLocation() string {
+	selected, err := currentStore()
+	if err != nil {
+		return ""
+	}
+	return selected.Location()
+}
+
+// MigrateStore copies the stored credentials into the named backend and
+// removes them from the current one
+func MigrateStore(name string) (CredentialStore, error) {
+	source, err := currentStore()
+	if err != nil {
+		return nil, err
+	}
+	target, err := NewStore(name)
+	if err != nil {
+		return nil, err
+	}
+	if source.Name() == target.Name() {
+		return nil, fmt.Errorf("credentials are already in the %s store", target.Name())
+	}
+
+	data, err := source.Load()
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, ErrNotAuthenticated
+		}
+		return nil, err
+	}
+	if err := target.Save(data); err != nil {
+		return nil, err
+	}
+	if err := source.Delete(); err != nil {
+		return nil, fmt.Errorf("credentials copied to %s, but removing them from %s failed: %v", target.Location(), source.Location(), err)
+	}
+
+	store = target
+	return target, nil
+}
+
+// fileStore keeps credentials in a JSON file readable only by the user
+type fileStore struct {
+	path string
+}
+
+func (s *fileStore) Name() string     { return StoreFile }
+func (s *fileStore) Location() string { return s.path }
+
+func (s *fileStore) Load() ([]byte, error) {
+	return os.ReadFile(s.path)
+}
+
+func (s *fileStore) Save(data []byte) error {
+	return os.WriteFile(s.path, data, 0600)
+}
+
+func (s *fileStore) Delete() error {
+	err := os.Remove(s.path)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// keyringStore keeps credentials in the OS keyring: the macOS Keychain,
+// Windows Credential Manager or a Secret Service provider on Linux
+type keyringStore struct {
+	service string
+	user    string
+}
+
+func (s *keyringStore) Name() string { return StoreKeyring }
+
+func (s *keyringStore) Location() string {
+	return fmt.Sprintf("OS keyring (service %s)", s.service)
+}
+
+func (s *keyringStore) Load() ([]byte, error) {
+	secret, err := keyring.Get(s.service, s.user)
+	if err != nil {
+		if errors.Is(err, keyring.ErrNotFound) {
+			return nil, os.ErrNotExist
+		}
+		return nil, fmt.Errorf("failed to read from OS keyring: %w", err)
+	}
+	return []byte(secret), nil
+}
+
+func (s *keyringStore) Save(data []byte) error {
+	if err := keyring.Set(s.service, s.user, string(data)); err != nil {
+		return fmt.Errorf("failed to write to OS keyring: %w", err)
+	}
+	return nil
+}
+
+func (s *keyringStore) Delete() error {
+	err := keyring.Delete(s.service, s.user)
+	if err != nil && !errors.Is(err, keyring.ErrNotFound) {
+		return fmt.Errorf("failed to delete from OS keyring: %w", err)
+	}
+	return nil
+}
+
+// scrypt parameters for deriving the encrypted-file key
+const (
+	scryptN      = 1 << 15
+	scryptR      = 8
+	scryptP      = 1
+	scryptKeyLen = 32
+)
+
+// encryptedFile is the on-disk format of the encrypted-file backend
+type encryptedFile struct {
+	Version    int    `json:"version"`
+	KDF        string `json:"kdf"`
+	N          int    `json:"n"`
+	R          int    `json:"r"`
+	P          int    `json:"p"`
+	Salt       []byte `json:"salt"`
+	Nonce      []byte `json:"nonce"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// encryptedFileStore keeps credentials in a file encrypted with AES-256-GCM
+// under a key derived from a passphrase with scrypt. The passphrase comes
+// from LINCTL_AUTH_PASSPHRASE or is prompted for once per run.
+type encryptedFileStore struct {
+	path       string
+	passphrase string
+}
+
+func (s *encryptedFileStore) Name() string     { return StoreEncryptedFile }
+func (s *encryptedFileStore) Location() string { return s.path }
+
+func (s *encryptedFileStore) Load() ([]byte, error) {
+	raw, err := os.ReadFile(s.path)
+	if err != nil {
+		return nil, err
+	}
+
+	var file encryptedFile
+	if err := json.Unmarshal(raw, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %v", s.path, err)
+	}
+	if file.Version != 1 || file.KDF != "scrypt" {
+		return nil, fmt.Errorf("unsupported encrypted credential file %s (version %d, kdf %q)", s.path, file.Version, file.KDF)
+	}
+
+	passphrase, err := s.getPassphrase(false)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := newGCM(passphrase, file.Salt, file.N, file.R, file.P)
+	if err != nil {
+		return nil, err
+	}
+	data, err := gcm.Open(nil, file.Nonce, file.Ciphertext, nil)
+	if err != nil {
+		s.passphrase = ""
+		return nil, fmt.Errorf("failed to decrypt %s: wrong passphrase or corrupted file", s.path)
+	}
+	return data, nil
+}
+
+func (s *encryptedFileStore) Save(data []byte) error {
+	_, statErr := os.Stat(s.path)
+	passphrase, err := s.getPassphrase(os.IsNotExist(statErr))
+	if err != nil {
+		return err
+	}
+
+	file := encryptedFile{Version: 1, KDF: "scrypt", N: scryptN, R: scryptR, P: scryptP}
+	file.Salt = make([]byte, 16)
+	if _, err := rand.Read(file.Salt); err != nil {
+		return err
+	}
+	gcm, err := newGCM(passphrase, file.Salt, file.N, file.R, file.P)
+	if err != nil {
+		return err
+	}
+	file.Nonce = make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(file.Nonce); err != nil {
+		return err
+	}
+	file.Ciphertext = gcm.Seal(nil, file.Nonce, data, nil)
+
+	raw, err := json.MarshalIndent(file, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(s.path, raw, 0600)
+}
+
+func (s *encryptedFileStore) Delete() error {
+	err := os.Remove(s.path)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// getPassphrase returns the passphrase from the environment or a prompt,
+// asking twice when a new file is being created
+func (s *encryptedFileStore) getPassphrase(confirm bool) (string, error) {
+	if s.passphrase != "" {
+		return s.passphrase, nil
+	}
+	if passphrase := os.Getenv(PassphraseEnv); passphrase != "" {
+		s.passphrase = passphrase
+		return passphrase, nil
+	}
+
+	if !term.IsTerminal(int(os.Stdin.Fd())) {
+		return "", fmt.Errorf("credentials are encrypted; set %s or run in a terminal to enter the passphrase", PassphraseEnv)
+	}
+
+	passphrase, err := readPassphrase("Credential store passphrase: ")
+	if err != nil {
+		return "", err
+	}
+	if passphrase == "" {
+		return "", fmt.Errorf("passphrase cannot be empty")
+	}
+	if confirm {
+		again, err := readPassphrase("Confirm passphrase: ")
+		if err != nil {
+			return "", err
+		}
+		if again != passphrase {
+			return "", fmt.Errorf("passphrases do not match")
+		}
+	}
+
+	s.passphrase = passphrase
+	return passphrase, nil
+}
+
+// readPassphrase prompts on stderr and reads a line from the terminal
+// without echoing it
+func readPassphrase(prompt string) (string, error) {
+	fmt.Fprint(os.Stderr, prompt)
+	defer fmt.Fprintln(os.Stderr)
+
+	data, err := term.ReadPassword(int(os.Stdin.Fd()))
+	if err != nil {
+		return "", fmt.Errorf("failed to read passphrase: %v", err)
+	}
+	return string(data), nil
+}
+
+// newGCM derives the AES-256-GCM cipher for a passphrase and salt
+func newGCM(passphrase string, salt []byte, n, r, p int) (cipher.AEAD, error) {
+	key, err := scrypt.Key([]byte(passphrase), salt, n, r, p, scryptKeyLen)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive key: %v", err)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
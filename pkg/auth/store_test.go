@@ -0,0 +1,209 @@
+package auth
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/zalando/go-keyring"
+)
+
+// useHome points the credential stores at an empty home directory and
+// restores the selected store afterwards
+func useHome(t *testing.T) string {
+	t.Helper()
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	keyring.MockInit()
+
+	previous := store
+	store = nil
+	t.Cleanup(func() { store = previous })
+	return home
+}
+
+func TestNewStore(t *testing.T) {
+	home := useHome(t)
+
+	tests := []struct {
+		name     string
+		backend  string
+		location string
+	}{
+		{"", StoreFile, filepath.Join(home, ".linctl-auth.json")},
+		{"File", StoreFile, filepath.Join(home, ".linctl-auth.json")},
+		{"keyring", StoreKeyring, "OS keyring (service linctl)"},
+		{" encrypted-file ", StoreEncryptedFile, filepath.Join(home, ".linctl-auth.enc")},
+	}
+	for _, tt := range tests {
+		selected, err := NewStore(tt.name)
+		if err != nil {
+			t.Fatalf("NewStore(%q) error = %v", tt.name, err)
+		}
+		if selected.Name() != tt.backend || selected.Location() != tt.location {
+			t.Errorf("NewStore(%q) = %s at %s, want %s at %s", tt.name, selected.Name(), selected.Location(), tt.backend, tt.location)
+		}
+	}
+
+	if _, err := NewStore("vault"); err == nil || !strings.Contains(err.Error(), "unknown credential store") {
+		t.Errorf("NewStore(vault) error = %v, want an unknown store error", err)
+	}
+}
+
+func TestCredentialStores(t *testing.T) {
+	t.Setenv(PassphraseEnv, "correct horse battery staple")
+
+	for _, name := range StoreNames {
+		t.Run(name, func(t *testing.T) {
+			useHome(t)
+			credentials, err := NewStore(name)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if _, err := credentials.Load(); !errors.Is(err, os.ErrNotExist) {
+				t.Errorf("Load() before Save error = %v, want os.ErrNotExist", err)
+			}
+
+			data := []byte(`{"api_key": "lin_api_secret"}`)
+			if err := credentials.Save(data); err != nil {
+				t.Fatal(err)
+			}
+			got, err := credentials.Load()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got, data) {
+				t.Errorf("Load() = %s, want %s", got, data)
+			}
+
+			if err := credentials.Delete(); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := credentials.Load(); !errors.Is(err, os.ErrNotExist) {
+				t.Errorf("Load() after Delete error = %v, want os.ErrNotExist", err)
+			}
+			if err := credentials.Delete(); err != nil {
+				t.Errorf("Delete() of missing credentials error = %v", err)
+			}
+		})
+	}
+}
+
+func TestFileStoreIsPrivate(t *testing.T) {
+	home := useHome(t)
+	credentials, _ := NewStore(StoreFile)
+	if err := credentials.Save([]byte(`{}`)); err != nil {
+		t.Fatal(err)
+	}
+
+	info, err := os.Stat(filepath.Join(home, ".linctl-auth.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mode := info.Mode().Perm(); mode != 0600 {
+		t.Errorf("credential file mode = %o, want 600", mode)
+	}
+}
+
+func TestKeyringStoreErrors(t *testing.T) {
+	useHome(t)
+	keyring.MockInitWithError(errors.New("secret service unavailable"))
+	credentials, _ := NewStore(StoreKeyring)
+
+	if _, err := credentials.Load(); err == nil || errors.Is(err, os.ErrNotExist) || !strings.Contains(err.Error(), "secret service unavailable") {
+		t.Errorf("Load() error = %v, want the keyring failure", err)
+	}
+	if err := credentials.Save([]byte(`{}`)); err == nil || !strings.Contains(err.Error(), "failed to write to OS keyring") {
+		t.Errorf("Save() error = %v, want the keyring failure", err)
+	}
+	if err := credentials.Delete(); err == nil {
+		t.Error("Delete() succeeded with a failing keyring")
+	}
+}
+
+func TestEncryptedFileStore(t *testing.T) {
+	home := useHome(t)
+	t.Setenv(PassphraseEnv, "first passphrase")
+
+	credentials, _ := NewStore(StoreEncryptedFile)
+	data := []byte(`{"api_key": "lin_api_secret"}`)
+	if err := credentials.Save(data); err != nil {
+		t.Fatal(err)
+	}
+
+	path := filepath.Join(home, ".linctl-auth.enc")
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(raw, []byte("lin_api_secret")) {
+		t.Error("encrypted file contains the API key in clear text")
+	}
+
+	// A new run with another passphrase cannot read the file
+	t.Setenv(PassphraseEnv, "second passphrase")
+	other, _ := NewStore(StoreEncryptedFile)
+	if _, err := other.Load(); err == nil || !strings.Contains(err.Error(), "wrong passphrase") {
+		t.Errorf("Load() with another passphrase error = %v, want a decryption failure", err)
+	}
+
+	if err := os.WriteFile(path, []byte(`{"version": 2, "kdf": "argon2"}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := other.Load(); err == nil || !strings.Contains(err.Error(), "unsupported encrypted credential file") {
+		t.Errorf("Load() of a newer format error = %v, want an unsupported file error", err)
+	}
+}
+
+func TestEncryptedFileStoreNeedsPassphrase(t *testing.T) {
+	useHome(t)
+	t.Setenv(PassphraseEnv, "")
+
+	// go test runs with stdin that is not a terminal, so there is no prompt
+	credentials, _ := NewStore(StoreEncryptedFile)
+	if err := credentials.Save([]byte(`{}`)); err == nil || !strings.Contains(err.Error(), PassphraseEnv) {
+		t.Errorf("Save() error = %v, want a hint to set %s", err, PassphraseEnv)
+	}
+}
+
+func TestMigrateStore(t *testing.T) {
+	home := useHome(t)
+
+	if _, err := MigrateStore(StoreKeyring); !errors.Is(err, ErrNotAuthenticated) {
+		t.Errorf("MigrateStore() without credentials error = %v, want ErrNotAuthenticated", err)
+	}
+
+	if err := saveAuth(&AuthConfig{APIKey: "lin_api_legacy"}); err != nil {
+		t.Fatal(err)
+	}
+	target, err := MigrateStore(StoreKeyring)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if target.Name() != StoreKeyring || StoreLocation() != target.Location() {
+		t.Errorf("selected store = %s, want the keyring", StoreLocation())
+	}
+	if _, err := os.Stat(filepath.Join(home, ".linctl-auth.json")); !os.IsNotExist(err) {
+		t.Errorf("credential file still exists after migrating (stat error %v)", err)
+	}
+
+	// The legacy single-key format reads as the default profile
+	config, err := loadAuth()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if profile := config.Profiles[DefaultProfile]; profile == nil || profile.APIKey != "lin_api_legacy" {
+		t.Errorf("profiles after migrating = %+v, want the key in the default profile", config.Profiles)
+	}
+	if config.CurrentProfile != DefaultProfile {
+		t.Errorf("current profile = %q, want %q", config.CurrentProfile, DefaultProfile)
+	}
+
+	if _, err := MigrateStore(StoreKeyring); err == nil || !strings.Contains(err.Error(), "already in the keyring store") {
+		t.Errorf("MigrateStore() to the current store error = %v", err)
+	}
+}
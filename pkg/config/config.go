@@ -0,0 +1,397 @@
+package config
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// Output formats accepted by the output setting
+const (
+	OutputTable     = "table"
+	OutputPlaintext = "plaintext"
+	OutputJSON      = "json"
+)
+
+// Defaults used when ~/.linctl.yaml does not set a value
+const (
+	DefaultOutput  = OutputTable
+	DefaultLimit   = 50
+	DefaultTimeout = 30 * time.Second
+	DefaultRetries = 3
+)
+
+// Settings are the user preferences read from ~/.linctl.yaml
+type Settings struct {
+	// Output is the default output format: table, plaintext or json
+	Output string `json:"output"`
+	// Limit is the default number of results for list commands
+	Limit int `json:"limit"`
+	// Limits overrides Limit per command, keyed by command path such as
+	// "issue.list", or by a parent such as "issue"
+	Limits map[string]int `json:"limits,omitempty"`
+	// Team is the default team key for commands that need a team
+	Team string       `json:"team,omitempty"`
+	API  APISettings  `json:"api"`
+	Auth AuthSettings `json:"auth"`
+}
+
+// APISettings configure the HTTP client
+type APISettings struct {
+	Timeout time.Duration `json:"timeout"`
+	Retries int           `json:"retries"`
+	// URL overrides the GraphQL endpoint
+	URL string `json:"url,omitempty"`
+	// Proxy routes requests through an HTTP(S) or SOCKS5 proxy instead of
+	// the one named by HTTPS_PROXY
+	Proxy string `json:"proxy,omitempty"`
+	// CAFile is a PEM bundle trusted in addition to the system roots
+	CAFile string `json:"ca_file,omitempty"`
+	// Headers are sent with every API request, keyed by header name
+	Headers map[string]string `json:"headers,omitempty"`
+}
+
+// AuthSettings configure credential storage
+type AuthSettings struct {
+	Store string `json:"store,omitempty"`
+}
+
+// Defaults returns the settings used without a config file
+func Defaults() *Settings {
+	return &Settings{
+		Output: DefaultOutput,
+		Limit:  DefaultLimit,
+		Limits: map[string]int{},
+		API: APISettings{
+			Timeout: DefaultTimeout,
+			Retries: DefaultRetries,
+			Headers: map[string]string{},
+		},
+	}
+}
+
+// Key describes a setting that 'linctl config' can read and write
+type Key struct {
+	Name        string
+	Description string
+	// Parse validates a value given on the command line and returns the
+	// value to write to the config file
+	Parse func(value string) (interface{}, error)
+}
+
+// Keys lists the supported settings. "limits.<command>" and
+// "api.headers.<name>" stand for any per-command limit and header.
+var Keys = []Key{
+	{"output", "Default output format: table, plaintext or json", asValue(parseOutput)},
+	{"limit", "Default number of results for list commands", asValue(parseLimit)},
+	{"limits.<command>", "Default limit for one command, e.g. limits.issue.list or limits.comment", asValue(parseLimit)},
+	{"team", "Default team key for commands that need a team", asValue(parseNonEmpty)},
+	{"api.timeout", "HTTP request timeout, e.g. 30s or 2m", func(value string) (interface{}, error) {
+		timeout, err := parseTimeout(value)
+		return timeout.String(), err
+	}},
+	{"api.retries", "How often rate-limited and failed requests are retried", asValue(parseRetries)},
+	{"api.url", "GraphQL endpoint to use instead of Linear's", asValue(parseURL)},
+	{"api.proxy", "Proxy for API requests, e.g. http://proxy.example.com:3128", asValue(parseProxy)},
+	{"api.ca_file", "PEM bundle of extra CA certificates to trust", asValue(parseCAFile)},
+	{"api.headers.<name>", "Header sent with every API request, e.g. api.headers.X-Request-Source", asValue(parseNonEmpty)},
+	{"auth.store", "Credential store: file, keyring or encrypted-file", asValue(parseStore)},
+}
+
+// asValue adapts a typed parser to Key.Parse
+func asValue[T any](parse func(string) (T, error)) func(string) (interface{}, error) {
+	return func(value string) (interface{}, error) {
+		return parse(value)
+	}
+}
+
+// LookupKey returns the definition of a setting
+func LookupKey(name string) (*Key, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	for i := range Keys {
+		if Keys[i].Name == name {
+			return &Keys[i], nil
+		}
+	}
+	// Per-command limits and headers share the definition of their pattern
+	for _, pattern := range []string{"limits.<command>", "api.headers.<name>"} {
+		prefix := pattern[:strings.Index(pattern, "<")]
+		if strings.HasPrefix(name, prefix) && len(name) > len(prefix) {
+			key, _ := LookupKey(pattern)
+			named := *key
+			named.Name = name
+			return &named, nil
+		}
+	}
+
+	names := make([]string, len(Keys))
+	for i, key := range Keys {
+		names[i] = key.Name
+	}
+	return nil, fmt.Errorf("unknown setting %q (known settings: %s)", name, strings.Join(names, ", "))
+}
+
+// Load reads and validates the settings held by v
+func Load(v *viper.Viper) (*Settings, error) {
+	settings := Defaults()
+
+	if v.IsSet("output") {
+		output, err := parseOutput(v.GetString("output"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid output: %w", err)
+		}
+		settings.Output = output
+	}
+
+	if v.IsSet("limit") {
+		limit, err := parseLimit(fmt.Sprint(v.Get("limit")))
+		if err != nil {
+			return nil, fmt.Errorf("invalid limit: %w", err)
+		}
+		settings.Limit = limit
+	}
+
+	if limits, ok := v.Get("limits").(map[string]interface{}); ok {
+		if err := flattenLimits("", limits, settings.Limits); err != nil {
+			return nil, err
+		}
+	}
+
+	settings.Team = strings.TrimSpace(v.GetString("team"))
+
+	if v.IsSet("api.timeout") {
+		timeout, err := parseTimeout(fmt.Sprint(v.Get("api.timeout")))
+		if err != nil {
+			return nil, fmt.Errorf("invalid api.timeout: %w", err)
+		}
+		settings.API.Timeout = timeout
+	}
+
+	if v.IsSet("api.retries") {
+		retries, err := parseRetries(fmt.Sprint(v.Get("api.retries")))
+		if err != nil {
+			return nil, fmt.Errorf("invalid api.retries: %w", err)
+		}
+		settings.API.Retries = retries
+	}
+
+	if apiURL := strings.TrimSpace(v.GetString("api.url")); apiURL != "" {
+		if _, err := parseURL(apiURL); err != nil {
+			return nil, fmt.Errorf("invalid api.url: %w", err)
+		}
+		settings.API.URL = apiURL
+	}
+
+	if proxy := strings.TrimSpace(v.GetString("api.proxy")); proxy != "" {
+		if _, err := parseProxy(proxy); err != nil {
+			return nil, fmt.Errorf("invalid api.proxy: %w", err)
+		}
+		settings.API.Proxy = proxy
+	}
+
+	settings.API.CAFile = strings.TrimSpace(v.GetString("api.ca_file"))
+
+	for name, value := range v.GetStringMapString("api.headers") {
+		if strings.TrimSpace(value) == "" {
+			return nil, fmt.Errorf("invalid api.headers.%s: value cannot be empty", name)
+		}
+		settings.API.Headers[name] = value
+	}
+
+	settings.Auth.Store = strings.TrimSpace(v.GetString("auth.store"))
+
+	return settings, nil
+}
+
+// flattenLimits turns nested limits maps into dotted command paths
+func flattenLimits(prefix string, limits map[string]interface{}, into map[string]int) error {
+	for name, value := range limits {
+		path := strings.ToLower(name)
+		if prefix != "" {
+			path = prefix + "." + path
+		}
+
+		if nested, ok := value.(map[string]interface{}); ok {
+			if err := flattenLimits(path, nested, into); err != nil {
+				return err
+			}
+			continue
+		}
+
+		limit, err := parseLimit(fmt.Sprint(value))
+		if err != nil {
+			return fmt.Errorf("invalid limits.%s: %w", path, err)
+		}
+		into[path] = limit
+	}
+	return nil
+}
+
+// LimitFor returns the default limit for a command path such as
+// "issue list", preferring the most specific limits entry
+func (s *Settings) LimitFor(commandPath string) int {
+	parts := strings.Fields(strings.ToLower(commandPath))
+	for i := len(parts); i > 0; i-- {
+		if limit, ok := s.Limits[strings.Join(parts[:i], ".")]; ok {
+			return limit
+		}
+	}
+	return s.Limit
+}
+
+// Value returns the effective value of a setting as text
+func (s *Settings) Value(name string) string {
+	switch name {
+	case "output":
+		return s.Output
+	case "limit":
+		return strconv.Itoa(s.Limit)
+	case "team":
+		return s.Team
+	case "api.timeout":
+		return s.API.Timeout.String()
+	case "api.retries":
+		return strconv.Itoa(s.API.Retries)
+	case "api.url":
+		return s.API.URL
+	case "api.proxy":
+		return s.API.Proxy
+	case "api.ca_file":
+		return s.API.CAFile
+	case "auth.store":
+		return s.Auth.Store
+	}
+	if path := strings.TrimPrefix(name, "limits."); path != name {
+		if limit, ok := s.Limits[path]; ok {
+			return strconv.Itoa(limit)
+		}
+	}
+	if header := strings.TrimPrefix(name, "api.headers."); header != name {
+		return s.API.Headers[header]
+	}
+	return ""
+}
+
+// Entries returns every setting with its effective value, with per-command
+// limits and headers expanded, sorted by name
+func (s *Settings) Entries() [][2]string {
+	entries := [][2]string{}
+	for _, key := range Keys {
+		if strings.Contains(key.Name, "<") {
+			continue
+		}
+		entries = append(entries, [2]string{key.Name, s.Value(key.Name)})
+	}
+	for path, limit := range s.Limits {
+		entries = append(entries, [2]string{"limits." + path, strconv.Itoa(limit)})
+	}
+	for name, value := range s.API.Headers {
+		entries = append(entries, [2]string{"api.headers." + name, value})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i][0] < entries[j][0]
+	})
+	return entries
+}
+
+func parseOutput(value string) (string, error) {
+	switch output := strings.ToLower(strings.TrimSpace(value)); output {
+	case OutputTable, OutputPlaintext, OutputJSON:
+		return output, nil
+	default:
+		return "", fmt.Errorf("%q is not one of table, plaintext, json", value)
+	}
+}
+
+func parseLimit(value string) (int, error) {
+	limit, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil || limit <= 0 {
+		return 0, fmt.Errorf("%q is not a positive number", value)
+	}
+	return limit, nil
+}
+
+func parseRetries(value string) (int, error) {
+	retries, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil || retries < 0 {
+		return 0, fmt.Errorf("%q is not a number of retries", value)
+	}
+	return retries, nil
+}
+
+// parseTimeout accepts a Go duration, or a bare number of seconds
+func parseTimeout(value string) (time.Duration, error) {
+	value = strings.TrimSpace(value)
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second, nil
+	}
+	timeout, err := time.ParseDuration(value)
+	if err != nil || timeout <= 0 {
+		return 0, fmt.Errorf("%q is not a duration such as 30s", value)
+	}
+	return timeout, nil
+}
+
+func parseURL(value string) (string, error) {
+	value = strings.TrimSpace(value)
+	parsed, err := url.Parse(value)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return "", fmt.Errorf("%q is not an http(s) URL", value)
+	}
+	return value, nil
+}
+
+func parseProxy(value string) (string, error) {
+	value = strings.TrimSpace(value)
+	parsed, err := url.Parse(value)
+	if err != nil || parsed.Host == "" {
+		return "", fmt.Errorf("%q is not a proxy URL", value)
+	}
+	switch parsed.Scheme {
+	case "http", "https", "socks5":
+		return value, nil
+	default:
+		return "", fmt.Errorf("%q is not an http, https or socks5 proxy URL", value)
+	}
+}
+
+// parseCAFile checks the bundle exists and returns its absolute path, so the
+// setting keeps working from any directory
+func parseCAFile(value string) (string, error) {
+	path, err := filepath.Abs(strings.TrimSpace(value))
+	if err != nil {
+		return "", err
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", err
+	}
+	if info.IsDir() {
+		return "", fmt.Errorf("%s is a directory", path)
+	}
+	return path, nil
+}
+
+func parseStore(value string) (string, error) {
+	switch store := strings.ToLower(strings.TrimSpace(value)); store {
+	case "file", "keyring", "encrypted-file":
+		return store, nil
+	default:
+		return "", fmt.Errorf("%q is not one of file, keyring, encrypted-file", value)
+	}
+}
+
+func parseNonEmpty(value string) (string, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return "", fmt.Errorf("value cannot be empty")
+	}
+	return value, nil
+}